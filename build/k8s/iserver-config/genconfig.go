@@ -124,7 +124,8 @@ func genconfig() {
 		LogLevel: "",
 	}
 	DB := &common.DBConfig{
-		LdbPath: "/data/storage/",
+		LdbPath:     "/data/storage/",
+		StorageType: "leveldb",
 	}
 	Snapshot := &common.SnapshotConfig{
 		Enable:   false,
@@ -144,7 +145,9 @@ func genconfig() {
 		Enable:       true,
 		GatewayAddr:  "0.0.0.0:30001",
 		GRPCAddr:     "0.0.0.0:30002",
+		JSONRPCAddr:  "0.0.0.0:30004",
 		TryTx:        false,
+		MaxBatchSize: 100,
 		AllowOrigins: []string{"*"},
 	}
 	Log := &common.LogConfig{
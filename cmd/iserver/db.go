@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+func openBlockChain(conf *common.Config) (block.Chain, error) {
+	storageType, err := kv.ParseStorageType(conf.DB.StorageType)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := block.NewBlockChainWithOptions(conf.DB.LdbPath+"BlockChainDB", &block.Options{
+		StorageType: storageType,
+		TxIndex:     conf.DB.TxIndex,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("open db failed: %v", err)
+	}
+	return bc, nil
+}
+
+// verifyDB checks the blockchain db in the data dir of the config offline, no iserver should be running on it.
+func verifyDB(conf *common.Config) error {
+	bc, err := openBlockChain(conf)
+	if err != nil {
+		return err
+	}
+	defer bc.Close()
+
+	ilog.Infof("Verifying %v, %v blocks, the first %v blocks are pruned", conf.DB.LdbPath, bc.Length(), bc.Pruned())
+	result, err := bc.Verify(*repair, func(issue *block.VerifyIssue) {
+		ilog.Warnf("%v", issue)
+	})
+	if err != nil {
+		return err
+	}
+	ilog.Infof("Verified %v blocks and %v txs, found %v issues, repaired %v issues",
+		result.Blocks, result.Txs, result.Issues, result.Repaired)
+	if result.Issues > result.Repaired {
+		return fmt.Errorf("%v issues are not repaired", result.Issues-result.Repaired)
+	}
+	return nil
+}
+
+// indexDB backfills the tx indexes of the blocks before the indexes are enabled offline,
+// no iserver should be running on it.
+func indexDB(conf *common.Config) error {
+	if !conf.DB.TxIndex {
+		return fmt.Errorf("txindex is not enabled in the config")
+	}
+	bc, err := openBlockChain(conf)
+	if err != nil {
+		return err
+	}
+	defer bc.Close()
+
+	ilog.Infof("Indexing the txs of blocks [%v, %v)", bc.Pruned(), bc.TxIndexFrom())
+	return bc.BackfillTxIndexes(func(number int64) {
+		if number%10000 == 0 {
+			ilog.Infof("Indexed the txs back to block %v", number)
+		}
+	})
+}
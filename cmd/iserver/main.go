@@ -32,6 +32,8 @@ import (
 var (
 	configFile = flag.StringP("config", "f", "", "Configuration `file`")
 	help       = flag.BoolP("help", "h", false, "Display available options")
+	keepBlocks = flag.Int64("keep", 0, "Number of the last blocks kept by the `prune` command, default by the config")
+	repair     = flag.Bool("repair", false, "Rebuild the broken indexes found by the `db verify` command")
 )
 
 func initMetrics(metricsConfig *common.MetricsConfig) error {
@@ -83,6 +85,38 @@ func main() {
 
 	initLogger(conf.Log)
 
+	if flag.Arg(0) == "prune" {
+		if err := pruneDB(conf); err != nil {
+			ilog.Fatalf("Prune failed: %v", err)
+		}
+		ilog.Stop()
+		return
+	}
+
+	if flag.Arg(0) == "db" && flag.Arg(1) == "verify" {
+		if err := verifyDB(conf); err != nil {
+			ilog.Fatalf("Verify db failed: %v", err)
+		}
+		ilog.Stop()
+		return
+	}
+
+	if flag.Arg(0) == "db" && flag.Arg(1) == "index" {
+		if err := indexDB(conf); err != nil {
+			ilog.Fatalf("Index db failed: %v", err)
+		}
+		ilog.Stop()
+		return
+	}
+
+	if flag.Arg(0) == "wal" && flag.Arg(1) == "inspect" {
+		if err := inspectWAL(conf, flag.Arg(2)); err != nil {
+			ilog.Fatalf("Inspect wal failed: %v", err)
+		}
+		ilog.Stop()
+		return
+	}
+
 	ilog.Infof("Config Information:\n%v", strings.Replace(conf.YamlString(), conf.ACC.SecKey, conf.ACC.SecKey[:3]+"******", -1))
 
 	ilog.Infof("build time:%v", global.BuildTime)
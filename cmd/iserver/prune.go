@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/global"
+	"github.com/iost-official/go-iost/core/pruner"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// pruneDB prunes the data dir of the config offline, no iserver should be running on it.
+func pruneDB(conf *common.Config) error {
+	if conf.DB.Prune == nil {
+		conf.DB.Prune = &common.PruneConfig{}
+	}
+	if *keepBlocks > 0 {
+		conf.DB.Prune.KeepBlocks = *keepBlocks
+	}
+	bv, err := global.New(conf)
+	if err != nil {
+		return fmt.Errorf("open db failed: %v", err)
+	}
+	defer func() {
+		bv.BlockChain().Close()
+		bv.StateDB().Close()
+	}()
+	p, err := pruner.New(bv.BlockChain(), bv.StateDB(), conf.DB)
+	if err != nil {
+		return err
+	}
+	ilog.Infof("Pruning %v, keep the last %v blocks", conf.DB.LdbPath, conf.DB.Prune.KeepBlocks)
+	return p.Prune()
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"os"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/blockcache"
+)
+
+// inspectWAL prints the records of block cache wal in dir, or the wal of the config if dir is empty.
+func inspectWAL(conf *common.Config, dir string) error {
+	if dir == "" {
+		dir = blockcache.WALDir(conf)
+	}
+	return blockcache.InspectWAL(dir, os.Stdout)
+}
@@ -22,6 +22,12 @@ type ACCConfig struct {
 	Algorithm string
 }
 
+// ProducerConfig is the config of block producing.
+type ProducerConfig struct {
+	// the order of txs packed into a block: gas_ratio, round_robin or deadline, gas_ratio if empty
+	TxProvider string
+}
+
 // Witness config of the genesis block
 type Witness struct {
 	ID             string
@@ -51,7 +57,21 @@ type GenesisConfig struct {
 
 // DBConfig config of the database
 type DBConfig struct {
-	LdbPath string
+	LdbPath     string
+	StorageType string // leveldb(default), memory or badger
+	Archive     bool   // keep the state of every irreversible block for historical queries
+	StateTrie   bool   // compute the merkle root of state for state proofs
+	DiskCache   bool   // keep the state changes of reversible blocks on disk instead of memory
+	WALCompress bool   // compress the large records of block cache wal
+	TxIndex     bool   // index the txs by account and contract for GetTxsByAccount and GetTxsByContract
+	Prune       *PruneConfig
+}
+
+// PruneConfig is the config of pruning old blocks and state history
+type PruneConfig struct {
+	Enable     bool
+	KeepBlocks int64 // txs, receipts and state history of the last blocks are kept
+	Interval   int64 // seconds between two rounds of pruning and compaction
 }
 
 // VMConfig config of the v8vm
@@ -79,9 +99,35 @@ type RPCConfig struct {
 	Enable       bool
 	GatewayAddr  string
 	GRPCAddr     string
+	JSONRPCAddr  string // the address of JSON-RPC 2.0 over HTTP and WebSocket, disabled if empty
 	AllowOrigins []string
 	TryTx        bool
 	ExecTx       bool
+	MaxBatchSize int // the max number of blocks or txs in a batch request, 100 if not set
+	Limit        *RPCLimitConfig
+	GraphQL      *GraphQLConfig
+}
+
+// RPCLimitConfig is the config for the access limits of RPC Server, the clients are identified by
+// the "X-Api-Key" header if it is set, otherwise by their ip. The graphql queries are limited as the method "GraphQL".
+type RPCLimitConfig struct {
+	Rate           float64  // requests per second of each ip, unlimited if 0
+	Burst          int      // the max requests of each ip at once
+	APIKeys        []string // the valid api keys
+	KeyRate        float64  // requests per second of each api key, unlimited if 0
+	KeyBurst       int      // the max requests of each api key at once
+	AllowMethods   []string // the methods available to the clients without api key, all if empty
+	KeyMethods     []string // the methods available to the clients with api key, all if empty
+	DenyMethods    []string // the methods unavailable to all the clients
+	MaxRequestSize int      // the max bytes of a request, 4MB if 0
+}
+
+// GraphQLConfig is the config for the GraphQL server of RPC Server, which serves the queries over chain and state data.
+type GraphQLConfig struct {
+	Enable        bool
+	Addr          string
+	MaxDepth      int // the max depth of a query, 10 if 0
+	MaxComplexity int // the max number of objects and state reads resolved by a query, 50000 if 0
 }
 
 // FileLogConfig is the config for filewriter of ilog.
@@ -129,11 +175,14 @@ type DebugConfig struct {
 type VersionConfig struct {
 	NetName         string
 	ProtocolVersion string
+	// the block number from which replacements are accepted, which should be the same in the network, disabled if <= 0
+	ReplacementHeight int64
 }
 
 // Config provide all configuration for the application
 type Config struct {
 	ACC      *ACCConfig
+	Producer *ProducerConfig
 	Genesis  string
 	VM       *VMConfig
 	DB       *DBConfig
@@ -22,6 +22,8 @@ var (
 	errSignature              = errors.New("wrong signature")
 	errTxDup                  = errors.New("duplicate tx")
 	errDoubleTx               = errors.New("double tx in block")
+	errTxReplaced             = errors.New("both replaced tx and replacement in chain")
+	errReplaceDisabled        = errors.New("replacement before ReplacementHeight")
 	errTxLenUnmatchReceiptLen = errors.New("tx len unmatch receipt len")
 )
 
@@ -31,7 +33,8 @@ func generateBlock(
 	db db.MVCCDB,
 	limitTime time.Duration,
 	pTx *txpool.SortedTxMap,
-	head *blockcache.BlockCacheNode) (*block.Block, error) {
+	head *blockcache.BlockCacheNode,
+	txProvider string) (*block.Block, error) {
 
 	ilog.Debug("generate Block start")
 	st := time.Now()
@@ -54,15 +57,17 @@ func generateBlock(
 	v := verifier.Verifier{}
 	t1 := time.Now()
 	// TODO: stateDb and block head is consisdent, pTx may be inconsisdent.
-	dropList, _, err := v.Gen(blk, topBlock, &head.WitnessList, db, pTx, &verifier.Config{
+	dropList, errs, err := v.Gen(blk, topBlock, &head.WitnessList, db, pTx, &verifier.Config{
 		Mode:        0,
 		Timeout:     limitTime - time.Now().Sub(st),
 		TxTimeLimit: common.MaxTxTimeLimit,
+		TxProvider:  txProvider,
 	})
 	t2 := time.Since(t1)
 	if len(blk.Txs) != 0 {
 		ilog.Debugf("time spent per tx: %v", t2.Nanoseconds()/int64(len(blk.Txs)))
 	}
+	go txPool.DropTxs(dropList, errs)
 	if err != nil {
 		go txPool.DelTxList(dropList)
 		ilog.Errorf("Gen is err: %v", err)
@@ -103,17 +108,37 @@ func verifyBlock(blk, parent *block.Block, witnessList *blockcache.WitnessList,
 		return errWitness
 	}
 	ilog.Debugf("[pob] start to verify block if foundchain, number: %v, hash = %v, witness = %v", blk.Head.Number, common.Base58Encode(blk.HeadHash()), blk.Head.Witness[4:6])
-	blkTxSet := make(map[string]bool, len(blk.Txs))
-	for i, t := range blk.Txs {
-		if blkTxSet[string(t.Hash())] {
+	blkTxs := make(map[string]*tx.Tx, len(blk.Txs))
+	for _, t := range blk.Txs {
+		if _, ok := blkTxs[string(t.Hash())]; ok {
 			return errDoubleTx
 		}
-		blkTxSet[string(t.Hash())] = true
+		blkTxs[string(t.Hash())] = t
+	}
+	// the replacements of a tx by the same publisher, only one of which and the tx can be packed
+	blkReplacedSet := make(map[string]bool)
+	for i, t := range blk.Txs {
+		if t.IsReplacement() {
+			if !tx.IsReplacementEnabled(blk.Head.Number) {
+				return errReplaceDisabled
+			}
+			key := string(t.ReplacedTx) + t.Publisher
+			if blkReplacedSet[key] {
+				return errTxReplaced
+			}
+			blkReplacedSet[key] = true
+			if r, ok := blkTxs[string(t.ReplacedTx)]; ok && t.CanReplace(r) {
+				return errTxReplaced
+			}
+		}
 
 		if i == 0 {
 			// base tx
 			continue
 		}
+		if txPool.ExistReplacement(t, parent) {
+			return errTxReplaced
+		}
 		exist := txPool.ExistTxs(t.Hash(), parent)
 		switch exist {
 		case txpool.FoundChain:
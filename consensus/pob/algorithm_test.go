@@ -73,10 +73,11 @@ func BenchmarkGenerateBlock(b *testing.B) { // 296275 = 0.3ms(0tx), 466353591 =
 	}
 	mockTxPool.EXPECT().PendingTx().Return(pendingTx, &blockcache.BlockCacheNode{Block: topBlock}).AnyTimes()
 	mockTxPool.EXPECT().DelTxList(gomock.Any()).AnyTimes()
+	mockTxPool.EXPECT().DropTxs(gomock.Any(), gomock.Any()).AnyTimes()
 	b.ResetTimer()
 	pTx, head := mockTxPool.PendingTx()
 	for j := 0; j < b.N; j++ {
-		generateBlock(account, mockTxPool, stateDB, time.Millisecond*1000, pTx, head)
+		generateBlock(account, mockTxPool, stateDB, time.Millisecond*1000, pTx, head, verifier.GasRatioProvider)
 	}
 	b.StopTimer()
 }
@@ -112,9 +113,10 @@ func BenchmarkVerifyBlockWithVM(b *testing.B) { // 296275 = 0.3ms(0tx), 46635359
 	}
 	mockTxPool.EXPECT().PendingTx().Return(pendingTx, &blockcache.BlockCacheNode{Block: topBlock}).AnyTimes()
 	mockTxPool.EXPECT().DelTxList(gomock.Any()).AnyTimes()
+	mockTxPool.EXPECT().DropTxs(gomock.Any(), gomock.Any()).AnyTimes()
 
 	pTx, head := mockTxPool.PendingTx()
-	blk, _ := generateBlock(account, mockTxPool, stateDB, time.Millisecond*1000, pTx, head)
+	blk, _ := generateBlock(account, mockTxPool, stateDB, time.Millisecond*1000, pTx, head, verifier.GasRatioProvider)
 
 	b.ResetTimer()
 	for j := 0; j < b.N; j++ {
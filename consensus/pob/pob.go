@@ -17,6 +17,7 @@ import (
 	"github.com/iost-official/go-iost/ilog"
 	"github.com/iost-official/go-iost/metrics"
 	"github.com/iost-official/go-iost/p2p"
+	"github.com/iost-official/go-iost/verifier"
 )
 
 var (
@@ -54,6 +55,7 @@ type PoB struct {
 	verifyDB     db.MVCCDB
 	produceDB    db.MVCCDB
 	sync         *synchro.Sync
+	txProvider   string
 
 	exitSignal       chan struct{}
 	quitGenerateMode chan struct{}
@@ -71,6 +73,14 @@ func New(baseVariable global.BaseVariable, blockCache blockcache.BlockCache, txP
 		ilog.Fatalf("NewKeyPair failed, stop the program! err:%v", err)
 	}
 
+	var txProvider string
+	if conf := baseVariable.Config().Producer; conf != nil {
+		txProvider = conf.TxProvider
+	}
+	if err := verifier.CheckProvider(txProvider); err != nil {
+		ilog.Fatalf("Invalid producer.txprovider %q, stop the program! err:%v", txProvider, err)
+	}
+
 	// TODO: Organize the owner and lifecycle of all metrics.
 	metricsMode.Set(float64(2), nil)
 
@@ -84,6 +94,7 @@ func New(baseVariable global.BaseVariable, blockCache blockcache.BlockCache, txP
 		verifyDB:     baseVariable.StateDB(),
 		produceDB:    baseVariable.StateDB().Fork(),
 		sync:         nil,
+		txProvider:   txProvider,
 
 		exitSignal:       make(chan struct{}),
 		quitGenerateMode: make(chan struct{}),
@@ -242,7 +253,7 @@ func (p *PoB) gen(num int, pTx *txpool.SortedTxMap, head *blockcache.BlockCacheN
 		limitTime = last2GenBlockTime
 	}
 	p.txPool.Lock()
-	blk, err := generateBlock(p.account, p.txPool, p.produceDB, limitTime, pTx, head)
+	blk, err := generateBlock(p.account, p.txPool, p.produceDB, limitTime, pTx, head, p.txProvider)
 	p.txPool.Release()
 	if err != nil {
 		ilog.Error(err)
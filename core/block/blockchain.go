@@ -18,11 +18,20 @@ type BlockChain struct { //nolint:golint
 	rw           sync.RWMutex
 	length       int64
 	txTotal      int64
+	pruned       int64
+	txIndexFrom  int64 // the first block of the secondary tx indexes, -1 if disabled
+}
+
+// Options is the options of blockchain
+type Options struct {
+	StorageType kv.StorageType
+	TxIndex     bool // index the txs by account and contract
 }
 
 var (
 	blockLength       = []byte("BlockLength")
 	blockTxTotal      = []byte("BlockTxTotal")
+	blockPruned       = []byte("BlockPruned") // the bodies of blocks before it are pruned
 	blockNumberPrefix = []byte("n")
 	blockPrefix       = []byte("H")
 	txPrefix          = []byte("t")      // txPrefix + tx hash -> block hash + tx hash
@@ -33,9 +42,24 @@ var (
 	delaytxPrefix     = []byte("delay-") // delaytxPrefix + tx hash -> tx data
 )
 
+// ErrBlockPruned is returned when getting a block before Pruned, whose txs and receipts are removed.
+var ErrBlockPruned = errors.New("block is pruned")
+
 // NewBlockChain returns a Chain instance
 func NewBlockChain(path string) (Chain, error) {
-	levelDB, err := kv.NewStorage(path, kv.LevelDBStorage)
+	return NewBlockChainWithStorage(path, kv.LevelDBStorage)
+}
+
+// NewBlockChainWithStorage returns a Chain instance on the specify storage type
+func NewBlockChainWithStorage(path string, storageType kv.StorageType) (Chain, error) {
+	return NewBlockChainWithOptions(path, &Options{
+		StorageType: storageType,
+	})
+}
+
+// NewBlockChainWithOptions returns a Chain instance with the options
+func NewBlockChainWithOptions(path string, opts *Options) (Chain, error) {
+	levelDB, err := kv.NewStorage(path, opts.StorageType)
 	if err != nil {
 		return nil, fmt.Errorf("fail to init blockchaindb, %v", err)
 	}
@@ -66,13 +90,25 @@ func NewBlockChain(path string) (Chain, error) {
 			return nil, errors.New("fail to put tx total")
 		}
 	}
+	prunedByte, err := levelDB.Get(blockPruned)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get pruned block number, %v", err)
+	}
+	var pruned int64
+	if len(prunedByte) != 0 {
+		pruned = common.BytesToInt64(prunedByte)
+	}
 	BC := &BlockChain{
 		blockChainDB: levelDB,
 		length:       length,
 		txTotal:      txTotal,
+		pruned:       pruned,
 	}
 	BC.CheckLength()
-	return BC, err
+	if err := BC.initTxIndex(opts.TxIndex); err != nil {
+		return nil, err
+	}
+	return BC, nil
 }
 
 // SetLength sets blockchain's length.
@@ -103,49 +139,85 @@ func (bc *BlockChain) TxTotal() int64 {
 	return bc.txTotal
 }
 
+// checkedBatch keeps the first error of the writes, and discards the batch with the error on commit
+type checkedBatch struct {
+	kv.Batch
+	err error
+}
+
+func newCheckedBatch(batch kv.Batch) *checkedBatch {
+	return &checkedBatch{Batch: batch}
+}
+
+// Put writes the key if no write failed before
+func (b *checkedBatch) Put(key []byte, value []byte) error {
+	if b.err == nil {
+		b.err = b.Batch.Put(key, value)
+	}
+	return b.err
+}
+
+// Delete deletes the key if no write failed before
+func (b *checkedBatch) Delete(key []byte) error {
+	if b.err == nil {
+		b.err = b.Batch.Delete(key)
+	}
+	return b.err
+}
+
+// Commit returns the first error of the writes without committing any of them
+func (b *checkedBatch) Commit() error {
+	if b.err != nil {
+		b.Batch.Discard()
+		return b.err
+	}
+	return b.Batch.Commit()
+}
+
 // Push save the block to database
 func (bc *BlockChain) Push(block *Block) error {
-	err := bc.blockChainDB.BeginBatch()
-	if err != nil {
-		return errors.New("fail to begin batch")
-	}
+	batch := newCheckedBatch(bc.blockChainDB.NewBatch())
 
 	hash := block.HeadHash()
 	number := block.Head.Number
 	txTotal := bc.TxTotal()
-	bc.blockChainDB.Put(append(blockNumberPrefix, common.Int64ToBytes(number)...), hash)
+	batch.Put(append(blockNumberPrefix, common.Int64ToBytes(number)...), hash)
 	blockByte, err := block.EncodeM()
 	if err != nil {
+		batch.Discard()
 		return errors.New("fail to encode block")
 	}
-	bc.blockChainDB.Put(append(blockPrefix, hash...), blockByte)
-	bc.blockChainDB.Put(blockLength, common.Int64ToBytes(number+1))
-	bc.blockChainDB.Put(blockTxTotal, common.Int64ToBytes(txTotal+int64(len(block.Txs))))
+	batch.Put(append(blockPrefix, hash...), blockByte)
+	batch.Put(blockLength, common.Int64ToBytes(number+1))
+	batch.Put(blockTxTotal, common.Int64ToBytes(txTotal+int64(len(block.Txs))))
 	for i, t := range block.Txs {
 		tHash := t.Hash()
 		txBytes := t.Encode()
-		bc.blockChainDB.Put(append(txPrefix, tHash...), append(hash, tHash...))
-		bc.blockChainDB.Put(append(bTxPrefix, append(hash, tHash...)...), txBytes)
+		batch.Put(append(txPrefix, tHash...), append(hash, tHash...))
+		batch.Put(append(bTxPrefix, append(hash, tHash...)...), txBytes)
 
 		// save receipt
 		rHash := block.Receipts[i].Hash()
-		bc.blockChainDB.Put(append(txReceiptPrefix, tHash...), append(hash, rHash...))
-		bc.blockChainDB.Put(append(receiptPrefix, rHash...), append(hash, rHash...))
-		bc.blockChainDB.Put(append(bReceiptPrefix, append(hash, rHash...)...), block.Receipts[i].Encode())
+		batch.Put(append(txReceiptPrefix, tHash...), append(hash, rHash...))
+		batch.Put(append(receiptPrefix, rHash...), append(hash, rHash...))
+		batch.Put(append(bReceiptPrefix, append(hash, rHash...)...), block.Receipts[i].Encode())
 
 		if t.Delay > 0 && block.Receipts[i].Status.Code == tx.Success {
-			bc.blockChainDB.Put(append(delaytxPrefix, tHash...), txBytes)
+			batch.Put(append(delaytxPrefix, tHash...), txBytes)
 		}
 		if t.IsDefer() {
-			bc.blockChainDB.Delete(append(delaytxPrefix, t.ReferredTx...))
+			batch.Delete(append(delaytxPrefix, t.ReferredTx...))
 		}
 
 		canceledDelayHashes := block.Receipts[i].ParseCancelDelaytx()
 		for _, canceledHash := range canceledDelayHashes {
-			bc.blockChainDB.Delete(append(delaytxPrefix, canceledHash...))
+			batch.Delete(append(delaytxPrefix, canceledHash...))
+		}
+		if bc.TxIndexFrom() >= 0 {
+			putTxIndexes(batch, number, t)
 		}
 	}
-	err = bc.blockChainDB.CommitBatch()
+	err = batch.Commit()
 	if err != nil {
 		return fmt.Errorf("fail to put block, err:%s", err)
 	}
@@ -194,8 +266,8 @@ func (bc *BlockChain) getBlockByteByHash(hash []byte) ([]byte, error) {
 	return blockByte, nil
 }
 
-// GetBlockByHash is get block by hash
-func (bc *BlockChain) GetBlockByHash(hash []byte) (*Block, error) {
+// getBlockHeader returns the block of hash without txs and receipts
+func (bc *BlockChain) getBlockHeader(hash []byte) (*Block, error) {
 	blockByte, err := bc.getBlockByteByHash(hash)
 	if err != nil {
 		return nil, err
@@ -205,6 +277,37 @@ func (bc *BlockChain) GetBlockByHash(hash []byte) (*Block, error) {
 	if err != nil {
 		return nil, errors.New("fail to decode blockByte")
 	}
+	return &blk, nil
+}
+
+// GetBlockHeaderByNumber returns the block of number without txs and receipts, which is available
+// for the pruned blocks too
+func (bc *BlockChain) GetBlockHeaderByNumber(number int64) (*Block, error) {
+	hash, err := bc.GetHashByNumber(number)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := bc.getBlockHeader(hash)
+	if err != nil {
+		return nil, err
+	}
+	blk.TxHashes = nil
+	blk.ReceiptHashes = nil
+	return blk, nil
+}
+
+// GetBlockByHash is get block by hash, ErrBlockPruned is returned if the block is pruned
+func (bc *BlockChain) GetBlockByHash(hash []byte) (*Block, error) {
+	// hold the lock, so the block is not pruned while reading its txs
+	bc.rw.RLock()
+	defer bc.rw.RUnlock()
+	blk, err := bc.getBlockHeader(hash)
+	if err != nil {
+		return nil, err
+	}
+	if blk.Head.Number < bc.pruned {
+		return nil, ErrBlockPruned
+	}
 	if blk.TxHashes != nil {
 		blk.Txs = make([]*tx.Tx, len(blk.TxHashes))
 		txsMap, err := bc.getBlockTxsMap(hash)
@@ -233,7 +336,7 @@ func (bc *BlockChain) GetBlockByHash(hash []byte) (*Block, error) {
 			}
 		}
 	}
-	return &blk, nil
+	return blk, nil
 }
 
 // GetBlockNumberByTxHash is get number of the block by hash of the tx
@@ -265,6 +368,98 @@ func (bc *BlockChain) GetBlockByNumber(number int64) (*Block, error) {
 	return bc.GetBlockByHash(hash)
 }
 
+// GetBlocksByRange returns at most limit blocks whose number is in [start, end),
+// from the end to the start if reverse
+func (bc *BlockChain) GetBlocksByRange(start, end int64, reverse bool, limit int) ([]*Block, error) {
+	if start < 0 {
+		start = 0
+	}
+	if end <= start {
+		return []*Block{}, nil
+	}
+	iter := bc.blockChainDB.NewIterator(&kv.IteratorOptions{
+		Start:   append(blockNumberPrefix, common.Int64ToBytes(start)...),
+		End:     append(blockNumberPrefix, common.Int64ToBytes(end)...),
+		Reverse: reverse,
+		Limit:   limit,
+	})
+	hashes := make([][]byte, 0)
+	for iter.Next() {
+		hashes = append(hashes, common.CopyBytes(iter.Value()))
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("fail to iterate block numbers: %v", err)
+	}
+	blocks := make([]*Block, 0, len(hashes))
+	for _, hash := range hashes {
+		blk, err := bc.GetBlockByHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, blk)
+	}
+	return blocks, nil
+}
+
+// GetBlockTxs returns at most limit txs of the block ordered by tx hash,
+// starting after the tx hash of the previous page
+func (bc *BlockChain) GetBlockTxs(blockHash []byte, after []byte, limit int) ([]*tx.Tx, error) {
+	iter := bc.newBlockPageIterator(bTxPrefix, blockHash, after, limit)
+	txs := make([]*tx.Tx, 0)
+	for iter.Next() {
+		t := &tx.Tx{}
+		err := t.Decode(iter.Value())
+		if err != nil {
+			iter.Release()
+			return nil, fmt.Errorf("fail to decode tx: %v", err)
+		}
+		txs = append(txs, t)
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("fail to get block txs: %v", err)
+	}
+	return txs, nil
+}
+
+// GetBlockReceipts returns at most limit receipts of the block ordered by receipt hash,
+// starting after the receipt hash of the previous page
+func (bc *BlockChain) GetBlockReceipts(blockHash []byte, after []byte, limit int) ([]*tx.TxReceipt, error) {
+	iter := bc.newBlockPageIterator(bReceiptPrefix, blockHash, after, limit)
+	receipts := make([]*tx.TxReceipt, 0)
+	for iter.Next() {
+		tr := &tx.TxReceipt{}
+		err := tr.Decode(iter.Value())
+		if err != nil {
+			iter.Release()
+			return nil, fmt.Errorf("fail to decode tx receipt: %v", err)
+		}
+		receipts = append(receipts, tr)
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("fail to get block receipts: %v", err)
+	}
+	return receipts, nil
+}
+
+// newBlockPageIterator returns the iterator of prefix + block hash, skipping the keys not after
+// prefix + block hash + after
+func (bc *BlockChain) newBlockPageIterator(prefix []byte, blockHash []byte, after []byte, limit int) *kv.Iterator {
+	keyPrefix := append(common.CopyBytes(prefix), blockHash...)
+	start := keyPrefix
+	if len(after) > 0 {
+		// the smallest key greater than keyPrefix + after
+		start = append(append(common.CopyBytes(keyPrefix), after...), 0)
+	}
+	return bc.blockChainDB.NewIterator(&kv.IteratorOptions{
+		Start: start,
+		End:   kv.PrefixEnd(keyPrefix),
+		Limit: limit,
+	})
+}
+
 func (bc *BlockChain) getBlockTxsMap(hash []byte) (map[string]*tx.Tx, error) {
 	iter := bc.blockChainDB.NewIteratorByPrefix(append(bTxPrefix, hash...))
 	txsMap := make(map[string]*tx.Tx, 0)
@@ -378,6 +573,69 @@ func (bc *BlockChain) HasReceipt(hash []byte) (bool, error) {
 	return bc.blockChainDB.Has(append(receiptPrefix, hash...))
 }
 
+// Pruned returns the number of the first block whose body is kept, the blocks before it only have headers
+func (bc *BlockChain) Pruned() int64 {
+	bc.rw.RLock()
+	defer bc.rw.RUnlock()
+	return bc.pruned
+}
+
+// Prune removes the txs and receipts of the blocks before number, keeping their headers
+func (bc *BlockChain) Prune(number int64) error {
+	if number > bc.Length() {
+		number = bc.Length()
+	}
+	for i := bc.Pruned(); i < number; i++ {
+		if err := bc.pruneBlock(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bc *BlockChain) pruneBlock(number int64) error {
+	blk, err := bc.GetBlockByNumber(number)
+	if err != nil {
+		return fmt.Errorf("fail to get block %v, err:%v", number, err)
+	}
+	hash := blk.HeadHash()
+	batch := newCheckedBatch(bc.blockChainDB.NewBatch())
+	for i, t := range blk.Txs {
+		tHash := t.Hash()
+		rHash := blk.Receipts[i].Hash()
+		batch.Delete(append(txPrefix, tHash...))
+		batch.Delete(append(bTxPrefix, append(hash, tHash...)...))
+		batch.Delete(append(txReceiptPrefix, tHash...))
+		batch.Delete(append(receiptPrefix, rHash...))
+		batch.Delete(append(bReceiptPrefix, append(hash, rHash...)...))
+		deleteTxIndexes(batch, number, t)
+	}
+	head := &Block{
+		Head: blk.Head,
+		Sign: blk.Sign,
+	}
+	headByte, err := head.EncodeM()
+	if err != nil {
+		batch.Discard()
+		return errors.New("fail to encode block")
+	}
+	batch.Put(append(blockPrefix, hash...), headByte)
+	batch.Put(blockPruned, common.Int64ToBytes(number+1))
+	// the block is marked as pruned along with the commit, so it is never read as a complete block
+	bc.rw.Lock()
+	defer bc.rw.Unlock()
+	if err := batch.Commit(); err != nil {
+		return fmt.Errorf("fail to prune block %v, err:%s", number, err)
+	}
+	bc.pruned = number + 1
+	return nil
+}
+
+// Compact compacts the blockchain db to reclaim the space of pruned blocks
+func (bc *BlockChain) Compact() error {
+	return bc.blockChainDB.Compact(nil, nil)
+}
+
 // Size returns the blockchain db size
 func (bc *BlockChain) Size() (int64, error) {
 	return bc.blockChainDB.Size()
@@ -2,14 +2,17 @@ package block
 
 import (
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/db/kv"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -86,6 +89,443 @@ func TestChainImpl(t *testing.T) {
 	})
 }
 
+func TestChainStorage(t *testing.T) {
+	Convey("test chain on every storage", t, func() {
+		a1, _ := account.NewKeyPair(nil, crypto.Secp256k1)
+		for _, st := range []kv.StorageType{kv.LevelDBStorage, kv.MemoryStorage, kv.BadgerStorage} {
+			bc, err := NewBlockChainWithStorage("./BlockChainDB/", st)
+			So(err, ShouldBeNil)
+
+			tBlock := &Block{
+				Head: &BlockHead{
+					Version:    2,
+					ParentHash: []byte("parent Hash"),
+					Number:     0,
+					Time:       201222,
+					Witness:    a1.ReadablePubkey(),
+				},
+			}
+			actions := []*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}
+			for i := 0; i < 3; i++ {
+				txn := tx.NewTx(actions, nil, 9999, 100, int64(i), 0, 0)
+				tBlock.Txs = append(tBlock.Txs, txn)
+				tBlock.Receipts = append(tBlock.Receipts, tx.NewTxReceipt(txn.Hash()))
+			}
+			tBlock.CalculateHeadHash()
+			tBlock.Sign = a1.Sign(tBlock.HeadHash())
+			So(bc.Push(tBlock), ShouldBeNil)
+			So(bc.Length(), ShouldEqual, 1)
+			So(bc.TxTotal(), ShouldEqual, 3)
+
+			block, err := bc.GetBlockByNumber(0)
+			So(err, ShouldBeNil)
+			So(string(block.HeadHash()), ShouldEqual, string(tBlock.HeadHash()))
+			So(len(block.Txs), ShouldEqual, 3)
+			So(len(block.Receipts), ShouldEqual, 3)
+
+			txn, err := bc.GetTx(tBlock.Txs[1].Hash())
+			So(err, ShouldBeNil)
+			So(string(txn.Hash()), ShouldEqual, string(tBlock.Txs[1].Hash()))
+			receipt, err := bc.GetReceiptByTxHash(tBlock.Txs[2].Hash())
+			So(err, ShouldBeNil)
+			So(string(receipt.TxHash), ShouldEqual, string(tBlock.Txs[2].Hash()))
+
+			bc.Close()
+			os.RemoveAll("./BlockChainDB/")
+		}
+	})
+}
+
+// failedBatch fails the writes after the limit of writes
+type failedBatch struct {
+	kv.Batch
+	limit     int
+	writes    int
+	committed bool
+	discarded bool
+}
+
+func (b *failedBatch) Put(key []byte, value []byte) error {
+	return b.write()
+}
+
+func (b *failedBatch) Delete(key []byte) error {
+	return b.write()
+}
+
+func (b *failedBatch) write() error {
+	if b.writes >= b.limit {
+		return errors.New("batch too large")
+	}
+	b.writes++
+	return nil
+}
+
+func (b *failedBatch) Commit() error {
+	b.committed = true
+	return nil
+}
+
+func (b *failedBatch) Discard() {
+	b.discarded = true
+}
+
+func TestCheckedBatch(t *testing.T) {
+	Convey("test checked batch", t, func() {
+		fb := &failedBatch{limit: 2}
+		batch := newCheckedBatch(fb)
+		So(batch.Put([]byte("a"), []byte("1")), ShouldBeNil)
+		So(batch.Delete([]byte("b")), ShouldBeNil)
+		So(batch.Commit(), ShouldBeNil)
+		So(fb.committed, ShouldBeTrue)
+
+		fb = &failedBatch{limit: 1}
+		batch = newCheckedBatch(fb)
+		So(batch.Put([]byte("a"), []byte("1")), ShouldBeNil)
+		So(batch.Put([]byte("b"), []byte("2")), ShouldNotBeNil)
+		fb.limit = 10
+		// the writes after the failed one are skipped
+		So(batch.Delete([]byte("c")), ShouldNotBeNil)
+		So(fb.writes, ShouldEqual, 1)
+		So(batch.Commit().Error(), ShouldEqual, "batch too large")
+		So(fb.committed, ShouldBeFalse)
+		So(fb.discarded, ShouldBeTrue)
+	})
+}
+
+func TestChainRange(t *testing.T) {
+	Convey("test range and page of chain", t, func() {
+		a1, _ := account.NewKeyPair(nil, crypto.Secp256k1)
+		bc, err := NewBlockChainWithStorage("./BlockChainDB/", kv.MemoryStorage)
+		So(err, ShouldBeNil)
+		defer bc.Close()
+
+		var last *Block
+		for i := 0; i < 5; i++ {
+			tBlock := &Block{
+				Head: &BlockHead{
+					Version: 2,
+					Number:  int64(i),
+					Witness: a1.ReadablePubkey(),
+				},
+			}
+			for j := 0; j < 4; j++ {
+				txn := tx.NewTx([]*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}, nil, 9999, 100, int64(i*10+j), 0, 0)
+				tBlock.Txs = append(tBlock.Txs, txn)
+				tBlock.Receipts = append(tBlock.Receipts, tx.NewTxReceipt(txn.Hash()))
+			}
+			tBlock.CalculateHeadHash()
+			tBlock.Sign = a1.Sign(tBlock.HeadHash())
+			So(bc.Push(tBlock), ShouldBeNil)
+			last = tBlock
+		}
+
+		blocks, err := bc.GetBlocksByRange(1, 4, false, 0)
+		So(err, ShouldBeNil)
+		So(len(blocks), ShouldEqual, 3)
+		So(blocks[0].Head.Number, ShouldEqual, 1)
+		So(blocks[2].Head.Number, ShouldEqual, 3)
+		So(len(blocks[0].Txs), ShouldEqual, 4)
+
+		blocks, err = bc.GetBlocksByRange(0, 100, true, 2)
+		So(err, ShouldBeNil)
+		So(len(blocks), ShouldEqual, 2)
+		So(blocks[0].Head.Number, ShouldEqual, 4)
+		So(blocks[1].Head.Number, ShouldEqual, 3)
+
+		blocks, err = bc.GetBlocksByRange(-1, 100, false, 2)
+		So(err, ShouldBeNil)
+		So(len(blocks), ShouldEqual, 2)
+		So(blocks[0].Head.Number, ShouldEqual, 0)
+		So(blocks[1].Head.Number, ShouldEqual, 1)
+
+		blocks, err = bc.GetBlocksByRange(3, 100, false, 0)
+		So(err, ShouldBeNil)
+		So(len(blocks), ShouldEqual, 2)
+		So(blocks[1].Head.Number, ShouldEqual, 4)
+
+		blocks, err = bc.GetBlocksByRange(3, 3, false, 0)
+		So(err, ShouldBeNil)
+		So(len(blocks), ShouldEqual, 0)
+		blocks, err = bc.GetBlocksByRange(3, 1, true, 0)
+		So(err, ShouldBeNil)
+		So(len(blocks), ShouldEqual, 0)
+		blocks, err = bc.GetBlocksByRange(5, 10, false, 0)
+		So(err, ShouldBeNil)
+		So(len(blocks), ShouldEqual, 0)
+
+		seen := make(map[string]bool)
+		var after []byte
+		for {
+			receipts, err := bc.GetBlockReceipts(last.HeadHash(), after, 3)
+			So(err, ShouldBeNil)
+			if len(receipts) == 0 {
+				break
+			}
+			for _, r := range receipts {
+				seen[string(r.TxHash)] = true
+			}
+			after = receipts[len(receipts)-1].Hash()
+		}
+		So(len(seen), ShouldEqual, 4)
+		for _, t := range last.Txs {
+			So(seen[string(t.Hash())], ShouldBeTrue)
+		}
+
+		txs, err := bc.GetBlockTxs(last.HeadHash(), nil, 2)
+		So(err, ShouldBeNil)
+		So(len(txs), ShouldEqual, 2)
+		txs, err = bc.GetBlockTxs(last.HeadHash(), txs[1].Hash(), 0)
+		So(err, ShouldBeNil)
+		So(len(txs), ShouldEqual, 2)
+	})
+}
+
+func TestChainPrune(t *testing.T) {
+	Convey("test prune of chain", t, func() {
+		a1, _ := account.NewKeyPair(nil, crypto.Secp256k1)
+		bc, err := NewBlockChainWithStorage("./BlockChainDB/", kv.LevelDBStorage)
+		So(err, ShouldBeNil)
+		defer os.RemoveAll("./BlockChainDB/")
+
+		blocks := make([]*Block, 0)
+		for i := 0; i < 5; i++ {
+			tBlock := &Block{
+				Head: &BlockHead{
+					Version: 2,
+					Number:  int64(i),
+					Witness: a1.ReadablePubkey(),
+				},
+			}
+			for j := 0; j < 2; j++ {
+				txn := tx.NewTx([]*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}, nil, 9999, 100, int64(i*10+j), 0, 0)
+				tBlock.Txs = append(tBlock.Txs, txn)
+				tBlock.Receipts = append(tBlock.Receipts, tx.NewTxReceipt(txn.Hash()))
+			}
+			tBlock.CalculateHeadHash()
+			tBlock.Sign = a1.Sign(tBlock.HeadHash())
+			So(bc.Push(tBlock), ShouldBeNil)
+			blocks = append(blocks, tBlock)
+		}
+
+		So(bc.Pruned(), ShouldEqual, 0)
+		So(bc.Prune(3), ShouldBeNil)
+		So(bc.Pruned(), ShouldEqual, 3)
+		So(bc.Compact(), ShouldBeNil)
+
+		_, err = bc.GetBlockByNumber(1)
+		So(err, ShouldEqual, ErrBlockPruned)
+		_, err = bc.GetBlockByHash(blocks[2].HeadHash())
+		So(err, ShouldEqual, ErrBlockPruned)
+		_, err = bc.GetBlocksByRange(2, 4, false, 10)
+		So(err, ShouldEqual, ErrBlockPruned)
+		blk, err := bc.GetBlockHeaderByNumber(1)
+		So(err, ShouldBeNil)
+		So(blk.HeadHash(), ShouldResemble, blocks[1].HeadHash())
+		So(len(blk.Txs), ShouldEqual, 0)
+		has, err := bc.HasTx(blocks[1].Txs[0].Hash())
+		So(err, ShouldBeNil)
+		So(has, ShouldBeFalse)
+		has, err = bc.HasReceipt(blocks[1].Receipts[0].Hash())
+		So(err, ShouldBeNil)
+		So(has, ShouldBeFalse)
+
+		blk, err = bc.GetBlockByNumber(3)
+		So(err, ShouldBeNil)
+		So(len(blk.Txs), ShouldEqual, 2)
+		_, err = bc.GetTx(blocks[3].Txs[0].Hash())
+		So(err, ShouldBeNil)
+		So(bc.TxTotal(), ShouldEqual, 10)
+
+		bc.Close()
+		bc, err = NewBlockChainWithStorage("./BlockChainDB/", kv.LevelDBStorage)
+		So(err, ShouldBeNil)
+		So(bc.Pruned(), ShouldEqual, 3)
+		So(bc.Prune(100), ShouldBeNil)
+		So(bc.Pruned(), ShouldEqual, 5)
+		bc.Close()
+	})
+}
+
+func TestChainTxIndex(t *testing.T) {
+	Convey("test tx indexes of chain", t, func() {
+		a1, _ := account.NewKeyPair(nil, crypto.Secp256k1)
+		defer os.RemoveAll("./BlockChainDB/")
+		pushBlocks := func(bc Chain, start int) []*Block {
+			blocks := make([]*Block, 0)
+			for i := start; i < start+3; i++ {
+				tBlock := &Block{
+					Head: &BlockHead{
+						Version: 2,
+						Number:  int64(i),
+						Witness: a1.ReadablePubkey(),
+					},
+				}
+				for j := 0; j < 2; j++ {
+					txn := tx.NewTx([]*tx.Action{tx.NewAction("contract1", fmt.Sprintf("action%v", j), "[]")}, []string{"bob@active"}, 9999, 100, int64(i*10+j), 0, 0)
+					txn.Publisher = "alice"
+					tBlock.Txs = append(tBlock.Txs, txn)
+					tBlock.Receipts = append(tBlock.Receipts, tx.NewTxReceipt(txn.Hash()))
+				}
+				tBlock.CalculateHeadHash()
+				tBlock.Sign = a1.Sign(tBlock.HeadHash())
+				So(bc.Push(tBlock), ShouldBeNil)
+				blocks = append(blocks, tBlock)
+			}
+			return blocks
+		}
+
+		bc, err := NewBlockChainWithStorage("./BlockChainDB/", kv.LevelDBStorage)
+		So(err, ShouldBeNil)
+		So(bc.TxIndexFrom(), ShouldEqual, -1)
+		pushBlocks(bc, 0)
+		_, err = bc.GetTxsByAccount("alice", TxRoleAny, nil, false, 0)
+		So(err, ShouldEqual, ErrTxIndexDisabled)
+		bc.Close()
+
+		bc, err = NewBlockChainWithOptions("./BlockChainDB/", &Options{StorageType: kv.LevelDBStorage, TxIndex: true})
+		So(err, ShouldBeNil)
+		So(bc.TxIndexFrom(), ShouldEqual, 3)
+		blocks := pushBlocks(bc, 3)
+
+		txs, err := bc.GetTxsByAccount("alice", TxRolePublisher, nil, false, 0)
+		So(err, ShouldBeNil)
+		So(len(txs), ShouldEqual, 6)
+		So(txs[0].BlockNumber, ShouldEqual, 3)
+		So(txs[0].Role, ShouldEqual, TxRolePublisher)
+		So(txs[0].Receipt.TxHash, ShouldResemble, txs[0].Tx.Hash())
+		txs, err = bc.GetTxsByAccount("alice", TxRoleSigner, nil, false, 0)
+		So(err, ShouldBeNil)
+		So(len(txs), ShouldEqual, 0)
+		txs, err = bc.GetTxsByAccount("bob", TxRoleSigner, nil, true, 4)
+		So(err, ShouldBeNil)
+		So(len(txs), ShouldEqual, 4)
+		So(txs[0].BlockNumber, ShouldEqual, 5)
+		txs, err = bc.GetTxsByAccount("bob", TxRoleSigner, txs[3].Cursor, true, 4)
+		So(err, ShouldBeNil)
+		So(len(txs), ShouldEqual, 2)
+		So(txs[1].BlockNumber, ShouldEqual, 3)
+
+		txs, err = bc.GetTxsByContract("contract1", "", nil, false, 0)
+		So(err, ShouldBeNil)
+		So(len(txs), ShouldEqual, 6)
+		txs, err = bc.GetTxsByContract("contract1", "action1", nil, false, 2)
+		So(err, ShouldBeNil)
+		So(len(txs), ShouldEqual, 2)
+		So(txs[0].Tx.Hash(), ShouldResemble, blocks[0].Txs[1].Hash())
+		txs, err = bc.GetTxsByContract("contract1", "action1", txs[1].Cursor, false, 2)
+		So(err, ShouldBeNil)
+		So(len(txs), ShouldEqual, 1)
+		So(txs[0].Tx.Hash(), ShouldResemble, blocks[2].Txs[1].Hash())
+		_, err = bc.GetTxsByContract("contract1", "", []byte("cursor"), false, 0)
+		So(err, ShouldEqual, ErrInvalidCursor)
+
+		numbers := make([]int64, 0)
+		So(bc.BackfillTxIndexes(func(number int64) {
+			numbers = append(numbers, number)
+		}), ShouldBeNil)
+		So(numbers, ShouldResemble, []int64{2, 1, 0})
+		So(bc.TxIndexFrom(), ShouldEqual, 0)
+		txs, err = bc.GetTxsByAccount("alice", TxRoleAny, nil, false, 0)
+		So(err, ShouldBeNil)
+		So(len(txs), ShouldEqual, 12)
+
+		So(bc.Prune(2), ShouldBeNil)
+		txs, err = bc.GetTxsByContract("contract1", "", nil, false, 0)
+		So(err, ShouldBeNil)
+		So(len(txs), ShouldEqual, 8)
+		So(txs[0].BlockNumber, ShouldEqual, 2)
+		bc.Close()
+	})
+}
+
+func TestChainVerify(t *testing.T) {
+	Convey("test verify of chain", t, func() {
+		a1, _ := account.NewKeyPair(nil, crypto.Secp256k1)
+		chain, err := NewBlockChainWithStorage("./BlockChainDB/", kv.LevelDBStorage)
+		So(err, ShouldBeNil)
+		defer os.RemoveAll("./BlockChainDB/")
+		bc := chain.(*BlockChain)
+
+		var parent []byte
+		blocks := make([]*Block, 0)
+		for i := 0; i < 5; i++ {
+			tBlock := &Block{
+				Head: &BlockHead{
+					Version:    2,
+					ParentHash: parent,
+					Number:     int64(i),
+					Witness:    a1.ReadablePubkey(),
+				},
+			}
+			for j := 0; j < 2; j++ {
+				txn := tx.NewTx([]*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}, nil, 9999, 100, int64(i*10+j), 0, 0)
+				tBlock.Txs = append(tBlock.Txs, txn)
+				tBlock.Receipts = append(tBlock.Receipts, tx.NewTxReceipt(txn.Hash()))
+			}
+			tBlock.Head.TxMerkleHash = tBlock.CalculateTxMerkleHash()
+			tBlock.Head.TxReceiptMerkleHash = tBlock.CalculateTxReceiptMerkleHash()
+			tBlock.CalculateHeadHash()
+			tBlock.Sign = a1.Sign(tBlock.HeadHash())
+			So(bc.Push(tBlock), ShouldBeNil)
+			blocks = append(blocks, tBlock)
+			parent = tBlock.HeadHash()
+		}
+
+		issues := make([]*VerifyIssue, 0)
+		report := func(issue *VerifyIssue) {
+			issues = append(issues, issue)
+		}
+		result, err := bc.Verify(false, report)
+		So(err, ShouldBeNil)
+		So(result.Blocks, ShouldEqual, 5)
+		So(result.Txs, ShouldEqual, 10)
+		So(result.Issues, ShouldEqual, 0)
+
+		// break the indexes and the tx total
+		tHash := blocks[2].Txs[0].Hash()
+		So(bc.blockChainDB.Delete(append(txPrefix, tHash...)), ShouldBeNil)
+		orphan := append(common.CopyBytes(txPrefix), make([]byte, 32)...)
+		So(bc.blockChainDB.Put(orphan, append(blocks[1].HeadHash(), make([]byte, 32)...)), ShouldBeNil)
+		So(bc.blockChainDB.Put(blockTxTotal, common.Int64ToBytes(7)), ShouldBeNil)
+		bc.SetTxTotal(7)
+
+		result, err = bc.Verify(false, report)
+		So(err, ShouldBeNil)
+		So(result.Issues, ShouldEqual, 3)
+		So(result.Repaired, ShouldEqual, 0)
+		So(issues[0].Number, ShouldEqual, 2)
+		So(issues[0].Key, ShouldResemble, append(txPrefix, tHash...))
+		_, err = bc.GetTx(tHash)
+		So(err, ShouldNotBeNil)
+
+		result, err = bc.Verify(true, nil)
+		So(err, ShouldBeNil)
+		So(result.Issues, ShouldEqual, 3)
+		So(result.Repaired, ShouldEqual, 3)
+		_, err = bc.GetTx(tHash)
+		So(err, ShouldBeNil)
+		So(bc.TxTotal(), ShouldEqual, 10)
+
+		result, err = bc.Verify(false, nil)
+		So(err, ShouldBeNil)
+		So(result.Issues, ShouldEqual, 0)
+
+		// the broken linkage is reported but not repaired
+		blk := *blocks[3]
+		head := *blk.Head
+		head.ParentHash = blocks[1].HeadHash()
+		blk.Head = &head
+		blk.CalculateHeadHash()
+		So(bc.Push(&blk), ShouldBeNil)
+		So(bc.Length(), ShouldEqual, 4)
+		result, err = bc.Verify(true, nil)
+		So(err, ShouldBeNil)
+		So(result.Issues, ShouldBeGreaterThan, result.Repaired)
+		bc.Close()
+	})
+}
+
 func BenchmarkBlock(b *testing.B) {
 	a1, _ := account.NewKeyPair(nil, crypto.Secp256k1)
 	a2, _ := account.NewKeyPair(nil, crypto.Secp256k1)
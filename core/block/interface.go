@@ -15,11 +15,23 @@ type Chain interface {
 	GetHashByNumber(number int64) ([]byte, error)
 	GetBlockByNumber(number int64) (*Block, error)
 	GetBlockByHash(blockHash []byte) (*Block, error)
+	GetBlockHeaderByNumber(number int64) (*Block, error)
+	GetBlocksByRange(start, end int64, reverse bool, limit int) ([]*Block, error)
+	GetBlockTxs(blockHash []byte, after []byte, limit int) ([]*tx.Tx, error)
+	GetBlockReceipts(blockHash []byte, after []byte, limit int) ([]*tx.TxReceipt, error)
 	GetTx(hash []byte) (*tx.Tx, error)
 	HasTx(hash []byte) (bool, error)
 	GetReceipt(Hash []byte) (*tx.TxReceipt, error)
 	GetReceiptByTxHash(Hash []byte) (*tx.TxReceipt, error)
 	HasReceipt(hash []byte) (bool, error)
+	Pruned() int64
+	Prune(number int64) error
+	Compact() error
+	TxIndexFrom() int64
+	BackfillTxIndexes(progress func(number int64)) error
+	GetTxsByAccount(account string, role TxRole, after []byte, reverse bool, limit int) ([]*IndexedTx, error)
+	GetTxsByContract(contract, action string, after []byte, reverse bool, limit int) ([]*IndexedTx, error)
+	Verify(repair bool, report func(*VerifyIssue)) (*VerifyResult, error)
 	Size() (int64, error)
 	Close()
 	AllDelaytx() ([]*tx.Tx, error)
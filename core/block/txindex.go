@@ -0,0 +1,234 @@
+package block
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/db/kv"
+)
+
+// The secondary indexes of txs, the block number and tx hash of the key is the cursor of paging
+//
+//	accountTxPrefix + account + 0 + block number + tx hash -> the roles of the account
+//	contractTxPrefix + contract + 0 + block number + tx hash -> TxRoleAny
+//	actionTxPrefix + contract + 0 + action name + 0 + block number + tx hash -> TxRoleAny
+var (
+	txIndexFrom      = []byte("TxIndexFrom")
+	accountTxPrefix  = []byte("A")
+	contractTxPrefix = []byte("C")
+	actionTxPrefix   = []byte("a")
+)
+
+// TxRole is the role of account in tx
+type TxRole byte
+
+// The roles of account in tx
+const (
+	TxRoleAny       TxRole = 0
+	TxRolePublisher TxRole = 1 << 0
+	TxRoleSigner    TxRole = 1 << 1
+)
+
+const txCursorSize = 8 + 32
+
+// error of tx indexes
+var (
+	ErrTxIndexDisabled = errors.New("tx index is not enabled")
+	ErrInvalidCursor   = errors.New("invalid cursor")
+)
+
+// IndexedTx is the tx found by the secondary indexes
+type IndexedTx struct {
+	BlockNumber int64
+	Tx          *tx.Tx
+	Receipt     *tx.TxReceipt
+	Role        TxRole // the roles of account, only for GetTxsByAccount
+	Cursor      []byte // the cursor of the next page
+}
+
+func accountTxKeyPrefix(account string) []byte {
+	return append(append(common.CopyBytes(accountTxPrefix), account...), 0)
+}
+
+func contractTxKeyPrefix(contract, action string) []byte {
+	if action == "" {
+		return append(append(common.CopyBytes(contractTxPrefix), contract...), 0)
+	}
+	key := append(append(common.CopyBytes(actionTxPrefix), contract...), 0)
+	return append(append(key, action...), 0)
+}
+
+func txCursor(number int64, hash []byte) []byte {
+	return append(common.Int64ToBytes(number), hash...)
+}
+
+// txIndexKeys returns the index keys of tx with their values
+func txIndexKeys(number int64, t *tx.Tx) map[string][]byte {
+	cursor := txCursor(number, t.Hash())
+	roles := map[string]TxRole{
+		t.Publisher: TxRolePublisher,
+	}
+	for _, signer := range t.Signers {
+		account := strings.Split(signer, "@")[0]
+		roles[account] |= TxRoleSigner
+	}
+	keys := make(map[string][]byte)
+	for account, role := range roles {
+		if account == "" {
+			continue
+		}
+		keys[string(append(accountTxKeyPrefix(account), cursor...))] = []byte{byte(role)}
+	}
+	for _, a := range t.Actions {
+		keys[string(append(contractTxKeyPrefix(a.Contract, ""), cursor...))] = []byte{byte(TxRoleAny)}
+		keys[string(append(contractTxKeyPrefix(a.Contract, a.ActionName), cursor...))] = []byte{byte(TxRoleAny)}
+	}
+	return keys
+}
+
+func putTxIndexes(batch kv.Batch, number int64, t *tx.Tx) {
+	for k, v := range txIndexKeys(number, t) {
+		batch.Put([]byte(k), v)
+	}
+}
+
+func deleteTxIndexes(batch kv.Batch, number int64, t *tx.Tx) {
+	for k := range txIndexKeys(number, t) {
+		batch.Delete([]byte(k))
+	}
+}
+
+// initTxIndex starts the tx indexes from the next block if they are newly enabled,
+// or drops the start of indexes if they are disabled, since the later blocks won't be indexed
+func (bc *BlockChain) initTxIndex(enable bool) error {
+	from, err := bc.blockChainDB.Get(txIndexFrom)
+	if err != nil {
+		return fmt.Errorf("fail to get tx index from, %v", err)
+	}
+	switch {
+	case !enable:
+		bc.txIndexFrom = -1
+		if len(from) != 0 {
+			return bc.blockChainDB.Delete(txIndexFrom)
+		}
+	case len(from) == 0:
+		bc.txIndexFrom = bc.Length()
+		return bc.blockChainDB.Put(txIndexFrom, common.Int64ToBytes(bc.txIndexFrom))
+	default:
+		bc.txIndexFrom = common.BytesToInt64(from)
+	}
+	return nil
+}
+
+// TxIndexFrom returns the number of the first block whose txs are indexed, -1 if the tx indexes are disabled
+func (bc *BlockChain) TxIndexFrom() int64 {
+	bc.rw.RLock()
+	defer bc.rw.RUnlock()
+	return bc.txIndexFrom
+}
+
+// BackfillTxIndexes indexes the txs of the blocks before TxIndexFrom back to the pruned blocks,
+// it can be resumed after interrupted since the blocks are indexed backward one by one
+func (bc *BlockChain) BackfillTxIndexes(progress func(number int64)) error {
+	from := bc.TxIndexFrom()
+	if from < 0 {
+		return ErrTxIndexDisabled
+	}
+	for number := from - 1; number >= bc.Pruned(); number-- {
+		blk, err := bc.GetBlockByNumber(number)
+		if err != nil {
+			return fmt.Errorf("fail to get block %v, err:%v", number, err)
+		}
+		batch := newCheckedBatch(bc.blockChainDB.NewBatch())
+		for _, t := range blk.Txs {
+			putTxIndexes(batch, number, t)
+		}
+		batch.Put(txIndexFrom, common.Int64ToBytes(number))
+		if err := batch.Commit(); err != nil {
+			return fmt.Errorf("fail to index block %v, err:%s", number, err)
+		}
+		bc.rw.Lock()
+		bc.txIndexFrom = number
+		bc.rw.Unlock()
+		if progress != nil {
+			progress(number)
+		}
+	}
+	return nil
+}
+
+// GetTxsByAccount returns at most limit txs in which the account has the role, ordered by block number,
+// starting after the cursor of the previous page. role TxRoleAny matches all the txs of the account
+func (bc *BlockChain) GetTxsByAccount(account string, role TxRole, after []byte, reverse bool, limit int) ([]*IndexedTx, error) {
+	return bc.getIndexedTxs(accountTxKeyPrefix(account), role, after, reverse, limit)
+}
+
+// GetTxsByContract returns at most limit txs calling the contract, or only the action of contract if action
+// is not empty, ordered by block number, starting after the cursor of the previous page
+func (bc *BlockChain) GetTxsByContract(contract, action string, after []byte, reverse bool, limit int) ([]*IndexedTx, error) {
+	return bc.getIndexedTxs(contractTxKeyPrefix(contract, action), TxRoleAny, after, reverse, limit)
+}
+
+func (bc *BlockChain) getIndexedTxs(prefix []byte, role TxRole, after []byte, reverse bool, limit int) ([]*IndexedTx, error) {
+	if bc.TxIndexFrom() < 0 {
+		return nil, ErrTxIndexDisabled
+	}
+	if len(after) != 0 && len(after) != txCursorSize {
+		return nil, ErrInvalidCursor
+	}
+	opts := &kv.IteratorOptions{
+		Start:   prefix,
+		End:     kv.PrefixEnd(prefix),
+		Reverse: reverse,
+	}
+	if len(after) != 0 {
+		if reverse {
+			opts.End = append(common.CopyBytes(prefix), after...)
+		} else {
+			// the smallest key greater than prefix + after
+			opts.Start = append(append(common.CopyBytes(prefix), after...), 0)
+		}
+	}
+	if role == TxRoleAny {
+		opts.Limit = limit
+	}
+	iter := bc.blockChainDB.NewIterator(opts)
+	txs := make([]*IndexedTx, 0)
+	for (limit <= 0 || len(txs) < limit) && iter.Next() {
+		key := iter.Key()
+		value := iter.Value()
+		if len(key) != len(prefix)+txCursorSize || len(value) == 0 {
+			continue
+		}
+		r := TxRole(value[0])
+		if role != TxRoleAny && r&role == 0 {
+			continue
+		}
+		cursor := common.CopyBytes(key[len(prefix):])
+		txs = append(txs, &IndexedTx{
+			BlockNumber: common.BytesToInt64(cursor[:8]),
+			Role:        r,
+			Cursor:      cursor,
+		})
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("fail to iterate tx indexes: %v", err)
+	}
+	for _, t := range txs {
+		hash := t.Cursor[8:]
+		var err error
+		t.Tx, err = bc.GetTx(hash)
+		if err != nil {
+			return nil, err
+		}
+		t.Receipt, err = bc.GetReceiptByTxHash(hash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return txs, nil
+}
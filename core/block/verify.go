@@ -0,0 +1,485 @@
+package block
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hashicorp/golang-lru"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/db/kv"
+)
+
+const (
+	verifyCacheSize = 1024
+	verifyBatchSize = 10000
+)
+
+// VerifyIssue is a problem of blockchain db found by Verify
+type VerifyIssue struct {
+	Number   int64 // the number of the block, -1 if the key doesn't belong to any block
+	Key      []byte
+	Reason   string
+	Repaired bool
+}
+
+func (i *VerifyIssue) String() string {
+	s := fmt.Sprintf("key %q: %v", i.Key, i.Reason)
+	if i.Number >= 0 {
+		s = fmt.Sprintf("block %v, %v", i.Number, s)
+	}
+	if i.Repaired {
+		s += " (repaired)"
+	}
+	return s
+}
+
+// VerifyResult is the summary of Verify
+type VerifyResult struct {
+	Blocks   int64 // the checked blocks
+	Txs      int64 // the checked txs of the unpruned blocks
+	Issues   int64
+	Repaired int64
+}
+
+// canonicalBlock is the tx and receipt hashes of block on the chain
+type canonicalBlock struct {
+	number   int64
+	txs      map[string]bool
+	receipts map[string]bool
+}
+
+type verifier struct {
+	bc      *BlockChain
+	repair  bool
+	report  func(*VerifyIssue)
+	result  *VerifyResult
+	length  int64
+	pruned  int64
+	batch   kv.Batch
+	pending int
+	cache   *lru.Cache
+	issued  map[string]bool // the keys already reported, which are skipped by the orphan checking
+}
+
+// Verify checks the blocks from genesis to the top, including the parent hash linkage,
+// the merkle roots of txs and receipts, the tx and receipt indexes and the tx total,
+// and then the orphaned keys which don't belong to any block on the chain.
+// The indexes are rebuilt if repair is true, the broken blocks are reported only.
+// It should be called on an offline blockchain db.
+func (bc *BlockChain) Verify(repair bool, report func(*VerifyIssue)) (*VerifyResult, error) {
+	cache, err := lru.New(verifyCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	v := &verifier{
+		bc:     bc,
+		repair: repair,
+		report: report,
+		result: &VerifyResult{},
+		length: bc.Length(),
+		pruned: bc.Pruned(),
+		cache:  cache,
+		issued: make(map[string]bool),
+	}
+	defer v.discard()
+
+	if err := v.verifyBlocks(); err != nil {
+		return nil, err
+	}
+	if err := v.commit(); err != nil {
+		return nil, err
+	}
+	for _, prefix := range [][]byte{txPrefix, txReceiptPrefix, receiptPrefix} {
+		if err := v.verifyIndexOrphans(prefix); err != nil {
+			return nil, err
+		}
+	}
+	if err := v.verifyNumberOrphans(); err != nil {
+		return nil, err
+	}
+	if err := v.verifyBlockOrphans(); err != nil {
+		return nil, err
+	}
+	for _, prefix := range [][]byte{bTxPrefix, bReceiptPrefix} {
+		if err := v.verifyBodyOrphans(prefix); err != nil {
+			return nil, err
+		}
+	}
+	if err := v.commit(); err != nil {
+		return nil, err
+	}
+	return v.result, nil
+}
+
+func (v *verifier) issue(number int64, key []byte, repaired bool, format string, args ...interface{}) {
+	v.issued[string(key)] = true
+	v.result.Issues++
+	if repaired {
+		v.result.Repaired++
+	}
+	if v.report != nil {
+		v.report(&VerifyIssue{
+			Number:   number,
+			Key:      common.CopyBytes(key),
+			Reason:   fmt.Sprintf(format, args...),
+			Repaired: repaired,
+		})
+	}
+}
+
+func (v *verifier) put(key, value []byte) error {
+	if v.batch == nil {
+		v.batch = v.bc.blockChainDB.NewBatch()
+	}
+	if err := v.batch.Put(common.CopyBytes(key), common.CopyBytes(value)); err != nil {
+		return err
+	}
+	return v.flush()
+}
+
+func (v *verifier) delete(key []byte) error {
+	if v.batch == nil {
+		v.batch = v.bc.blockChainDB.NewBatch()
+	}
+	if err := v.batch.Delete(common.CopyBytes(key)); err != nil {
+		return err
+	}
+	return v.flush()
+}
+
+func (v *verifier) flush() error {
+	v.pending++
+	if v.pending < verifyBatchSize {
+		return nil
+	}
+	return v.commit()
+}
+
+func (v *verifier) commit() error {
+	if v.batch == nil {
+		return nil
+	}
+	batch := v.batch
+	v.batch = nil
+	v.pending = 0
+	if err := batch.Commit(); err != nil {
+		return fmt.Errorf("fail to repair blockchain db: %v", err)
+	}
+	return nil
+}
+
+func (v *verifier) discard() {
+	if v.batch != nil {
+		v.batch.Discard()
+		v.batch = nil
+		v.pending = 0
+	}
+}
+
+func (v *verifier) verifyBlocks() error {
+	var parent []byte
+	var txTotal int64
+	complete := v.pruned == 0
+	for number := int64(0); number < v.length; number++ {
+		v.result.Blocks++
+		numberKey := append(common.CopyBytes(blockNumberPrefix), common.Int64ToBytes(number)...)
+		hash, err := v.bc.blockChainDB.Get(numberKey)
+		if err != nil {
+			return fmt.Errorf("fail to get hash of block %v: %v", number, err)
+		}
+		if len(hash) == 0 {
+			v.issue(number, numberKey, false, "missing block hash")
+			parent, complete = nil, false
+			continue
+		}
+		blockKey := append(common.CopyBytes(blockPrefix), hash...)
+		blockByte, err := v.bc.blockChainDB.Get(blockKey)
+		if err != nil {
+			return fmt.Errorf("fail to get block %v: %v", number, err)
+		}
+		if len(blockByte) == 0 {
+			v.issue(number, blockKey, false, "missing block")
+			parent, complete = nil, false
+			continue
+		}
+		var blk Block
+		if err := blk.Decode(blockByte); err != nil {
+			v.issue(number, blockKey, false, "invalid block: %v", err)
+			parent, complete = nil, false
+			continue
+		}
+		if !bytes.Equal(blk.HeadHash(), hash) {
+			v.issue(number, blockKey, false, "block hash mismatch, got %v", common.Base58Encode(blk.HeadHash()))
+		}
+		if blk.Head.Number != number {
+			v.issue(number, blockKey, false, "block number mismatch, got %v", blk.Head.Number)
+		}
+		if parent != nil && !bytes.Equal(blk.Head.ParentHash, parent) {
+			v.issue(number, blockKey, false, "parent hash mismatch, got %v, expect %v",
+				common.Base58Encode(blk.Head.ParentHash), common.Base58Encode(parent))
+		}
+		parent = hash
+		if number < v.pruned {
+			continue
+		}
+		txTotal += int64(len(blk.TxHashes))
+		if err := v.verifyBody(number, hash, &blk); err != nil {
+			return err
+		}
+	}
+	if !complete {
+		// the tx total can't be counted without all the blocks
+		return nil
+	}
+	if txTotal != v.bc.TxTotal() {
+		v.issue(-1, blockTxTotal, v.repair, "tx total mismatch, got %v, expect %v", v.bc.TxTotal(), txTotal)
+		if v.repair {
+			if err := v.put(blockTxTotal, common.Int64ToBytes(txTotal)); err != nil {
+				return err
+			}
+			v.bc.SetTxTotal(txTotal)
+		}
+	}
+	return nil
+}
+
+func (v *verifier) verifyBody(number int64, hash []byte, blk *Block) error {
+	if len(blk.TxHashes) != len(blk.ReceiptHashes) {
+		v.issue(number, append(common.CopyBytes(blockPrefix), hash...), false,
+			"%v txs with %v receipts", len(blk.TxHashes), len(blk.ReceiptHashes))
+		return nil
+	}
+	txsMap, err := v.bc.getBlockTxsMap(hash)
+	if err != nil {
+		return err
+	}
+	receiptMap, err := v.bc.getBlockReceiptMap(hash)
+	if err != nil {
+		return err
+	}
+
+	complete := true
+	for i, tHash := range blk.TxHashes {
+		v.result.Txs++
+		rHash := blk.ReceiptHashes[i]
+		if t, ok := txsMap[string(tHash)]; ok {
+			blk.Txs = append(blk.Txs, t)
+		} else {
+			v.issue(number, append(append(common.CopyBytes(bTxPrefix), hash...), tHash...), false, "missing tx")
+			complete = false
+		}
+		if r, ok := receiptMap[string(rHash)]; ok {
+			blk.Receipts = append(blk.Receipts, r)
+		} else {
+			v.issue(number, append(append(common.CopyBytes(bReceiptPrefix), hash...), rHash...), false, "missing tx receipt")
+			complete = false
+		}
+		delete(txsMap, string(tHash))
+		delete(receiptMap, string(rHash))
+
+		indexes := []struct {
+			key   []byte
+			value []byte
+		}{
+			{append(common.CopyBytes(txPrefix), tHash...), append(common.CopyBytes(hash), tHash...)},
+			{append(common.CopyBytes(txReceiptPrefix), tHash...), append(common.CopyBytes(hash), rHash...)},
+			{append(common.CopyBytes(receiptPrefix), rHash...), append(common.CopyBytes(hash), rHash...)},
+		}
+		for _, index := range indexes {
+			if err := v.verifyIndex(number, index.key, index.value); err != nil {
+				return err
+			}
+		}
+	}
+	for tHash := range txsMap {
+		v.issue(number, append(append(common.CopyBytes(bTxPrefix), hash...), tHash...), false, "orphaned tx of the block")
+	}
+	for rHash := range receiptMap {
+		v.issue(number, append(append(common.CopyBytes(bReceiptPrefix), hash...), rHash...), false, "orphaned tx receipt of the block")
+	}
+	if !complete {
+		return nil
+	}
+	blockKey := append(common.CopyBytes(blockPrefix), hash...)
+	if !bytes.Equal(blk.CalculateTxMerkleHash(), blk.Head.TxMerkleHash) {
+		v.issue(number, blockKey, false, "tx merkle hash mismatch")
+	}
+	if !bytes.Equal(blk.CalculateTxReceiptMerkleHash(), blk.Head.TxReceiptMerkleHash) {
+		v.issue(number, blockKey, false, "tx receipt merkle hash mismatch")
+	}
+	return nil
+}
+
+func (v *verifier) verifyIndex(number int64, key, expect []byte) error {
+	value, err := v.bc.blockChainDB.Get(key)
+	if err != nil {
+		return fmt.Errorf("fail to get index of block %v: %v", number, err)
+	}
+	if bytes.Equal(value, expect) {
+		return nil
+	}
+	if len(value) == 0 {
+		v.issue(number, key, v.repair, "missing index")
+	} else {
+		v.issue(number, key, v.repair, "wrong index %v", common.Base58Encode(value))
+	}
+	if v.repair {
+		return v.put(key, expect)
+	}
+	return nil
+}
+
+// canonical returns the block of hash if it is on the chain, or nil
+func (v *verifier) canonical(hash []byte) (*canonicalBlock, error) {
+	if b, ok := v.cache.Get(string(hash)); ok {
+		return b.(*canonicalBlock), nil
+	}
+	blockByte, err := v.bc.blockChainDB.Get(append(common.CopyBytes(blockPrefix), hash...))
+	if err != nil {
+		return nil, fmt.Errorf("fail to get block: %v", err)
+	}
+	var b *canonicalBlock
+	var blk Block
+	if len(blockByte) != 0 && blk.Decode(blockByte) == nil && blk.Head.Number < v.length {
+		h, err := v.bc.blockChainDB.Get(append(common.CopyBytes(blockNumberPrefix), common.Int64ToBytes(blk.Head.Number)...))
+		if err != nil {
+			return nil, fmt.Errorf("fail to get hash of block %v: %v", blk.Head.Number, err)
+		}
+		if bytes.Equal(h, hash) {
+			b = &canonicalBlock{
+				number:   blk.Head.Number,
+				txs:      make(map[string]bool, len(blk.TxHashes)),
+				receipts: make(map[string]bool, len(blk.ReceiptHashes)),
+			}
+			for _, tHash := range blk.TxHashes {
+				b.txs[string(tHash)] = true
+			}
+			for _, rHash := range blk.ReceiptHashes {
+				b.receipts[string(rHash)] = true
+			}
+		}
+	}
+	v.cache.Add(string(hash), b)
+	return b, nil
+}
+
+// verifyIndexOrphans checks the tx and receipt indexes which don't point to the blocks on the chain
+func (v *verifier) verifyIndexOrphans(prefix []byte) error {
+	iter := v.bc.blockChainDB.NewIteratorByPrefix(prefix)
+	defer iter.Release()
+	for iter.Next() {
+		key := iter.Key()
+		if v.issued[string(key)] {
+			continue
+		}
+		value := iter.Value()
+		hash := key[len(prefix):]
+		orphaned := len(value) <= 32
+		if !orphaned {
+			b, err := v.canonical(value[:32])
+			if err != nil {
+				return err
+			}
+			switch {
+			case b == nil:
+				orphaned = true
+			case bytes.Equal(prefix, receiptPrefix):
+				orphaned = !b.receipts[string(hash)] || !bytes.Equal(value[32:], hash)
+			default:
+				orphaned = !b.txs[string(hash)]
+			}
+		}
+		if !orphaned {
+			continue
+		}
+		v.issue(-1, key, v.repair, "orphaned index")
+		if v.repair {
+			if err := v.delete(key); err != nil {
+				return err
+			}
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("fail to iterate indexes: %v", err)
+	}
+	return nil
+}
+
+// verifyNumberOrphans checks the block hashes after the top block
+func (v *verifier) verifyNumberOrphans() error {
+	iter := v.bc.blockChainDB.NewIterator(&kv.IteratorOptions{
+		Start: append(common.CopyBytes(blockNumberPrefix), common.Int64ToBytes(v.length)...),
+		End:   kv.PrefixEnd(blockNumberPrefix),
+	})
+	defer iter.Release()
+	for iter.Next() {
+		key := iter.Key()
+		number := int64(-1)
+		if len(key) == len(blockNumberPrefix)+8 {
+			number = common.BytesToInt64(key[len(blockNumberPrefix):])
+		}
+		v.issue(number, key, v.repair, "block hash after the top block")
+		if v.repair {
+			if err := v.delete(key); err != nil {
+				return err
+			}
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("fail to iterate block hashes: %v", err)
+	}
+	return nil
+}
+
+// verifyBlockOrphans checks the blocks which are not on the chain
+func (v *verifier) verifyBlockOrphans() error {
+	iter := v.bc.blockChainDB.NewIteratorByPrefix(blockPrefix)
+	defer iter.Release()
+	for iter.Next() {
+		key := iter.Key()
+		if v.issued[string(key)] {
+			continue
+		}
+		b, err := v.canonical(key[len(blockPrefix):])
+		if err != nil {
+			return err
+		}
+		if b == nil {
+			v.issue(-1, key, false, "orphaned block")
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("fail to iterate blocks: %v", err)
+	}
+	return nil
+}
+
+// verifyBodyOrphans checks the txs and receipts of the blocks which are not on the chain or pruned
+func (v *verifier) verifyBodyOrphans(prefix []byte) error {
+	iter := v.bc.blockChainDB.NewIteratorByPrefix(prefix)
+	defer iter.Release()
+	for iter.Next() {
+		key := iter.Key()
+		if bytes.Equal(key, blockLength) || bytes.Equal(key, blockTxTotal) || bytes.Equal(key, blockPruned) {
+			continue
+		}
+		if v.issued[string(key)] {
+			continue
+		}
+		if len(key) > len(prefix)+32 {
+			b, err := v.canonical(key[len(prefix) : len(prefix)+32])
+			if err != nil {
+				return err
+			}
+			if b != nil && b.number >= v.pruned {
+				// the body of the block on the chain is checked by verifyBody
+				continue
+			}
+		}
+		v.issue(-1, key, false, "orphaned block body")
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("fail to iterate block bodies: %v", err)
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/event"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/db"
 	"github.com/iost-official/go-iost/db/wal"
@@ -331,7 +332,7 @@ func (bc *BlockCacheImpl) nmdel(num int64) {
 
 // NewBlockCache return a new BlockCache instance
 func NewBlockCache(baseVariable global.BaseVariable) (*BlockCacheImpl, error) {
-	w, err := wal.Create(baseVariable.Config().DB.LdbPath+blockCacheWALDir, []byte("block_cache_wal"))
+	w, err := createWAL(baseVariable.Config())
 	if err != nil {
 		return nil, err
 	}
@@ -385,10 +386,10 @@ func NewBlockCache(baseVariable global.BaseVariable) (*BlockCacheImpl, error) {
 
 // NewWAL New wal when old one is not recoverable. Move Old File into Corrupted for later analysis.
 func (bc *BlockCacheImpl) NewWAL(config *common.Config) (err error) {
-	walPath := config.DB.LdbPath + blockCacheWALDir
-	corruptWalPath := config.DB.LdbPath + blockCacheWALDir + "Corrupted"
+	walPath := WALDir(config)
+	corruptWalPath := walPath + "Corrupted"
 	os.Rename(walPath, corruptWalPath)
-	bc.wal, err = wal.Create(walPath, []byte("block_cache_wal"))
+	bc.wal, err = createWAL(config)
 	return
 
 }
@@ -568,7 +569,63 @@ func (bc *BlockCacheImpl) Link(bcn *BlockCacheNode, replay bool) {
 		bc.AddNodeToWAL(bcn)
 	}
 	if bcn.Head.Number > bc.Head().Head.Number || (bcn.Head.Number == bc.Head().Head.Number && bcn.Head.Time < bc.Head().Head.Time) {
+		head := bc.Head()
 		bc.SetHead(bcn)
+		if !replay {
+			postHeadEvents(head, bcn)
+		}
+	}
+}
+
+// postHeadEvents posts the event of new head, and the event of chain reorg if the new head isn't a child of the old one
+func postHeadEvents(oldHead, newHead *BlockCacheNode) {
+	if oldHead == newHead {
+		return
+	}
+	ec := event.GetCollector()
+	if ec.HasSubscriber(event.NewHeadBlock) {
+		ec.Post(event.NewJSONEvent(event.NewHeadBlock, event.NewBlockInfo(newHead.Block)))
+	}
+	if oldHead == nil || newHead.GetParent() == oldHead || !ec.HasSubscriber(event.ChainReorg) {
+		return
+	}
+	reorg := &event.ReorgInfo{
+		OldHead: event.NewBlockInfo(oldHead.Block),
+		NewHead: event.NewBlockInfo(newHead.Block),
+		Dropped: make([]*event.BlockInfo, 0),
+		Added:   make([]*event.BlockInfo, 0),
+	}
+	// walk back to the fork point, the nodes deleted from block cache have no parent
+	added := make([]*BlockCacheNode, 0)
+	for o, n := oldHead, newHead; o != n && o != nil && n != nil; {
+		if o.Head.Number >= n.Head.Number {
+			reorg.Dropped = append(reorg.Dropped, event.NewBlockInfo(o.Block))
+			o = o.GetParent()
+		} else {
+			added = append(added, n)
+			n = n.GetParent()
+		}
+	}
+	for i := len(added) - 1; i >= 0; i-- {
+		reorg.Added = append(reorg.Added, event.NewBlockInfo(added[i].Block))
+	}
+	ec.Post(event.NewJSONEvent(event.ChainReorg, reorg))
+}
+
+// postLibEvents posts the events of the new irreversible block, which are tagged with the same cursors as the
+// ones replayed from the chain, so the clients can resume from the last event they received
+func postLibEvents(blk *block.Block) {
+	ec := event.GetCollector()
+	if ec.HasSubscriber(event.NewLibBlock) {
+		e := event.NewJSONEvent(event.NewLibBlock, event.NewBlockInfo(blk))
+		e.Cursor = event.BlockCursor(blk.Head.Number)
+		ec.Post(e)
+	}
+	if ec.HasSubscriber(event.ContractReceipt) {
+		events, metas := event.ReceiptEvents(blk)
+		for i, e := range events {
+			ec.Post(e, metas[i])
+		}
 	}
 }
 
@@ -629,11 +686,13 @@ func (bc *BlockCacheImpl) updateLongest() {
 	if ok {
 		return
 	}
+	head := bc.Head()
 	for bcn := range bc.leaf {
 		if bcn.Head.Number > bc.Head().Head.Number || (bcn.Head.Number == bc.Head().Head.Number && bcn.Head.Time < bc.Head().Head.Time) {
 			bc.SetHead(bcn)
 		}
 	}
+	postHeadEvents(head, bc.Head())
 }
 
 // Add is add a block
@@ -743,6 +802,8 @@ func (bc *BlockCacheImpl) flush(bcn *BlockCacheNode) {
 	err := bc.blockChain.Push(bcn.Block)
 	if err != nil {
 		ilog.Errorf("Database error, BlockChain Push err: %v %v", bcn.HeadHash(), err)
+	} else {
+		postLibEvents(bcn.Block)
 	}
 
 	err = bc.writeUpdateLinkedRootWitnessWAL()
@@ -10,6 +10,8 @@ import (
 
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/event"
+	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/vm/database"
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -292,6 +294,47 @@ func TestVote(t *testing.T) {
 	})
 }
 
+func TestPostLibEvents(t *testing.T) {
+	Convey("test post lib events", t, func() {
+		t1 := tx.NewTx(nil, nil, 100000, 100, 0, 0, 0)
+		t1.Publisher = "alice"
+		t2 := tx.NewTx(nil, nil, 100000, 100, 0, 0, 0)
+		t2.Publisher = "bob"
+		r1 := tx.NewTxReceipt(t1.Hash())
+		r1.Receipts = []*tx.Receipt{{FuncName: "token.iost/transfer", Content: "r1"}}
+		r2 := tx.NewTxReceipt(t2.Hash())
+		r2.Receipts = []*tx.Receipt{
+			{FuncName: "token.iost/transfer", Content: "r2"},
+			{FuncName: "token.iost/transfer", Content: "r3"},
+		}
+		blk := &block.Block{
+			Head:     &block.BlockHead{Number: 9, Time: 1000},
+			Txs:      []*tx.Tx{t1, t2},
+			Receipts: []*tx.TxReceipt{r1, r2},
+		}
+
+		ec := event.GetCollector()
+		libCh := ec.Subscribe(1, []event.Topic{event.NewLibBlock}, nil)
+		defer ec.Unsubscribe(1, []event.Topic{event.NewLibBlock})
+		receiptCh := ec.Subscribe(2, []event.Topic{event.ContractReceipt}, &event.Meta{Accounts: []string{"bob"}})
+		defer ec.Unsubscribe(2, []event.Topic{event.ContractReceipt})
+		postLibEvents(blk)
+
+		e := <-libCh
+		So(e.Cursor, ShouldResemble, event.BlockCursor(9))
+		cursors := make(map[string]*event.Cursor)
+		for i := 0; i < 2; i++ {
+			e := <-receiptCh
+			So(e.Topic, ShouldEqual, event.ContractReceipt)
+			cursors[e.Data] = e.Cursor
+		}
+		So(cursors, ShouldResemble, map[string]*event.Cursor{
+			"r2": {BlockNumber: 9, TxIndex: 1, ReceiptIndex: 0},
+			"r3": {BlockNumber: 9, TxIndex: 1, ReceiptIndex: 1},
+		})
+	})
+}
+
 func StringSliceEqual(a, b []string) bool {
 	if len(a) != len(b) {
 		return false
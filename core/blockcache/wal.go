@@ -0,0 +1,97 @@
+package blockcache
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/db/wal"
+)
+
+var walMetadata = []byte("block_cache_wal")
+
+// WALDir returns the directory of block cache wal
+func WALDir(conf *common.Config) string {
+	return conf.DB.LdbPath + blockCacheWALDir
+}
+
+func createWAL(conf *common.Config) (*wal.WAL, error) {
+	opts := &wal.Options{}
+	if conf.DB.WALCompress {
+		opts.Compression = wal.CompressionType_snappyCompression
+	}
+	return wal.CreateWithOptions(WALDir(conf), walMetadata, opts)
+}
+
+// InspectWAL writes the records of block cache wal in the directory to w, the records
+// after the first corrupted one are dropped when the wal is recovered.
+func InspectWAL(dir string, w io.Writer) error {
+	entries := 0
+	err := wal.Inspect(dir, func(r *wal.Record) error {
+		fmt.Fprintf(w, "%v:%v size=%v compressed=%v ", r.File, r.Offset, r.Size, r.Compressed)
+		switch r.Type {
+		case wal.LogType_crcType:
+			fmt.Fprintf(w, "type=crc crc=%v\n", wal.BytesToUint64(r.Data))
+		case wal.LogType_metaDataType:
+			fmt.Fprintf(w, "type=metadata metadata=%q\n", r.Data)
+		case wal.LogType_entryType:
+			entries++
+			fmt.Fprintf(w, "index=%v %v\n", r.Entry.Index, describeWALEntry(r.Entry))
+		default:
+			fmt.Fprintf(w, "type=unknown(%v)\n", r.Type)
+		}
+		return nil
+	})
+	if e, ok := err.(*wal.CorruptedError); ok {
+		fmt.Fprintf(w, "%v, the wal will be truncated here when recovering\n", e)
+		err = nil
+	}
+	fmt.Fprintf(w, "%v entries\n", entries)
+	return err
+}
+
+func describeWALEntry(entry *wal.Entry) string {
+	var bcMessage BcMessage
+	if err := proto.Unmarshal(entry.Data, &bcMessage); err != nil {
+		return fmt.Sprintf("type=invalid err=%v", err)
+	}
+	switch bcMessage.Type {
+	case BcMessageType_LinkType:
+		blk, witnessList, serialNum, err := decodeBCN(bcMessage.Data)
+		if err != nil {
+			return fmt.Sprintf("type=link err=%v", err)
+		}
+		return fmt.Sprintf("type=link number=%v hash=%v parent=%v witness=%v txs=%v serial=%v active=[%v] pending=[%v]",
+			blk.Head.Number,
+			common.Base58Encode(blk.HeadHash()),
+			common.Base58Encode(blk.Head.ParentHash),
+			blk.Head.Witness,
+			len(blk.Txs),
+			serialNum,
+			strings.Join(witnessList.Active(), ","),
+			strings.Join(witnessList.Pending(), ","),
+		)
+	case BcMessageType_UpdateActiveType:
+		hash, witnessList, err := decodeUpdateActive(bcMessage.Data)
+		if err != nil {
+			return fmt.Sprintf("type=update-active err=%v", err)
+		}
+		return fmt.Sprintf("type=update-active hash=%v active=[%v]",
+			common.Base58Encode(hash),
+			strings.Join(witnessList.Active(), ","),
+		)
+	case BcMessageType_UpdateLinkedRootWitnessType:
+		hash, witness, err := decodeUpdateLinkedRootWitness(bcMessage.Data)
+		if err != nil {
+			return fmt.Sprintf("type=update-linked-root-witness err=%v", err)
+		}
+		return fmt.Sprintf("type=update-linked-root-witness hash=%v witness=[%v]",
+			common.Base58Encode(hash),
+			strings.Join(witness, ","),
+		)
+	default:
+		return fmt.Sprintf("type=unknown(%v)", bcMessage.Type)
+	}
+}
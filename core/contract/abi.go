@@ -0,0 +1,138 @@
+package contract
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The types of ABI arguments.
+const (
+	ArgString = "string"
+	ArgBool   = "bool"
+	ArgNumber = "number"
+	ArgJSON   = "json"
+)
+
+// ArgError is the error of an argument encoded or decoded against ABI.
+type ArgError struct {
+	Index int
+	Type  string
+	Err   error
+}
+
+// Error implements error.
+func (e *ArgError) Error() string {
+	return fmt.Sprintf("arg %v (%v): %v", e.Index, e.Type, e.Err)
+}
+
+// EncodeArgs validates the arguments against the abi, and encodes them into the json array of action data,
+// which follows the rules of vm.UnmarshalArgs. A "string" argument is used as it is, the others are in json.
+// The errors of each invalid argument are returned in errs, err is returned if the count of arguments is unmatched.
+func (a *ABI) EncodeArgs(args []string) (data string, errs []*ArgError, err error) {
+	if len(args) != len(a.Args) {
+		return "", nil, fmt.Errorf("args length unmatched to abi %v. need %v, got %v", a.Name, len(a.Args), len(args))
+	}
+	values := make([]json.RawMessage, 0, len(args))
+	for i, arg := range args {
+		v, err := encodeArg(a.Args[i], arg)
+		if err != nil {
+			errs = append(errs, &ArgError{Index: i, Type: a.Args[i], Err: err})
+			continue
+		}
+		values = append(values, v)
+	}
+	if len(errs) > 0 {
+		return "", errs, nil
+	}
+	buf, err := json.Marshal(values)
+	if err != nil {
+		return "", nil, err
+	}
+	return string(buf), nil, nil
+}
+
+func encodeArg(typ string, arg string) (json.RawMessage, error) {
+	switch typ {
+	case ArgString:
+		return json.Marshal(arg)
+	case ArgBool:
+		if arg != "true" && arg != "false" {
+			return nil, fmt.Errorf("invalid bool %q, should be true or false", arg)
+		}
+		return json.RawMessage(arg), nil
+	case ArgNumber:
+		n, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q, should be an int64", arg)
+		}
+		return json.RawMessage(strconv.FormatInt(n, 10)), nil
+	case ArgJSON:
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, []byte(arg)); err != nil {
+			return nil, fmt.Errorf("invalid json %q, %v", arg, err)
+		}
+		return json.RawMessage(buf.Bytes()), nil
+	default:
+		return nil, fmt.Errorf("unknown abi type %v", typ)
+	}
+}
+
+// DecodeArgs decodes the json array of action data or receipt content against the abi. The arguments are
+// rendered in the same way as EncodeArgs accepts, so EncodeArgs(DecodeArgs(data)) is data.
+func (a *ABI) DecodeArgs(data string) (args []string, errs []*ArgError, err error) {
+	if strings.HasSuffix(data, ",]") {
+		data = data[:len(data)-2] + "]"
+	}
+	var values []json.RawMessage
+	if err := json.Unmarshal([]byte(data), &values); err != nil {
+		return nil, nil, fmt.Errorf("error args should be array, %v", err)
+	}
+	if len(values) != len(a.Args) {
+		return nil, nil, fmt.Errorf("args length unmatched to abi %v. need %v, got %v", a.Name, len(a.Args), len(values))
+	}
+	args = make([]string, 0, len(values))
+	for i, v := range values {
+		arg, err := decodeArg(a.Args[i], v)
+		if err != nil {
+			errs = append(errs, &ArgError{Index: i, Type: a.Args[i], Err: err})
+			arg = string(v)
+		}
+		args = append(args, arg)
+	}
+	return args, errs, nil
+}
+
+func decodeArg(typ string, v json.RawMessage) (string, error) {
+	switch typ {
+	case ArgString:
+		var s string
+		if err := json.Unmarshal(v, &s); err != nil {
+			return "", errors.New("not a string")
+		}
+		return s, nil
+	case ArgBool:
+		var b bool
+		if err := json.Unmarshal(v, &b); err != nil {
+			return "", errors.New("not a bool")
+		}
+		return strconv.FormatBool(b), nil
+	case ArgNumber:
+		var n int64
+		if err := json.Unmarshal(v, &n); err != nil {
+			return "", errors.New("not an int64")
+		}
+		return strconv.FormatInt(n, 10), nil
+	case ArgJSON:
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, v); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	default:
+		return "", fmt.Errorf("unknown abi type %v", typ)
+	}
+}
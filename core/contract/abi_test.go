@@ -0,0 +1,46 @@
+package contract
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestABIEncodeArgs(t *testing.T) {
+	abi := &ABI{Name: "transfer", Args: []string{"string", "bool", "number", "json"}}
+
+	data, errs, err := abi.EncodeArgs([]string{"iost", "true", "-12", `{"a": [1, 2]}`})
+	assert.Nil(t, err)
+	assert.Nil(t, errs)
+	assert.Equal(t, `["iost",true,-12,{"a":[1,2]}]`, data)
+
+	_, errs, err = abi.EncodeArgs([]string{"123", "yes", "1.5", `{"a":`})
+	assert.Nil(t, err)
+	assert.Len(t, errs, 3)
+	assert.Equal(t, 1, errs[0].Index)
+	assert.Equal(t, "bool", errs[0].Type)
+	assert.Equal(t, 2, errs[1].Index)
+	assert.Equal(t, 3, errs[2].Index)
+
+	_, _, err = abi.EncodeArgs([]string{"iost"})
+	assert.NotNil(t, err)
+}
+
+func TestABIDecodeArgs(t *testing.T) {
+	abi := &ABI{Name: "transfer", Args: []string{"string", "bool", "number", "json"}}
+
+	args, errs, err := abi.DecodeArgs(`["iost", false, 100, {"a": 1},]`)
+	assert.Nil(t, err)
+	assert.Nil(t, errs)
+	assert.Equal(t, []string{"iost", "false", "100", `{"a":1}`}, args)
+	data, _, _ := abi.EncodeArgs(args)
+	assert.Equal(t, `["iost",false,100,{"a":1}]`, data)
+
+	args, errs, err = abi.DecodeArgs(`[1, "x", "100", []]`)
+	assert.Nil(t, err)
+	assert.Len(t, errs, 3)
+	assert.Equal(t, []string{"1", `"x"`, `"100"`, "[]"}, args)
+
+	_, _, err = abi.DecodeArgs(`{"a": 1}`)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,95 @@
+package event
+
+import (
+	"strings"
+
+	"github.com/iost-official/go-iost/core/block"
+)
+
+// Cursor is the position of the event in the irreversible blocks. The NewLibBlock event of a block
+// has TxIndex and ReceiptIndex -1, so it is before all the ContractReceipt events of the block.
+type Cursor struct {
+	BlockNumber  int64
+	TxIndex      int32
+	ReceiptIndex int32
+}
+
+// BlockCursor returns the cursor of the block, which is before all the events in the block.
+func BlockCursor(number int64) *Cursor {
+	return &Cursor{BlockNumber: number, TxIndex: -1, ReceiptIndex: -1}
+}
+
+// Less returns whether c is before o.
+func (c *Cursor) Less(o *Cursor) bool {
+	if c.BlockNumber != o.BlockNumber {
+		return c.BlockNumber < o.BlockNumber
+	}
+	if c.TxIndex != o.TxIndex {
+		return c.TxIndex < o.TxIndex
+	}
+	return c.ReceiptIndex < o.ReceiptIndex
+}
+
+// IsChainTopic returns whether the events of topic can be replayed from the irreversible blocks.
+func IsChainTopic(topic Topic) bool {
+	return topic == NewLibBlock || topic == ContractReceipt
+}
+
+// ChainEvents returns the events of the topics in the irreversible block which match the filter, ordered by cursor.
+func ChainEvents(blk *block.Block, topics []Topic, filter *Meta) []*Event {
+	var libBlock, receipt bool
+	for _, topic := range topics {
+		switch topic {
+		case NewLibBlock:
+			libBlock = true
+		case ContractReceipt:
+			receipt = true
+		}
+	}
+	events := make([]*Event, 0)
+	if libBlock {
+		e := NewJSONEvent(NewLibBlock, NewBlockInfo(blk))
+		e.Time = blk.Head.Time
+		e.Cursor = BlockCursor(blk.Head.Number)
+		events = append(events, e)
+	}
+	if !receipt {
+		return events
+	}
+	receipts, metas := ReceiptEvents(blk)
+	for i, e := range receipts {
+		if filter != nil && !filter.Match(metas[i]) {
+			continue
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+// ReceiptEvents returns the ContractReceipt events in the irreversible block ordered by cursor, and the metas to match them.
+func ReceiptEvents(blk *block.Block) ([]*Event, []*Meta) {
+	events := make([]*Event, 0)
+	metas := make([]*Meta, 0)
+	for i, r := range blk.Receipts {
+		var accounts []string
+		if i < len(blk.Txs) {
+			accounts = txAccounts(blk.Txs[i])
+		}
+		for j, rec := range r.Receipts {
+			meta := &Meta{Accounts: accounts}
+			if idx := strings.Index(rec.FuncName, "/"); idx >= 0 {
+				meta.ContractID, meta.ActionName = rec.FuncName[:idx], rec.FuncName[idx+1:]
+			} else {
+				meta.ContractID = rec.FuncName
+			}
+			events = append(events, &Event{
+				Topic:  ContractReceipt,
+				Data:   rec.Content,
+				Time:   blk.Head.Time,
+				Cursor: &Cursor{BlockNumber: blk.Head.Number, TxIndex: int32(i), ReceiptIndex: int32(j)},
+			})
+			metas = append(metas, meta)
+		}
+	}
+	return events, metas
+}
@@ -0,0 +1,58 @@
+package event_test
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/event"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursorLess(t *testing.T) {
+	assert.True(t, event.BlockCursor(3).Less(&event.Cursor{BlockNumber: 3, TxIndex: 0, ReceiptIndex: 0}))
+	assert.True(t, (&event.Cursor{BlockNumber: 3, TxIndex: 0, ReceiptIndex: 1}).Less(&event.Cursor{BlockNumber: 3, TxIndex: 1, ReceiptIndex: 0}))
+	assert.True(t, (&event.Cursor{BlockNumber: 3, TxIndex: 5, ReceiptIndex: 5}).Less(event.BlockCursor(4)))
+	assert.False(t, event.BlockCursor(3).Less(event.BlockCursor(3)))
+}
+
+func TestChainEvents(t *testing.T) {
+	t1 := tx.NewTx([]*tx.Action{tx.NewAction("token.iost", "transfer", "[]")}, nil, 100000, 100, 0, 0, 0)
+	t1.Publisher = "alice"
+	t2 := tx.NewTx([]*tx.Action{tx.NewAction("vote.iost", "vote", "[]")}, []string{"carol@active"}, 100000, 100, 0, 0, 0)
+	t2.Publisher = "bob"
+	r1 := tx.NewTxReceipt(t1.Hash())
+	r1.Receipts = []*tx.Receipt{
+		{FuncName: "token.iost/transfer", Content: "r1"},
+		{FuncName: "token.iost/transfer", Content: "r2"},
+	}
+	r2 := tx.NewTxReceipt(t2.Hash())
+	r2.Receipts = []*tx.Receipt{
+		{FuncName: "vote.iost/vote", Content: "r3"},
+	}
+	blk := &block.Block{
+		Head:     &block.BlockHead{Number: 7, Time: 1000},
+		Txs:      []*tx.Tx{t1, t2},
+		Receipts: []*tx.TxReceipt{r1, r2},
+	}
+
+	events := event.ChainEvents(blk, []event.Topic{event.NewLibBlock, event.ContractReceipt}, nil)
+	assert.Len(t, events, 4)
+	assert.Equal(t, event.NewLibBlock, events[0].Topic)
+	assert.Equal(t, event.BlockCursor(7), events[0].Cursor)
+	for i := 1; i < len(events); i++ {
+		assert.Equal(t, event.ContractReceipt, events[i].Topic)
+		assert.Equal(t, int64(1000), events[i].Time)
+		assert.True(t, events[i-1].Cursor.Less(events[i].Cursor))
+	}
+	assert.Equal(t, "r2", events[2].Data)
+	assert.Equal(t, &event.Cursor{BlockNumber: 7, TxIndex: 0, ReceiptIndex: 1}, events[2].Cursor)
+
+	events = event.ChainEvents(blk, []event.Topic{event.ContractReceipt}, &event.Meta{Accounts: []string{"carol"}})
+	assert.Len(t, events, 1)
+	assert.Equal(t, "r3", events[0].Data)
+	assert.Equal(t, &event.Cursor{BlockNumber: 7, TxIndex: 1, ReceiptIndex: 0}, events[0].Cursor)
+
+	events = event.ChainEvents(blk, []event.Topic{event.ContractReceipt}, &event.Meta{ContractID: "token.iost", ActionName: "issue"})
+	assert.Len(t, events, 0)
+}
@@ -0,0 +1,118 @@
+package event
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// The reasons of PendingTxRemoved event
+const (
+	TxRemovedDeleted  = "deleted"
+	TxRemovedDropped  = "dropped"
+	TxRemovedPacked   = "packed"
+	TxRemovedExpired  = "expired"
+	TxRemovedReplaced = "replaced"
+)
+
+// BlockInfo is the data of NewHeadBlock and NewLibBlock events.
+type BlockInfo struct {
+	Number     int64  `json:"number"`
+	Hash       string `json:"hash"`
+	ParentHash string `json:"parent_hash"`
+	Witness    string `json:"witness"`
+	Time       int64  `json:"time"`
+	TxCount    int    `json:"tx_count"`
+}
+
+// NewBlockInfo returns the BlockInfo of block.
+func NewBlockInfo(blk *block.Block) *BlockInfo {
+	return &BlockInfo{
+		Number:     blk.Head.Number,
+		Hash:       common.Base58Encode(blk.HeadHash()),
+		ParentHash: common.Base58Encode(blk.Head.ParentHash),
+		Witness:    blk.Head.Witness,
+		Time:       blk.Head.Time,
+		TxCount:    len(blk.Txs),
+	}
+}
+
+// ReorgInfo is the data of ChainReorg event. Dropped are the blocks of the old chain after the fork point
+// from the old head backward, Added are the blocks of the new chain after the fork point in order.
+type ReorgInfo struct {
+	OldHead *BlockInfo   `json:"old_head"`
+	NewHead *BlockInfo   `json:"new_head"`
+	Dropped []*BlockInfo `json:"dropped"`
+	Added   []*BlockInfo `json:"added"`
+}
+
+// ActionInfo is the action in TxInfo.
+type ActionInfo struct {
+	Contract   string `json:"contract"`
+	ActionName string `json:"action_name"`
+}
+
+// TxInfo is the data of PendingTxAdded and PendingTxRemoved events, Reason is only set for PendingTxRemoved.
+type TxInfo struct {
+	Hash       string        `json:"hash"`
+	Publisher  string        `json:"publisher"`
+	Signers    []string      `json:"signers"`
+	Actions    []*ActionInfo `json:"actions"`
+	Time       int64         `json:"time"`
+	Expiration int64         `json:"expiration"`
+	GasRatio   int64         `json:"gas_ratio"`
+	Reason     string        `json:"reason,omitempty"`
+}
+
+// NewTxInfo returns the TxInfo of t.
+func NewTxInfo(t *tx.Tx, reason string) *TxInfo {
+	info := &TxInfo{
+		Hash:       common.Base58Encode(t.Hash()),
+		Publisher:  t.Publisher,
+		Signers:    t.Signers,
+		Actions:    make([]*ActionInfo, 0, len(t.Actions)),
+		Time:       t.Time,
+		Expiration: t.Expiration,
+		GasRatio:   t.GasRatio,
+		Reason:     reason,
+	}
+	for _, a := range t.Actions {
+		info.Actions = append(info.Actions, &ActionInfo{Contract: a.Contract, ActionName: a.ActionName})
+	}
+	return info
+}
+
+// TxMetas returns the metas of t, one for each action, with the publisher and signers as the accounts.
+func TxMetas(t *tx.Tx) []*Meta {
+	accounts := txAccounts(t)
+	metas := make([]*Meta, 0, len(t.Actions))
+	for _, a := range t.Actions {
+		metas = append(metas, &Meta{
+			ContractID: a.Contract,
+			ActionName: a.ActionName,
+			Accounts:   accounts,
+		})
+	}
+	return metas
+}
+
+func txAccounts(t *tx.Tx) []string {
+	accounts := []string{t.Publisher}
+	for _, signer := range t.Signers {
+		accounts = append(accounts, strings.Split(signer, "@")[0])
+	}
+	return accounts
+}
+
+// NewJSONEvent generates new event with topic and the json encoded data.
+func NewJSONEvent(topic Topic, data interface{}) *Event {
+	b, err := json.Marshal(data)
+	if err != nil {
+		ilog.Errorf("Marshal %v event data failed. err=%v", topic, err)
+	}
+	return NewEvent(topic, string(b))
+}
@@ -18,6 +18,11 @@ type Topic int
 const (
 	ContractReceipt Topic = iota
 	ContractEvent
+	NewHeadBlock
+	NewLibBlock
+	PendingTxAdded
+	PendingTxRemoved
+	ChainReorg
 )
 
 func (t Topic) String() string {
@@ -26,6 +31,16 @@ func (t Topic) String() string {
 		return "ContractReceipt"
 	case ContractEvent:
 		return "ContractEvent"
+	case NewHeadBlock:
+		return "NewHeadBlock"
+	case NewLibBlock:
+		return "NewLibBlock"
+	case PendingTxAdded:
+		return "PendingTxAdded"
+	case PendingTxRemoved:
+		return "PendingTxRemoved"
+	case ChainReorg:
+		return "ChainReorg"
 	default:
 		return "unknown_topic:" + strconv.Itoa(int(t))
 	}
@@ -33,9 +48,10 @@ func (t Topic) String() string {
 
 // Event is the struct sent to subscriber.
 type Event struct {
-	Topic Topic
-	Data  string
-	Time  int64
+	Topic  Topic
+	Data   string
+	Time   int64
+	Cursor *Cursor // only for the events of irreversible blocks
 }
 
 // NewEvent generate new event with topic and data
@@ -50,6 +66,8 @@ func NewEvent(topic Topic, data string) *Event {
 // Meta is the information abount event.
 type Meta struct {
 	ContractID string
+	ActionName string
+	Accounts   []string
 }
 
 // Match checks whether the given meta argument is matched to self.
@@ -61,9 +79,38 @@ func (m *Meta) Match(meta *Meta) bool {
 	if m.ContractID != "" && m.ContractID != meta.ContractID {
 		return false
 	}
+	if m.ActionName != "" && m.ActionName != meta.ActionName {
+		return false
+	}
+	if len(m.Accounts) > 0 && !containsAny(meta.Accounts, m.Accounts) {
+		return false
+	}
 	return true
 }
 
+func containsAny(accounts []string, targets []string) bool {
+	for _, a := range accounts {
+		for _, t := range targets {
+			if a == t {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchAny(filter *Meta, metas []*Meta) bool {
+	if len(metas) == 0 {
+		return true
+	}
+	for _, meta := range metas {
+		if filter.Match(meta) {
+			return true
+		}
+	}
+	return false
+}
+
 // Subscription is a struct used for listening specific topics
 type Subscription struct {
 	C      chan<- *Event
@@ -108,11 +155,26 @@ func (ec *Collector) Unsubscribe(id int64, topics []Topic) {
 	}
 }
 
-func (ec *Collector) sendEvent(e *Event, meta *Meta) {
+// HasSubscriber returns whether there is any subscription of the topic, so the
+// poster can skip building the event if nobody listens.
+func (ec *Collector) HasSubscriber(topic Topic) bool {
+	m, ok := ec.subMap.Load(topic)
+	if !ok {
+		return false
+	}
+	found := false
+	m.(*sync.Map).Range(func(k, v interface{}) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+func (ec *Collector) sendEvent(e *Event, metas []*Meta) {
 	if m, exist := ec.subMap.Load(e.Topic); exist {
 		m.(*sync.Map).Range(func(k, v interface{}) bool {
 			sub := v.(*Subscription)
-			if sub.filter != nil && !sub.filter.Match(meta) {
+			if sub.filter != nil && !matchAny(sub.filter, metas) {
 				return true
 			}
 			select {
@@ -125,7 +187,8 @@ func (ec *Collector) sendEvent(e *Event, meta *Meta) {
 	}
 }
 
-// Post a event.
-func (ec *Collector) Post(e *Event, meta *Meta) {
-	go ec.sendEvent(e, meta)
+// Post a event. The event is sent to the subscriptions whose filter matches any of the metas,
+// or to all of them if there is no meta.
+func (ec *Collector) Post(e *Event, metas ...*Meta) {
+	go ec.sendEvent(e, metas)
 }
@@ -110,3 +110,58 @@ func TestEventCollectorFullPost(t *testing.T) {
 
 	assert.EqualValues(t, event.EventChSize, atomic.LoadInt32(&count))
 }
+
+func TestMetaMatch(t *testing.T) {
+	meta := &event.Meta{ContractID: "token.iost", ActionName: "transfer", Accounts: []string{"alice", "bob"}}
+
+	assert.True(t, (&event.Meta{}).Match(meta))
+	assert.True(t, (&event.Meta{ContractID: "token.iost"}).Match(meta))
+	assert.True(t, (&event.Meta{ContractID: "token.iost", ActionName: "transfer"}).Match(meta))
+	assert.True(t, (&event.Meta{Accounts: []string{"bob"}}).Match(meta))
+	assert.True(t, (&event.Meta{ActionName: "transfer", Accounts: []string{"carol", "alice"}}).Match(meta))
+	assert.False(t, (&event.Meta{ContractID: "base.iost"}).Match(meta))
+	assert.False(t, (&event.Meta{ContractID: "token.iost", ActionName: "issue"}).Match(meta))
+	assert.False(t, (&event.Meta{Accounts: []string{"carol"}}).Match(meta))
+	assert.False(t, (&event.Meta{Accounts: []string{"alice"}}).Match(&event.Meta{ContractID: "token.iost"}))
+}
+
+func TestEventCollectorPostMetas(t *testing.T) {
+	ilog.Stop()
+	ec := event.GetCollector()
+
+	assert.False(t, ec.HasSubscriber(event.PendingTxAdded))
+	ch1 := ec.Subscribe(11, []event.Topic{event.PendingTxAdded, event.NewHeadBlock}, &event.Meta{Accounts: []string{"alice"}})
+	ch2 := ec.Subscribe(12, []event.Topic{event.PendingTxAdded}, &event.Meta{ContractID: "token.iost", ActionName: "transfer"})
+	assert.True(t, ec.HasSubscriber(event.PendingTxAdded))
+
+	count1 := int32(0)
+	count2 := int32(0)
+	go func() {
+		for range ch1 {
+			atomic.AddInt32(&count1, 1)
+		}
+	}()
+	go func() {
+		for range ch2 {
+			atomic.AddInt32(&count2, 1)
+		}
+	}()
+
+	// the event matches if any meta matches
+	ec.Post(event.NewEvent(event.PendingTxAdded, "tx1"),
+		&event.Meta{ContractID: "vote.iost", ActionName: "vote", Accounts: []string{"alice"}},
+		&event.Meta{ContractID: "token.iost", ActionName: "transfer", Accounts: []string{"alice"}})
+	ec.Post(event.NewEvent(event.PendingTxAdded, "tx2"),
+		&event.Meta{ContractID: "token.iost", ActionName: "issue", Accounts: []string{"bob"}})
+	// the event without meta matches all the filters
+	ec.Post(event.NewEvent(event.NewHeadBlock, "block"))
+
+	time.Sleep(time.Millisecond * 100)
+
+	assert.EqualValues(t, 2, atomic.LoadInt32(&count1))
+	assert.EqualValues(t, 1, atomic.LoadInt32(&count2))
+
+	ec.Unsubscribe(11, []event.Topic{event.PendingTxAdded, event.NewHeadBlock})
+	ec.Unsubscribe(12, []event.Topic{event.PendingTxAdded})
+	assert.False(t, ec.HasSubscriber(event.PendingTxAdded))
+}
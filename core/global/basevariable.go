@@ -8,6 +8,7 @@ import (
 	"github.com/iost-official/go-iost/consensus/snapshot"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/db/kv"
 	"github.com/iost-official/go-iost/ilog"
 )
 
@@ -39,12 +40,25 @@ func New(conf *common.Config) (*BaseVariableImpl, error) {
 		}
 	}
 
-	blockChain, err := block.NewBlockChain(conf.DB.LdbPath + "BlockChainDB")
+	storageType, err := kv.ParseStorageType(conf.DB.StorageType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid db config, stop the program. err: %v", err)
+	}
+
+	blockChain, err := block.NewBlockChainWithOptions(conf.DB.LdbPath+"BlockChainDB", &block.Options{
+		StorageType: storageType,
+		TxIndex:     conf.DB.TxIndex,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("new blockchain failed, stop the program. err: %v", err)
 	}
 
-	stateDB, err := db.NewMVCCDB(conf.DB.LdbPath + "StateDB")
+	stateDB, err := db.NewMVCCDBWithOptions(conf.DB.LdbPath+"StateDB", &db.Options{
+		StorageType: storageType,
+		Archive:     conf.DB.Archive,
+		StateTrie:   conf.DB.StateTrie,
+		DiskCache:   conf.DB.DiskCache,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("new statedb failed, stop the program. err: %v", err)
 	}
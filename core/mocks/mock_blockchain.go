@@ -47,6 +47,18 @@ func (mr *MockChainMockRecorder) AllDelaytx() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllDelaytx", reflect.TypeOf((*MockChain)(nil).AllDelaytx))
 }
 
+// BackfillTxIndexes mocks base method
+func (m *MockChain) BackfillTxIndexes(arg0 func(int64)) error {
+	ret := m.ctrl.Call(m, "BackfillTxIndexes", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackfillTxIndexes indicates an expected call of BackfillTxIndexes
+func (mr *MockChainMockRecorder) BackfillTxIndexes(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillTxIndexes", reflect.TypeOf((*MockChain)(nil).BackfillTxIndexes), arg0)
+}
+
 // CheckLength mocks base method
 func (m *MockChain) CheckLength() {
 	m.ctrl.Call(m, "CheckLength")
@@ -67,6 +79,18 @@ func (mr *MockChainMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockChain)(nil).Close))
 }
 
+// Compact mocks base method
+func (m *MockChain) Compact() error {
+	ret := m.ctrl.Call(m, "Compact")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Compact indicates an expected call of Compact
+func (mr *MockChainMockRecorder) Compact() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compact", reflect.TypeOf((*MockChain)(nil).Compact))
+}
+
 // Draw mocks base method
 func (m *MockChain) Draw(arg0, arg1 int64) string {
 	ret := m.ctrl.Call(m, "Draw", arg0, arg1)
@@ -105,6 +129,19 @@ func (mr *MockChainMockRecorder) GetBlockByNumber(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockByNumber", reflect.TypeOf((*MockChain)(nil).GetBlockByNumber), arg0)
 }
 
+// GetBlockHeaderByNumber mocks base method
+func (m *MockChain) GetBlockHeaderByNumber(arg0 int64) (*block.Block, error) {
+	ret := m.ctrl.Call(m, "GetBlockHeaderByNumber", arg0)
+	ret0, _ := ret[0].(*block.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockHeaderByNumber indicates an expected call of GetBlockHeaderByNumber
+func (mr *MockChainMockRecorder) GetBlockHeaderByNumber(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHeaderByNumber", reflect.TypeOf((*MockChain)(nil).GetBlockHeaderByNumber), arg0)
+}
+
 // GetBlockNumberByTxHash mocks base method
 func (m *MockChain) GetBlockNumberByTxHash(arg0 []byte) (int64, error) {
 	ret := m.ctrl.Call(m, "GetBlockNumberByTxHash", arg0)
@@ -118,6 +155,45 @@ func (mr *MockChainMockRecorder) GetBlockNumberByTxHash(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockNumberByTxHash", reflect.TypeOf((*MockChain)(nil).GetBlockNumberByTxHash), arg0)
 }
 
+// GetBlockReceipts mocks base method
+func (m *MockChain) GetBlockReceipts(arg0, arg1 []byte, arg2 int) ([]*tx.TxReceipt, error) {
+	ret := m.ctrl.Call(m, "GetBlockReceipts", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*tx.TxReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockReceipts indicates an expected call of GetBlockReceipts
+func (mr *MockChainMockRecorder) GetBlockReceipts(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockReceipts", reflect.TypeOf((*MockChain)(nil).GetBlockReceipts), arg0, arg1, arg2)
+}
+
+// GetBlockTxs mocks base method
+func (m *MockChain) GetBlockTxs(arg0, arg1 []byte, arg2 int) ([]*tx.Tx, error) {
+	ret := m.ctrl.Call(m, "GetBlockTxs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*tx.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockTxs indicates an expected call of GetBlockTxs
+func (mr *MockChainMockRecorder) GetBlockTxs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockTxs", reflect.TypeOf((*MockChain)(nil).GetBlockTxs), arg0, arg1, arg2)
+}
+
+// GetBlocksByRange mocks base method
+func (m *MockChain) GetBlocksByRange(arg0, arg1 int64, arg2 bool, arg3 int) ([]*block.Block, error) {
+	ret := m.ctrl.Call(m, "GetBlocksByRange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*block.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlocksByRange indicates an expected call of GetBlocksByRange
+func (mr *MockChainMockRecorder) GetBlocksByRange(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksByRange", reflect.TypeOf((*MockChain)(nil).GetBlocksByRange), arg0, arg1, arg2, arg3)
+}
+
 // GetHashByNumber mocks base method
 func (m *MockChain) GetHashByNumber(arg0 int64) ([]byte, error) {
 	ret := m.ctrl.Call(m, "GetHashByNumber", arg0)
@@ -170,6 +246,32 @@ func (mr *MockChainMockRecorder) GetTx(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockChain)(nil).GetTx), arg0)
 }
 
+// GetTxsByAccount mocks base method
+func (m *MockChain) GetTxsByAccount(arg0 string, arg1 block.TxRole, arg2 []byte, arg3 bool, arg4 int) ([]*block.IndexedTx, error) {
+	ret := m.ctrl.Call(m, "GetTxsByAccount", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*block.IndexedTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxsByAccount indicates an expected call of GetTxsByAccount
+func (mr *MockChainMockRecorder) GetTxsByAccount(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxsByAccount", reflect.TypeOf((*MockChain)(nil).GetTxsByAccount), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetTxsByContract mocks base method
+func (m *MockChain) GetTxsByContract(arg0, arg1 string, arg2 []byte, arg3 bool, arg4 int) ([]*block.IndexedTx, error) {
+	ret := m.ctrl.Call(m, "GetTxsByContract", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*block.IndexedTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxsByContract indicates an expected call of GetTxsByContract
+func (mr *MockChainMockRecorder) GetTxsByContract(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxsByContract", reflect.TypeOf((*MockChain)(nil).GetTxsByContract), arg0, arg1, arg2, arg3, arg4)
+}
+
 // HasReceipt mocks base method
 func (m *MockChain) HasReceipt(arg0 []byte) (bool, error) {
 	ret := m.ctrl.Call(m, "HasReceipt", arg0)
@@ -208,6 +310,30 @@ func (mr *MockChainMockRecorder) Length() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Length", reflect.TypeOf((*MockChain)(nil).Length))
 }
 
+// Prune mocks base method
+func (m *MockChain) Prune(arg0 int64) error {
+	ret := m.ctrl.Call(m, "Prune", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Prune indicates an expected call of Prune
+func (mr *MockChainMockRecorder) Prune(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prune", reflect.TypeOf((*MockChain)(nil).Prune), arg0)
+}
+
+// Pruned mocks base method
+func (m *MockChain) Pruned() int64 {
+	ret := m.ctrl.Call(m, "Pruned")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// Pruned indicates an expected call of Pruned
+func (mr *MockChainMockRecorder) Pruned() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pruned", reflect.TypeOf((*MockChain)(nil).Pruned))
+}
+
 // Push mocks base method
 func (m *MockChain) Push(arg0 *block.Block) error {
 	ret := m.ctrl.Call(m, "Push", arg0)
@@ -256,6 +382,18 @@ func (mr *MockChainMockRecorder) Top() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Top", reflect.TypeOf((*MockChain)(nil).Top))
 }
 
+// TxIndexFrom mocks base method
+func (m *MockChain) TxIndexFrom() int64 {
+	ret := m.ctrl.Call(m, "TxIndexFrom")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// TxIndexFrom indicates an expected call of TxIndexFrom
+func (mr *MockChainMockRecorder) TxIndexFrom() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxIndexFrom", reflect.TypeOf((*MockChain)(nil).TxIndexFrom))
+}
+
 // TxTotal mocks base method
 func (m *MockChain) TxTotal() int64 {
 	ret := m.ctrl.Call(m, "TxTotal")
@@ -267,3 +405,16 @@ func (m *MockChain) TxTotal() int64 {
 func (mr *MockChainMockRecorder) TxTotal() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxTotal", reflect.TypeOf((*MockChain)(nil).TxTotal))
 }
+
+// Verify mocks base method
+func (m *MockChain) Verify(arg0 bool, arg1 func(*block.VerifyIssue)) (*block.VerifyResult, error) {
+	ret := m.ctrl.Call(m, "Verify", arg0, arg1)
+	ret0, _ := ret[0].(*block.VerifyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify
+func (mr *MockChainMockRecorder) Verify(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockChain)(nil).Verify), arg0, arg1)
+}
@@ -0,0 +1,143 @@
+package pruner
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/metrics"
+)
+
+var (
+	metricsPrunedBlock = metrics.NewGauge("iost_prune_pruned_block", nil)
+	metricsTargetBlock = metrics.NewGauge("iost_prune_target_block", nil)
+	metricsCompactTime = metrics.NewSummary("iost_prune_compact_time", []string{"Name"})
+)
+
+const (
+	// the blocks pruned in a step, between which the pruner can be stopped
+	pruneStep       = 1000
+	defaultInterval = 3600
+)
+
+// Pruner prunes the txs, receipts and state history of old blocks and compacts the databases.
+type Pruner struct {
+	blockChain block.Chain
+	stateDB    db.MVCCDB
+	keepBlocks int64
+	interval   time.Duration
+	archive    bool
+
+	quitCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// New returns a Pruner instance.
+func New(blockChain block.Chain, stateDB db.MVCCDB, conf *common.DBConfig) (*Pruner, error) {
+	if conf.Prune == nil {
+		return nil, fmt.Errorf("prune config is not found")
+	}
+	if conf.Prune.KeepBlocks <= 0 {
+		return nil, fmt.Errorf("invalid keepblocks %v of prune config", conf.Prune.KeepBlocks)
+	}
+	interval := conf.Prune.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	return &Pruner{
+		blockChain: blockChain,
+		stateDB:    stateDB,
+		keepBlocks: conf.Prune.KeepBlocks,
+		interval:   time.Duration(interval) * time.Second,
+		archive:    conf.Archive,
+		quitCh:     make(chan struct{}),
+	}, nil
+}
+
+// Start starts the background pruning.
+func (p *Pruner) Start() error {
+	p.wg.Add(1)
+	go p.loop()
+	return nil
+}
+
+// Stop stops the background pruning.
+func (p *Pruner) Stop() {
+	close(p.quitCh)
+	p.wg.Wait()
+}
+
+func (p *Pruner) loop() {
+	defer p.wg.Done()
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		if err := p.Prune(); err != nil {
+			ilog.Errorf("Prune failed: %v", err)
+		}
+		select {
+		case <-p.quitCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Prune prunes the blocks before the last keepBlocks blocks, and then compacts the databases.
+// It returns early without error if the pruner is stopped.
+func (p *Pruner) Prune() error {
+	target := p.blockChain.Length() - p.keepBlocks
+	pruned := p.blockChain.Pruned()
+	metricsTargetBlock.Set(float64(target), nil)
+	metricsPrunedBlock.Set(float64(pruned), nil)
+	if target <= pruned {
+		return nil
+	}
+	ilog.Infof("Pruning blocks from %v to %v", pruned, target)
+	for pruned < target {
+		select {
+		case <-p.quitCh:
+			return nil
+		default:
+		}
+		next := pruned + pruneStep
+		if next > target {
+			next = target
+		}
+		if err := p.blockChain.Prune(next); err != nil {
+			return fmt.Errorf("prune blocks failed: %v", err)
+		}
+		pruned = p.blockChain.Pruned()
+		metricsPrunedBlock.Set(float64(pruned), nil)
+	}
+	if p.archive {
+		hash, err := p.blockChain.GetHashByNumber(target)
+		if err != nil {
+			return fmt.Errorf("get block hash failed: %v", err)
+		}
+		if err := p.stateDB.PruneHistory(string(hash)); err != nil {
+			return fmt.Errorf("prune state history failed: %v", err)
+		}
+	}
+	return p.compact()
+}
+
+func (p *Pruner) compact() error {
+	start := time.Now()
+	if err := p.blockChain.Compact(); err != nil {
+		return fmt.Errorf("compact blockchaindb failed: %v", err)
+	}
+	metricsCompactTime.Observe(time.Since(start).Seconds(), map[string]string{"Name": "BlockChainDB"})
+
+	start = time.Now()
+	if err := p.stateDB.Compact(); err != nil {
+		return fmt.Errorf("compact statedb failed: %v", err)
+	}
+	metricsCompactTime.Observe(time.Since(start).Seconds(), map[string]string{"Name": "StateDB"})
+	ilog.Infof("Pruned blocks before %v", p.blockChain.Pruned())
+	return nil
+}
@@ -0,0 +1,83 @@
+package pruner
+
+import (
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/db/kv"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPruner(t *testing.T) {
+	Convey("test pruner", t, func() {
+		a1, _ := account.NewKeyPair(nil, crypto.Secp256k1)
+		bc, err := block.NewBlockChainWithStorage("./BlockChainDB/", kv.MemoryStorage)
+		So(err, ShouldBeNil)
+		defer bc.Close()
+		stateDB, err := db.NewArchiveMVCCDB("./StateDB/", kv.MemoryStorage)
+		So(err, ShouldBeNil)
+		defer func() {
+			stateDB.Close()
+			os.RemoveAll("./StateDB/")
+		}()
+
+		blocks := make([]*block.Block, 0)
+		for i := 0; i < 10; i++ {
+			blk := &block.Block{
+				Head: &block.BlockHead{
+					Version: 2,
+					Number:  int64(i),
+					Witness: a1.ReadablePubkey(),
+				},
+			}
+			txn := tx.NewTx([]*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}, nil, 9999, 100, int64(i), 0, 0)
+			blk.Txs = append(blk.Txs, txn)
+			blk.Receipts = append(blk.Receipts, tx.NewTxReceipt(txn.Hash()))
+			blk.CalculateHeadHash()
+			blk.Sign = a1.Sign(blk.HeadHash())
+			So(bc.Push(blk), ShouldBeNil)
+			blocks = append(blocks, blk)
+
+			stateDB.Put("table", "key", strconv.Itoa(i))
+			stateDB.Commit(string(blk.HeadHash()))
+			So(stateDB.Flush(string(blk.HeadHash())), ShouldBeNil)
+		}
+
+		_, err = New(bc, stateDB, &common.DBConfig{})
+		So(err, ShouldNotBeNil)
+		p, err := New(bc, stateDB, &common.DBConfig{
+			Archive: true,
+			Prune: &common.PruneConfig{
+				Enable:     true,
+				KeepBlocks: 4,
+			},
+		})
+		So(err, ShouldBeNil)
+		So(p.Prune(), ShouldBeNil)
+		So(bc.Pruned(), ShouldEqual, 6)
+
+		_, err = bc.GetBlockByNumber(5)
+		So(err, ShouldEqual, block.ErrBlockPruned)
+		blk, err := bc.GetBlockByNumber(6)
+		So(err, ShouldBeNil)
+		So(len(blk.Txs), ShouldEqual, 1)
+
+		_, err = stateDB.History(string(blocks[5].HeadHash()))
+		So(err, ShouldEqual, db.ErrTagPruned)
+		h, err := stateDB.History(string(blocks[6].HeadHash()))
+		So(err, ShouldBeNil)
+		value, err := h.Get("table", "key")
+		So(err, ShouldBeNil)
+		So(value, ShouldEqual, "6")
+
+		So(p.Prune(), ShouldBeNil)
+		So(bc.Pruned(), ShouldEqual, 6)
+	})
+}
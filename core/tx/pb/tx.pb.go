@@ -92,6 +92,7 @@ type Tx struct {
 	ReferredTx           []byte             `protobuf:"bytes,12,opt,name=referredTx,proto3" json:"referredTx,omitempty"`
 	AmountLimit          []*contract.Amount `protobuf:"bytes,13,rep,name=amountLimit,proto3" json:"amountLimit,omitempty"`
 	Reserved             []byte             `protobuf:"bytes,14,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	ReplacedTx           []byte             `protobuf:"bytes,15,opt,name=replacedTx,proto3" json:"replacedTx,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -220,6 +221,13 @@ func (m *Tx) GetReserved() []byte {
 	return nil
 }
 
+func (m *Tx) GetReplacedTx() []byte {
+	if m != nil {
+		return m.ReplacedTx
+	}
+	return nil
+}
+
 type Receipt struct {
 	FuncName             string   `protobuf:"bytes,1,opt,name=funcName,proto3" json:"funcName,omitempty"`
 	Content              string   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
@@ -405,42 +413,42 @@ func init() {
 func init() { proto.RegisterFile("core/tx/pb/tx.proto", fileDescriptor_a5cd2a43d9b9fb36) }
 
 var fileDescriptor_a5cd2a43d9b9fb36 = []byte{
-	// 582 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x5f, 0x8b, 0x13, 0x3f,
-	0x14, 0xa5, 0x9d, 0xed, 0xbf, 0xdb, 0xf6, 0xc7, 0x92, 0x9f, 0x48, 0x2c, 0x2a, 0xa5, 0xc8, 0x52,
-	0x1f, 0x76, 0x0a, 0xab, 0x88, 0xae, 0x88, 0xec, 0x83, 0xa0, 0x20, 0x3e, 0x64, 0x2b, 0xf8, 0x26,
-	0x99, 0x99, 0x74, 0x1a, 0xec, 0x4c, 0x86, 0x24, 0xb3, 0x4c, 0xbf, 0x8e, 0x5f, 0xc8, 0xaf, 0x24,
-	0xb9, 0xc9, 0x8c, 0xdd, 0x07, 0xf1, 0xed, 0x9e, 0x9c, 0x7b, 0x4f, 0x6e, 0x0e, 0x27, 0xf0, 0x7f,
-	0xaa, 0xb4, 0xd8, 0xd8, 0x66, 0x53, 0x25, 0x1b, 0xdb, 0xc4, 0x95, 0x56, 0x56, 0x91, 0x33, 0xdb,
-	0x54, 0xc9, 0xe2, 0x3a, 0x97, 0x76, 0x5f, 0x27, 0x71, 0xaa, 0x8a, 0x8d, 0x54, 0xc6, 0x5e, 0xaa,
-	0xdd, 0x4e, 0xa6, 0x92, 0x1f, 0x36, 0xb9, 0xba, 0x74, 0x07, 0x9b, 0x54, 0x1f, 0x2b, 0xab, 0xdc,
-	0xa8, 0x91, 0x79, 0xc9, 0x6d, 0xad, 0x85, 0x57, 0x58, 0xbc, 0xfb, 0xf7, 0xac, 0xbb, 0x37, 0x55,
-	0xa5, 0xd5, 0x3c, 0xb5, 0x5d, 0xe1, 0xc7, 0x57, 0xdf, 0x60, 0x78, 0x93, 0x5a, 0xa9, 0x4a, 0xb2,
-	0x80, 0x71, 0xcb, 0xd1, 0xde, 0xb2, 0xb7, 0x9e, 0xb0, 0x0e, 0x93, 0xa7, 0x00, 0x1c, 0xbb, 0xbe,
-	0xf0, 0x42, 0xd0, 0x3e, 0xb2, 0x27, 0x27, 0x84, 0xc0, 0x59, 0xc6, 0x2d, 0xa7, 0x11, 0x32, 0x58,
-	0xaf, 0x7e, 0x45, 0xd0, 0xdf, 0x36, 0x8e, 0xb2, 0xb2, 0x10, 0x28, 0x19, 0x31, 0xac, 0x9d, 0x9c,
-	0x68, 0x2a, 0xa9, 0xb9, 0x13, 0x40, 0xb9, 0x88, 0x9d, 0x9c, 0xb8, 0x55, 0x72, 0x6e, 0x3e, 0xcb,
-	0x42, 0x5a, 0x94, 0x8c, 0x58, 0x87, 0x03, 0xc7, 0x5c, 0x23, 0x3d, 0xeb, 0x38, 0xc4, 0xe4, 0x02,
-	0x46, 0x7e, 0x29, 0x43, 0x07, 0xcb, 0x68, 0x3d, 0xbd, 0x9a, 0xc5, 0xce, 0xdf, 0xd8, 0xbf, 0x90,
-	0xb5, 0x24, 0xa1, 0x30, 0x72, 0x36, 0x0a, 0x6d, 0xe8, 0x70, 0x19, 0xad, 0x27, 0xac, 0x85, 0xe4,
-	0x02, 0x06, 0xae, 0x34, 0x74, 0x84, 0xf3, 0xe7, 0xb1, 0x91, 0x79, 0x95, 0xc4, 0xb7, 0xad, 0xe9,
-	0xcc, 0xd3, 0xe4, 0x31, 0x4c, 0xaa, 0x3a, 0x39, 0x48, 0xb3, 0x17, 0x9a, 0x8e, 0xf1, 0xd5, 0x7f,
-	0x0e, 0xc8, 0x4b, 0x98, 0x05, 0x70, 0x8b, 0x62, 0x93, 0xbf, 0x88, 0xdd, 0xeb, 0x22, 0x0f, 0x60,
-	0x90, 0x89, 0x03, 0x3f, 0x52, 0xc0, 0x67, 0x79, 0x40, 0x1e, 0xc1, 0x38, 0xdd, 0x73, 0x59, 0x7e,
-	0x97, 0x19, 0x9d, 0x2e, 0x7b, 0xeb, 0x39, 0x1b, 0x21, 0xfe, 0x94, 0x39, 0x1b, 0xb5, 0xd8, 0x09,
-	0xad, 0x45, 0xb6, 0x6d, 0xe8, 0x6c, 0xd9, 0x5b, 0xcf, 0xd8, 0xc9, 0x09, 0xb9, 0x82, 0x29, 0x2f,
-	0x54, 0x5d, 0x5a, 0xef, 0xe4, 0x3c, 0x6c, 0xd1, 0x25, 0xe0, 0x06, 0x49, 0x76, 0xda, 0xe4, 0xec,
-	0xd5, 0xc2, 0x08, 0x7d, 0x27, 0x32, 0xfa, 0x1f, 0x2a, 0x76, 0x78, 0xf5, 0x1e, 0x46, 0x4c, 0xa4,
-	0x42, 0x56, 0xd8, 0xb6, 0xab, 0xcb, 0x14, 0xe3, 0x10, 0xc2, 0xd2, 0x62, 0xe7, 0xae, 0xbb, 0x42,
-	0x94, 0x36, 0x24, 0xa5, 0x85, 0xab, 0x57, 0x30, 0xbc, 0xb5, 0xdc, 0xd6, 0xc6, 0xa5, 0x22, 0x55,
-	0x99, 0x9f, 0x1d, 0x30, 0xac, 0xdd, 0x5c, 0x21, 0x8c, 0xe1, 0x79, 0x9b, 0xb0, 0x16, 0xae, 0x7e,
-	0xf6, 0x61, 0xb2, 0x6d, 0xda, 0xbb, 0x1f, 0xc2, 0xd0, 0x36, 0x1f, 0xb9, 0xd9, 0xe3, 0xf4, 0x8c,
-	0x05, 0x14, 0x92, 0xf1, 0xb5, 0x13, 0xf0, 0xc9, 0x40, 0x4c, 0xde, 0xc0, 0x58, 0xf3, 0xc2, 0x73,
-	0x11, 0xfa, 0xf0, 0xc4, 0x47, 0xa3, 0x93, 0x8d, 0x59, 0xe0, 0x3f, 0x94, 0x56, 0x1f, 0x59, 0xd7,
-	0x4e, 0x9e, 0xc1, 0xd0, 0xe0, 0xd2, 0x18, 0xb7, 0x2e, 0x53, 0xfe, 0x21, 0x2c, 0x70, 0x6e, 0x79,
-	0x2d, 0x6c, 0xad, 0x43, 0xf4, 0x26, 0xac, 0x85, 0xe4, 0xb9, 0x73, 0x14, 0xaf, 0xf0, 0x69, 0x9b,
-	0x5e, 0xcd, 0xbd, 0x42, 0xb8, 0x98, 0x75, 0xf4, 0xe2, 0x2d, 0xcc, 0xef, 0x6d, 0x41, 0xce, 0x21,
-	0xfa, 0x21, 0x8e, 0xc1, 0x61, 0x57, 0xba, 0x90, 0xdc, 0xf1, 0x43, 0xdd, 0xbe, 0xd0, 0x83, 0xeb,
-	0xfe, 0xeb, 0x5e, 0x32, 0xc4, 0x0f, 0xfd, 0xe2, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x09, 0xd7,
-	0x47, 0x80, 0x68, 0x04, 0x00, 0x00,
+	// 591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x5d, 0x8b, 0x13, 0x3d,
+	0x14, 0xa6, 0x9d, 0xed, 0xc7, 0x9c, 0xb6, 0xef, 0xbb, 0x44, 0x91, 0x58, 0x54, 0x4a, 0x91, 0xa5,
+	0x5e, 0xec, 0x14, 0x56, 0x11, 0x5d, 0x11, 0xd9, 0x0b, 0x41, 0x41, 0xf6, 0x22, 0xbb, 0x82, 0x77,
+	0x92, 0xce, 0xa4, 0xd3, 0x60, 0xe7, 0x83, 0x24, 0xb3, 0x4c, 0xff, 0x8e, 0x7f, 0xd0, 0xbf, 0x20,
+	0x39, 0x99, 0xc4, 0xee, 0x85, 0x78, 0x77, 0x9e, 0xf3, 0xe4, 0x3c, 0xe7, 0x63, 0x9e, 0x81, 0x07,
+	0x69, 0xa5, 0xc4, 0xda, 0xb4, 0xeb, 0x7a, 0xb3, 0x36, 0x6d, 0x52, 0xab, 0xca, 0x54, 0xe4, 0xc4,
+	0xb4, 0xf5, 0x66, 0x7e, 0x99, 0x4b, 0xb3, 0x6b, 0x36, 0x49, 0x5a, 0x15, 0x6b, 0x59, 0x69, 0x73,
+	0x5e, 0x6d, 0xb7, 0x32, 0x95, 0x7c, 0xbf, 0xce, 0xab, 0x73, 0x9b, 0x58, 0xa7, 0xea, 0x50, 0x9b,
+	0xca, 0x96, 0x6a, 0x99, 0x97, 0xdc, 0x34, 0x4a, 0x38, 0x85, 0xf9, 0xfb, 0x7f, 0xd7, 0xda, 0xbe,
+	0x69, 0x55, 0x1a, 0xc5, 0x53, 0x13, 0x02, 0x57, 0xbe, 0xfc, 0x06, 0xc3, 0xab, 0xd4, 0xc8, 0xaa,
+	0x24, 0x73, 0x18, 0x7b, 0x8e, 0xf6, 0x16, 0xbd, 0x55, 0xcc, 0x02, 0x26, 0xcf, 0x00, 0x38, 0xbe,
+	0xba, 0xe6, 0x85, 0xa0, 0x7d, 0x64, 0x8f, 0x32, 0x84, 0xc0, 0x49, 0xc6, 0x0d, 0xa7, 0x11, 0x32,
+	0x18, 0x2f, 0x7f, 0x45, 0xd0, 0xbf, 0x6d, 0x2d, 0x65, 0x64, 0x21, 0x50, 0x32, 0x62, 0x18, 0x5b,
+	0x39, 0xd1, 0xd6, 0x52, 0x71, 0x2b, 0x80, 0x72, 0x11, 0x3b, 0xca, 0xd8, 0x51, 0x72, 0xae, 0xbf,
+	0xc8, 0x42, 0x1a, 0x94, 0x8c, 0x58, 0xc0, 0x1d, 0xc7, 0xec, 0x43, 0x7a, 0x12, 0x38, 0xc4, 0xe4,
+	0x0c, 0x46, 0x6e, 0x28, 0x4d, 0x07, 0x8b, 0x68, 0x35, 0xb9, 0x98, 0x26, 0xf6, 0xbe, 0x89, 0xdb,
+	0x90, 0x79, 0x92, 0x50, 0x18, 0xd9, 0x33, 0x0a, 0xa5, 0xe9, 0x70, 0x11, 0xad, 0x62, 0xe6, 0x21,
+	0x39, 0x83, 0x81, 0x0d, 0x35, 0x1d, 0x61, 0xfd, 0x69, 0xa2, 0x65, 0x5e, 0x6f, 0x92, 0x1b, 0x7f,
+	0x74, 0xe6, 0x68, 0xf2, 0x04, 0xe2, 0xba, 0xd9, 0xec, 0xa5, 0xde, 0x09, 0x45, 0xc7, 0xb8, 0xf5,
+	0x9f, 0x04, 0x79, 0x05, 0xd3, 0x0e, 0xdc, 0xa0, 0x58, 0xfc, 0x17, 0xb1, 0x7b, 0xaf, 0xc8, 0x43,
+	0x18, 0x64, 0x62, 0xcf, 0x0f, 0x14, 0x70, 0x2d, 0x07, 0xc8, 0x63, 0x18, 0xa7, 0x3b, 0x2e, 0xcb,
+	0xef, 0x32, 0xa3, 0x93, 0x45, 0x6f, 0x35, 0x63, 0x23, 0xc4, 0x9f, 0x33, 0x7b, 0x46, 0x25, 0xb6,
+	0x42, 0x29, 0x91, 0xdd, 0xb6, 0x74, 0xba, 0xe8, 0xad, 0xa6, 0xec, 0x28, 0x43, 0x2e, 0x60, 0xc2,
+	0x8b, 0xaa, 0x29, 0x8d, 0xbb, 0xe4, 0xac, 0x9b, 0x22, 0x38, 0xe0, 0x0a, 0x49, 0x76, 0xfc, 0xc8,
+	0x9e, 0x57, 0x09, 0x2d, 0xd4, 0x9d, 0xc8, 0xe8, 0x7f, 0xa8, 0x18, 0xb0, 0xeb, 0x57, 0xef, 0x79,
+	0x8a, 0xfd, 0xfe, 0xf7, 0xfd, 0x7c, 0x66, 0xf9, 0x01, 0x46, 0x4c, 0xa4, 0x42, 0xd6, 0x28, 0xb3,
+	0x6d, 0xca, 0xf4, 0x9a, 0x77, 0x5f, 0x3e, 0x66, 0x01, 0xdb, 0xeb, 0xdb, 0x11, 0x44, 0x69, 0x3a,
+	0x27, 0x79, 0xb8, 0x7c, 0x0d, 0xc3, 0x1b, 0xc3, 0x4d, 0xa3, 0xad, 0x6b, 0xd2, 0x2a, 0x73, 0xb5,
+	0x03, 0x86, 0xb1, 0xad, 0x2b, 0x84, 0xd6, 0x3c, 0xf7, 0x0e, 0xf4, 0x70, 0xf9, 0xb3, 0x0f, 0xf1,
+	0x6d, 0xeb, 0x7b, 0x3f, 0x82, 0xa1, 0x69, 0x3f, 0x71, 0xbd, 0xc3, 0xea, 0x29, 0xeb, 0x50, 0xe7,
+	0x9c, 0xaf, 0x41, 0x20, 0x62, 0x01, 0x93, 0xb7, 0x30, 0x56, 0xbc, 0x70, 0x5c, 0x84, 0x77, 0x7a,
+	0xea, 0xac, 0x13, 0x64, 0x13, 0xd6, 0xf1, 0x1f, 0x4b, 0xa3, 0x0e, 0x2c, 0x3c, 0x27, 0xcf, 0x61,
+	0xa8, 0x71, 0x68, 0xb4, 0x63, 0xf0, 0x9c, 0x5b, 0x84, 0x75, 0x9c, 0x1d, 0x5e, 0x09, 0xd3, 0xa8,
+	0xce, 0x9a, 0x31, 0xf3, 0x90, 0xbc, 0xb0, 0x17, 0xc7, 0x16, 0xce, 0x8d, 0x93, 0x8b, 0x99, 0x53,
+	0xe8, 0x1a, 0xb3, 0x40, 0xcf, 0xdf, 0xc1, 0xec, 0xde, 0x14, 0xe4, 0x14, 0xa2, 0x1f, 0xe2, 0xd0,
+	0x5d, 0xd8, 0x86, 0xd6, 0x44, 0x77, 0x7c, 0xdf, 0xf8, 0x0d, 0x1d, 0xb8, 0xec, 0xbf, 0xe9, 0x6d,
+	0x86, 0xf8, 0xc3, 0xbf, 0xfc, 0x3d, 0x00, 0xea, 0xb0, 0x99, 0x23, 0x88, 0x04, 0x00, 0x00,
 }
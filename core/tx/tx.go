@@ -16,19 +16,24 @@ import (
 	"github.com/iost-official/go-iost/crypto"
 )
 
+// the ranges of gas ratio and gas limit of tx, in 0.01 gas
 const (
-	minGasRatio = 100
-	maxGasRatio = 10000
-	minGasLimit = 600000
-	maxGasLimit = 400000000
-	txSizeLimit = 65536
+	MinGasRatio = 100
+	MaxGasRatio = 10000
+	MinGasLimit = 600000
+	MaxGasLimit = 400000000
 )
 
+const txSizeLimit = 65536
+
 // values
 var (
 	MaxExpiration = int64(90 * time.Second)
 	MaxDelay      = int64(720 * time.Hour) // 30 days
 	ChainID       uint32
+	// ReplacementHeight is the number of the first block which can contain replacements. ReplacedTx changes the
+	// encoding and hash of tx, which the nodes before it can not verify, so it is disabled until set.
+	ReplacementHeight = int64(math.MaxInt64)
 )
 
 //go:generate protoc  --go_out=plugins=grpc:. ./core/tx/tx.proto
@@ -60,6 +65,7 @@ type Tx struct {
 	ReferredTx   []byte              `json:"referred_tx"`
 	AmountLimit  []*contract.Amount  `json:"amountLimit"`
 	Reserved     []byte              `json:"reserved"`
+	ReplacedTx   []byte              `json:"replaced_tx"`
 }
 
 // NewTx return a new Tx
@@ -130,6 +136,7 @@ func (t *Tx) ToPb() *txpb.Tx {
 		ChainId:     t.ChainID,
 		ReferredTx:  t.ReferredTx,
 		AmountLimit: t.AmountLimit,
+		ReplacedTx:  t.ReplacedTx,
 	}
 	for _, a := range t.Actions {
 		tr.Actions = append(tr.Actions, a.ToPb())
@@ -165,6 +172,7 @@ func (t *Tx) FromPb(tr *txpb.Tx) *Tx {
 	t.ChainID = tr.ChainId
 	t.ReferredTx = tr.ReferredTx
 	t.AmountLimit = tr.AmountLimit
+	t.ReplacedTx = tr.ReplacedTx
 	for _, a := range tr.Actions {
 		ac := &Action{}
 		t.Actions = append(t.Actions, ac.FromPb(a))
@@ -229,6 +237,23 @@ func (t *Tx) IsDefer() bool {
 	return len(t.ReferredTx) > 0
 }
 
+// IsReplacement returns whether the transaction replaces a pending transaction of the same publisher.
+func (t *Tx) IsReplacement() bool {
+	return len(t.ReplacedTx) > 0
+}
+
+// IsReplacementEnabled returns whether the block of number can contain replacements.
+func IsReplacementEnabled(number int64) bool {
+	return number >= ReplacementHeight
+}
+
+// CanReplace returns whether t can replace r, which is the transaction t refers to or another replacement of it.
+// The replacement should be published by the same publisher with a higher gas ratio, otherwise anyone could
+// censor a transaction by referring to it.
+func (t *Tx) CanReplace(r *Tx) bool {
+	return t.IsReplacement() && t.Publisher == r.Publisher && t.GasRatio > r.GasRatio
+}
+
 // DeferTx generates a new transaction that will be packed to blockchain.
 func (t *Tx) DeferTx() *Tx {
 	expi := t.Expiration + t.Delay
@@ -268,6 +293,9 @@ func (t *Tx) VerifySelf() error { // nolint
 	if t.Delay > 0 && t.IsDefer() {
 		return errors.New("invalid tx. including both delay and referredtx field")
 	}
+	if t.IsReplacement() && (t.Delay > 0 || t.IsDefer()) {
+		return errors.New("invalid tx. replacement can not be delayed")
+	}
 	if err := t.CheckSize(); err != nil {
 		return err
 	}
@@ -339,11 +367,11 @@ func (t *Tx) CheckSize() error {
 // CheckGas checks whether the transaction's gas is valid.
 func (t *Tx) CheckGas() error {
 	ratio := 100
-	if t.GasRatio < minGasRatio || t.GasRatio > maxGasRatio {
-		return fmt.Errorf("gas ratio illegal, should in [%v, %v]", minGasRatio/ratio, maxGasRatio/ratio)
+	if t.GasRatio < MinGasRatio || t.GasRatio > MaxGasRatio {
+		return fmt.Errorf("gas ratio illegal, should in [%v, %v]", MinGasRatio/ratio, MaxGasRatio/ratio)
 	}
-	if t.GasLimit < minGasLimit || t.GasLimit > maxGasLimit {
-		return fmt.Errorf("gas limit illegal, should in [%v, %v]", minGasLimit/ratio, maxGasLimit/ratio)
+	if t.GasLimit < MinGasLimit || t.GasLimit > MaxGasLimit {
+		return fmt.Errorf("gas limit illegal, should in [%v, %v]", MinGasLimit/ratio, MaxGasLimit/ratio)
 	}
 	return nil
 }
@@ -372,6 +400,11 @@ func (t *Tx) ToBytes(l ToBytesLevel) []byte {
 	}
 	se.WriteBytesSlice(amountBytes)
 
+	// written only if set, so the hashes of the other txs are not changed
+	if t.IsReplacement() {
+		se.WriteBytes(t.ReplacedTx)
+	}
+
 	if l > Base {
 		signBytes := make([][]byte, 0, len(t.Signs))
 		for _, sig := range t.Signs {
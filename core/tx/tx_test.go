@@ -131,6 +131,47 @@ func TestTx(t *testing.T) {
 			}
 		})
 
+		Convey("encode and decode replacement", func() {
+			tx := NewTx(actions, []string{a1.ReadablePubkey()}, 100000, 100, 11, 0, 0)
+			hash := tx.Hash()
+
+			tx2 := NewTx(actions, []string{a1.ReadablePubkey()}, 100000, 100, 11, 0, 0)
+			tx2.Time = tx.Time
+			tx2.ReplacedTx = hash
+			So(tx2.IsReplacement(), ShouldBeTrue)
+			So(bytes.Equal(tx2.Hash(), hash), ShouldBeFalse)
+
+			tx1 := NewTx([]*Action{}, []string{}, 0, 0, 0, 0, 0)
+			err := tx1.Decode(tx2.Encode())
+			So(err, ShouldBeNil)
+			So(bytes.Equal(tx1.ReplacedTx, hash), ShouldBeTrue)
+			So(bytes.Equal(tx1.Hash(), tx2.Hash()), ShouldBeTrue)
+		})
+
+		Convey("can replace", func() {
+			tx := NewTx(actions, []string{a1.ReadablePubkey()}, 100000, 100, 11, 0, 0)
+			tx.Publisher = "a"
+			r := NewTx(actions, []string{a1.ReadablePubkey()}, 100000, 200, 11, 0, 0)
+			r.Publisher = "a"
+			So(r.CanReplace(tx), ShouldBeFalse)
+			r.ReplacedTx = tx.Hash()
+			So(r.CanReplace(tx), ShouldBeTrue)
+			r.GasRatio = 100
+			So(r.CanReplace(tx), ShouldBeFalse)
+			r.GasRatio = 200
+			r.Publisher = "b"
+			So(r.CanReplace(tx), ShouldBeFalse)
+		})
+
+		Convey("replacement height", func() {
+			So(IsReplacementEnabled(100), ShouldBeFalse)
+			old := ReplacementHeight
+			ReplacementHeight = 100
+			defer func() { ReplacementHeight = old }()
+			So(IsReplacementEnabled(99), ShouldBeFalse)
+			So(IsReplacementEnabled(100), ShouldBeTrue)
+		})
+
 		Convey("sign and verify", func() {
 			tx := NewTx(actions, []string{a1.ReadablePubkey(), a2.ReadablePubkey()}, 100000000, 100, time.Now().Add(time.Minute).UnixNano(), 0, 0)
 			sig1, err := SignTxContent(tx, a1.ReadablePubkey(), a1)
@@ -182,6 +223,9 @@ func TestTx(t *testing.T) {
 			tx.ReferredTx = []byte("b")
 			So(tx.VerifySelf().Error(), ShouldEqual, "invalid tx. including both delay and referredtx field")
 			tx.ReferredTx = nil
+			tx.ReplacedTx = []byte("c")
+			So(tx.VerifySelf().Error(), ShouldEqual, "invalid tx. replacement can not be delayed")
+			tx.ReplacedTx = nil
 			tx.Actions = []*Action{{"", "", string(make([]byte, 1000000))}}
 			So(tx.VerifySelf().Error(), ShouldContainSubstring, "tx size illegal, should <= 65536")
 		})
@@ -68,7 +68,8 @@ func (b *BlockchainWrapper) findBlock(hash []byte) (*blockTx, bool) {
 	return nil, false
 }
 
-func (b *BlockchainWrapper) getTxAndReceiptInChain(txHash []byte, blk *block.Block) (*tx.Tx, *tx.TxReceipt) {
+// walkChain calls f on blk and its ancestors in filterTime until f returns true.
+func (b *BlockchainWrapper) walkChain(blk *block.Block, f func(*blockTx) bool) {
 	if blk == nil {
 		blk = b.head
 	}
@@ -76,22 +77,44 @@ func (b *BlockchainWrapper) getTxAndReceiptInChain(txHash []byte, blk *block.Blo
 	filterLimit := blk.Head.Time - b.filterTime
 	var ok bool
 	for {
-		t, tr := b.getTxAndReceiptInBlock(txHash, blkHash)
-		if t != nil {
-			return t, tr
+		if bt, ok := b.findBlock(blkHash); ok && f(bt) {
+			return
 		}
 		blkHash, ok = b.parentHash(blkHash)
 		if !ok {
-			return nil, nil
+			return
 		}
 		if b, ok := b.findBlock(blkHash); ok {
 			if b.time < filterLimit {
-				return nil, nil
+				return
 			}
 		}
 	}
 }
 
+func (b *BlockchainWrapper) getTxAndReceiptInChain(txHash []byte, blk *block.Block) (t *tx.Tx, tr *tx.TxReceipt) {
+	b.walkChain(blk, func(bt *blockTx) bool {
+		t, tr = bt.getTxAndReceipt(txHash)
+		return t != nil
+	})
+	return t, tr
+}
+
+// replacedInChain returns whether t is replaced by a tx in chain, which refers to t and can replace it.
+func (b *BlockchainWrapper) replacedInChain(t *tx.Tx, blk *block.Block) bool {
+	replaced := false
+	b.walkChain(blk, func(bt *blockTx) bool {
+		for _, r := range bt.getReplacements(t.Hash()) {
+			if r.CanReplace(t) {
+				replaced = true
+				break
+			}
+		}
+		return replaced
+	})
+	return replaced
+}
+
 func (b *BlockchainWrapper) setHead(blk *block.Block) {
 	b.head = blk
 }
@@ -101,14 +124,6 @@ func (b *BlockchainWrapper) existTxInChain(txHash []byte, blk *block.Block) bool
 	return t != nil
 }
 
-func (b *BlockchainWrapper) getTxAndReceiptInBlock(txHash []byte, blockHash []byte) (*tx.Tx, *tx.TxReceipt) {
-	blk, ok := b.blockList.Load(string(blockHash))
-	if !ok {
-		return nil, nil
-	}
-	return blk.(*blockTx).getTxAndReceipt(txHash)
-}
-
 func (b *BlockchainWrapper) clearBlock() {
 	head := b.blockCache.LinkedRoot().Block
 	headTime := head.Head.Time
@@ -16,9 +16,12 @@ type TxPool interface {
 	AddTx(tx *tx.Tx) error
 	DelTx(hash []byte) error
 	DelTxList(delList []*tx.Tx)
+	DropTxs(txs []*tx.Tx, errs []error)
 	ExistTxs(hash []byte, chainBlock *block.Block) FRet
+	ExistReplacement(t *tx.Tx, chainBlock *block.Block) bool
 	GetFromPending(hash []byte) (*tx.Tx, error)
 	GetFromChain(hash []byte) (*tx.Tx, *tx.TxReceipt, error)
+	GetTxRecord(hash []byte) (*TxRecord, error)
 	Lock()
 	Release()
 	PendingTx() (*SortedTxMap, *blockcache.BlockCacheNode)
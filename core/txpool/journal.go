@@ -0,0 +1,274 @@
+package txpool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+const (
+	txJournalFile = "TxPoolJournal"
+	// the records larger than it are broken, as the size of a tx is limited
+	maxJournalRecordSize = 1 << 20
+	// the txs inserted when the queue of the writer is full are dropped, and saved by the next rotation
+	journalQueueSize = 1024
+	// the journal is rewritten if more than half of its records are stale and their count passes it
+	minJournalStale = 128
+)
+
+var errJournalClosed = errors.New("txpool journal is closed")
+
+// txJournal keeps the pending txs on disk, so they are not lost when the node restarts. Each added tx is
+// queued and appended to the journal file by the writer goroutine, and the file is rewritten with the pending
+// txs when the stale records pass the threshold. The count of txs in the file is bounded by maxCacheTxs,
+// the txs appended to a full journal are dropped until it is rewritten.
+//
+// A record in the file is the length of the encoded tx in 4 bytes big endian, followed by the encoded tx.
+type txJournal struct {
+	path      string
+	ops       chan *journalOp
+	dropped   int32
+	quitCh    chan struct{}
+	doneCh    chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+
+	// the fields below are only accessed by the writer goroutine
+	file   *os.File
+	writer *bufio.Writer
+	count  int
+}
+
+// journalOp is the tx to append, or the rotation with the txs to rewrite if errCh is not nil.
+type journalOp struct {
+	t     *tx.Tx
+	txs   func() []*tx.Tx
+	force bool
+	errCh chan error
+}
+
+// newTxJournal returns a journal of the file path, the journal is disabled if path is empty.
+func newTxJournal(path string) *txJournal {
+	j := &txJournal{
+		path:   path,
+		ops:    make(chan *journalOp, journalQueueSize),
+		quitCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}
+	if path == "" {
+		close(j.doneCh)
+		return j
+	}
+	go j.writeLoop()
+	return j
+}
+
+// load reads at most maxCacheTxs txs from the journal file. The records after a broken one are dropped,
+// as the last record may be partially written when the node crashes.
+func (j *txJournal) load() ([]*tx.Tx, error) {
+	if j.path == "" {
+		return nil, nil
+	}
+	f, err := os.Open(j.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	txs := make([]*tx.Tx, 0)
+	lenBuf := make([]byte, 4)
+	for len(txs) < maxCacheTxs {
+		if _, err := io.ReadFull(r, lenBuf); err != nil {
+			break
+		}
+		size := binary.BigEndian.Uint32(lenBuf)
+		if size > maxJournalRecordSize {
+			break
+		}
+		data := make([]byte, size)
+		if _, err := io.ReadFull(r, data); err != nil {
+			break
+		}
+		t := &tx.Tx{}
+		if err := t.Decode(data); err != nil {
+			break
+		}
+		txs = append(txs, t)
+	}
+	return txs, nil
+}
+
+// insert queues the tx to be appended to the journal file without blocking. The tx is dropped if the queue
+// or the journal is full, or the journal is not opened by rotate.
+func (j *txJournal) insert(t *tx.Tx) {
+	if j.path == "" {
+		return
+	}
+	select {
+	case j.ops <- &journalOp{t: t}:
+	default:
+		atomic.StoreInt32(&j.dropped, 1)
+	}
+}
+
+// rotate rewrites the journal file with the txs returned by txs, and opens it for appending. The txs are read
+// by the writer goroutine after the queued ones are appended, so none of the added txs is lost by the rewrite.
+// Unless force is set, the file is only rewritten if it is not opened, some txs were dropped, or the stale
+// records pass the threshold.
+func (j *txJournal) rotate(txs func() []*tx.Tx, force bool) error {
+	if j.path == "" {
+		return nil
+	}
+	op := &journalOp{txs: txs, force: force, errCh: make(chan error, 1)}
+	select {
+	case j.ops <- op:
+	case <-j.doneCh:
+		return errJournalClosed
+	}
+	select {
+	case err := <-op.errCh:
+		return err
+	case <-j.doneCh:
+		return errJournalClosed
+	}
+}
+
+// close writes the queued txs and closes the journal file, the txs inserted later are dropped.
+func (j *txJournal) close() error {
+	j.closeOnce.Do(func() {
+		close(j.quitCh)
+	})
+	<-j.doneCh
+	return j.closeErr
+}
+
+func (j *txJournal) writeLoop() {
+	defer close(j.doneCh)
+	for {
+		select {
+		case op := <-j.ops:
+			j.apply(op)
+		case <-j.quitCh:
+			for {
+				select {
+				case op := <-j.ops:
+					j.apply(op)
+				default:
+					j.closeErr = j.closeFile()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (j *txJournal) apply(op *journalOp) {
+	if op.errCh != nil {
+		op.errCh <- j.rewrite(op.txs, op.force)
+		return
+	}
+	if j.writer == nil {
+		return
+	}
+	if j.count >= maxCacheTxs {
+		atomic.StoreInt32(&j.dropped, 1)
+		return
+	}
+	if _, err := j.writer.Write(encodeJournalRecord(op.t)); err != nil {
+		ilog.Warnf("insert tx to txpool journal failed: %v", err)
+		atomic.StoreInt32(&j.dropped, 1)
+		return
+	}
+	j.count++
+	if len(j.ops) == 0 {
+		j.flush()
+	}
+}
+
+// flush writes the buffered records to the file, the journal is rewritten by the next rotation if it fails
+func (j *txJournal) flush() {
+	if j.writer == nil {
+		return
+	}
+	if err := j.writer.Flush(); err != nil {
+		ilog.Warnf("flush txpool journal failed: %v", err)
+		atomic.StoreInt32(&j.dropped, 1)
+	}
+}
+
+func (j *txJournal) rewrite(txsFunc func() []*tx.Tx, force bool) error {
+	txs := txsFunc()
+	dropped := atomic.SwapInt32(&j.dropped, 0) == 1
+	stale := j.count - len(txs)
+	if !force && !dropped && j.file != nil && (stale < minJournalStale || stale*2 < j.count) {
+		j.flush()
+		return nil
+	}
+	if err := j.closeFile(); err != nil {
+		ilog.Warnf("close txpool journal failed: %v", err)
+	}
+	tmp := j.path + ".new"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	count := 0
+	for _, t := range txs {
+		if count >= maxCacheTxs {
+			break
+		}
+		if _, err := w.Write(encodeJournalRecord(t)); err != nil {
+			f.Close()
+			return err
+		}
+		count++
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	f.Close()
+	if err := os.Rename(tmp, j.path); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	j.file = file
+	j.writer = bufio.NewWriter(file)
+	j.count = count
+	return nil
+}
+
+func (j *txJournal) closeFile() error {
+	if j.file == nil {
+		return nil
+	}
+	err := j.writer.Flush()
+	if cerr := j.file.Close(); err == nil {
+		err = cerr
+	}
+	j.file, j.writer = nil, nil
+	return err
+}
+
+func encodeJournalRecord(t *tx.Tx) []byte {
+	data := t.Encode()
+	buf := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	copy(buf[4:], data)
+	return buf
+}
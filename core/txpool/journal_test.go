@@ -0,0 +1,124 @@
+package txpool
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTxJournal(t *testing.T) {
+	Convey("test txJournal", t, func() {
+		dir, err := ioutil.TempDir("", "txjournal")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, txJournalFile)
+
+		a, err := account.NewKeyPair(nil, crypto.Secp256k1)
+		So(err, ShouldBeNil)
+		txs := make([]*tx.Tx, 0)
+		for i := 0; i < 4; i++ {
+			txs = append(txs, genTx(a, tx.MaxExpiration))
+		}
+		pending := func(txs []*tx.Tx) func() []*tx.Tx {
+			return func() []*tx.Tx { return txs }
+		}
+
+		Convey("insert and load", func() {
+			j := newTxJournal(path)
+			loaded, err := j.load()
+			So(err, ShouldBeNil)
+			So(len(loaded), ShouldEqual, 0)
+
+			j.insert(txs[0])
+			So(j.rotate(pending(txs[:2]), false), ShouldBeNil)
+			j.insert(txs[2])
+			So(j.close(), ShouldBeNil)
+			j.insert(txs[3])
+			So(j.rotate(pending(txs), true), ShouldEqual, errJournalClosed)
+			So(j.close(), ShouldBeNil)
+
+			loaded, err = newTxJournal(path).load()
+			So(err, ShouldBeNil)
+			So(len(loaded), ShouldEqual, 3)
+			for i, t := range loaded {
+				So(t.Hash(), ShouldResemble, txs[i].Hash())
+			}
+		})
+
+		Convey("max txs", func() {
+			old := maxCacheTxs
+			maxCacheTxs = 2
+			defer func() { maxCacheTxs = old }()
+
+			j := newTxJournal(path)
+			So(j.rotate(pending(txs[:3]), true), ShouldBeNil)
+			j.insert(txs[3])
+			So(j.close(), ShouldBeNil)
+
+			loaded, err := j.load()
+			So(err, ShouldBeNil)
+			So(len(loaded), ShouldEqual, 2)
+		})
+
+		Convey("stale threshold", func() {
+			j := newTxJournal(path)
+			So(j.rotate(pending(txs[:2]), true), ShouldBeNil)
+			j.insert(txs[2])
+			// few stale records are kept
+			So(j.rotate(pending(txs[2:3]), false), ShouldBeNil)
+			j.insert(txs[3])
+			So(j.rotate(pending(txs[2:4]), false), ShouldBeNil)
+			loaded, err := j.load()
+			So(err, ShouldBeNil)
+			So(len(loaded), ShouldEqual, 4)
+
+			// the journal is rewritten when half of the records are stale
+			live := txs[:0:0]
+			for i := 0; i < 2*minJournalStale; i++ {
+				t := genTx(a, tx.MaxExpiration)
+				j.insert(t)
+				if i%4 == 0 {
+					live = append(live, t)
+				}
+			}
+			So(j.rotate(pending(live), false), ShouldBeNil)
+			So(j.close(), ShouldBeNil)
+			loaded, err = j.load()
+			So(err, ShouldBeNil)
+			So(len(loaded), ShouldEqual, len(live))
+			So(loaded[0].Hash(), ShouldResemble, live[0].Hash())
+		})
+
+		Convey("broken record", func() {
+			j := newTxJournal(path)
+			So(j.rotate(pending(txs[:2]), true), ShouldBeNil)
+			So(j.close(), ShouldBeNil)
+
+			f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+			So(err, ShouldBeNil)
+			record := encodeJournalRecord(txs[2])
+			_, err = f.Write(record[:len(record)/2])
+			So(err, ShouldBeNil)
+			f.Close()
+
+			loaded, err := j.load()
+			So(err, ShouldBeNil)
+			So(len(loaded), ShouldEqual, 2)
+		})
+
+		Convey("disabled", func() {
+			j := newTxJournal("")
+			So(j.rotate(pending(txs), true), ShouldBeNil)
+			j.insert(txs[0])
+			loaded, err := j.load()
+			So(err, ShouldBeNil)
+			So(len(loaded), ShouldEqual, 0)
+		})
+	})
+}
@@ -82,6 +82,28 @@ func (mr *MockTxPoolMockRecorder) DelTxList(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelTxList", reflect.TypeOf((*MockTxPool)(nil).DelTxList), arg0)
 }
 
+// DropTxs mocks base method
+func (m *MockTxPool) DropTxs(arg0 []*tx.Tx, arg1 []error) {
+	m.ctrl.Call(m, "DropTxs", arg0, arg1)
+}
+
+// DropTxs indicates an expected call of DropTxs
+func (mr *MockTxPoolMockRecorder) DropTxs(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropTxs", reflect.TypeOf((*MockTxPool)(nil).DropTxs), arg0, arg1)
+}
+
+// ExistReplacement mocks base method
+func (m *MockTxPool) ExistReplacement(arg0 *tx.Tx, arg1 *block.Block) bool {
+	ret := m.ctrl.Call(m, "ExistReplacement", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ExistReplacement indicates an expected call of ExistReplacement
+func (mr *MockTxPoolMockRecorder) ExistReplacement(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistReplacement", reflect.TypeOf((*MockTxPool)(nil).ExistReplacement), arg0, arg1)
+}
+
 // ExistTxs mocks base method
 func (m *MockTxPool) ExistTxs(arg0 []byte, arg1 *block.Block) txpool.FRet {
 	ret := m.ctrl.Call(m, "ExistTxs", arg0, arg1)
@@ -121,6 +143,19 @@ func (mr *MockTxPoolMockRecorder) GetFromPending(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFromPending", reflect.TypeOf((*MockTxPool)(nil).GetFromPending), arg0)
 }
 
+// GetTxRecord mocks base method
+func (m *MockTxPool) GetTxRecord(arg0 []byte) (*txpool.TxRecord, error) {
+	ret := m.ctrl.Call(m, "GetTxRecord", arg0)
+	ret0, _ := ret[0].(*txpool.TxRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxRecord indicates an expected call of GetTxRecord
+func (mr *MockTxPoolMockRecorder) GetTxRecord(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxRecord", reflect.TypeOf((*MockTxPool)(nil).GetTxRecord), arg0)
+}
+
 // Lock mocks base method
 func (m *MockTxPool) Lock() {
 	m.ctrl.Call(m, "Lock")
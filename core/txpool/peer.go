@@ -0,0 +1,104 @@
+package txpool
+
+import (
+	"sync"
+	"time"
+)
+
+// Values of peer accounting.
+var (
+	// the peer is put to black list if it relays more invalid txs in a clearInterval
+	maxPeerInvalidTxs = 20
+	peerStatsTTL      = int64(time.Hour)
+)
+
+// PeerStats is the count of txs received from a peer by p2p.
+type PeerStats struct {
+	Received int64
+	Accepted int64
+	Rejected int64 // rejected as duplicate, expired or by admission policies
+	Invalid  int64 // can not be decoded or fails signature verification
+	updated  int64
+}
+
+// peerAccounts keeps the stats of the peers relaying txs. The stats not updated in peerStatsTTL are dropped.
+type peerAccounts struct {
+	mu      sync.Mutex
+	stats   map[string]*PeerStats
+	invalid map[string]int // the count of invalid txs in the current clearInterval
+}
+
+func newPeerAccounts() *peerAccounts {
+	return &peerAccounts{
+		stats:   make(map[string]*PeerStats),
+		invalid: make(map[string]int),
+	}
+}
+
+func (pa *peerAccounts) get(peer string) *PeerStats {
+	s, ok := pa.stats[peer]
+	if !ok {
+		s = &PeerStats{}
+		pa.stats[peer] = s
+	}
+	s.updated = time.Now().UnixNano()
+	return s
+}
+
+func (pa *peerAccounts) received(peer string) {
+	pa.mu.Lock()
+	pa.get(peer).Received++
+	pa.mu.Unlock()
+}
+
+func (pa *peerAccounts) accepted(peer string) {
+	pa.mu.Lock()
+	pa.get(peer).Accepted++
+	pa.mu.Unlock()
+}
+
+func (pa *peerAccounts) rejected(peer string) {
+	pa.mu.Lock()
+	pa.get(peer).Rejected++
+	pa.mu.Unlock()
+}
+
+// markInvalid counts an invalid tx from the peer, and returns whether the peer should be put to black list.
+func (pa *peerAccounts) markInvalid(peer string) bool {
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+
+	pa.get(peer).Invalid++
+	pa.invalid[peer]++
+	if pa.invalid[peer] > maxPeerInvalidTxs {
+		delete(pa.invalid, peer)
+		return true
+	}
+	return false
+}
+
+// clear starts a new clearInterval, and drops the stats not updated in peerStatsTTL.
+func (pa *peerAccounts) clear() {
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+
+	pa.invalid = make(map[string]int)
+	limit := time.Now().UnixNano() - peerStatsTTL
+	for peer, s := range pa.stats {
+		if s.updated < limit {
+			delete(pa.stats, peer)
+		}
+	}
+}
+
+// all returns a copy of the stats of all peers.
+func (pa *peerAccounts) all() map[string]PeerStats {
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+
+	ret := make(map[string]PeerStats, len(pa.stats))
+	for peer, s := range pa.stats {
+		ret[peer] = *s
+	}
+	return ret
+}
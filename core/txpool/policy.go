@@ -0,0 +1,90 @@
+package txpool
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/iost-official/go-iost/core/tx"
+)
+
+// Values of the default admission policies.
+var (
+	maxContractTxs = 2000
+	// the gas ratio floor starts to increase when the pool is fuller than it
+	gasRatioFloorStart = 0.5
+	maxGasRatioFloor   = int64(10 * tx.MinGasRatio)
+
+	ErrContractTxsFull = errors.New("too many pending txs calling the contract")
+)
+
+// AdmissionPolicy decides whether a tx verified by itself can be added to pending list. replaced is the
+// pending tx replaced by t, nil if t is not a replacement.
+type AdmissionPolicy interface {
+	Admit(t *tx.Tx, replaced *tx.Tx, pending *SortedTxMap) error
+}
+
+// AdmissionPolicyFunc is an adapter to allow the use of ordinary functions as AdmissionPolicy.
+type AdmissionPolicyFunc func(t *tx.Tx, replaced *tx.Tx, pending *SortedTxMap) error
+
+// Admit calls f(t, replaced, pending).
+func (f AdmissionPolicyFunc) Admit(t *tx.Tx, replaced *tx.Tx, pending *SortedTxMap) error {
+	return f(t, replaced, pending)
+}
+
+// DefaultAdmissionPolicies returns the policies used by txpool if they are not set.
+func DefaultAdmissionPolicies() []AdmissionPolicy {
+	return []AdmissionPolicy{
+		NewGasRatioFloorPolicy(gasRatioFloorStart, maxGasRatioFloor),
+		NewPublisherQuotaPolicy(maxAccountTxs),
+		NewContractQuotaPolicy(maxContractTxs),
+	}
+}
+
+// NewGasRatioFloorPolicy returns the policy requiring a minimum gas ratio based on the fullness of txpool.
+// The floor is tx.MinGasRatio until the pool is fuller than start, then increases linearly to maxFloor when it is full.
+func NewGasRatioFloorPolicy(start float64, maxFloor int64) AdmissionPolicy {
+	return AdmissionPolicyFunc(func(t *tx.Tx, replaced *tx.Tx, pending *SortedTxMap) error {
+		fullness := float64(pending.Size()) / float64(maxCacheTxs)
+		floor := gasRatioFloor(fullness, start, maxFloor)
+		if t.GasRatio < floor {
+			return fmt.Errorf("gas ratio too low, should be >= %v as txpool is %.0f%% full", float64(floor)/100, fullness*100)
+		}
+		return nil
+	})
+}
+
+func gasRatioFloor(fullness float64, start float64, maxFloor int64) int64 {
+	if fullness <= start || start >= 1 {
+		return tx.MinGasRatio
+	}
+	if fullness > 1 {
+		fullness = 1
+	}
+	return tx.MinGasRatio + int64(float64(maxFloor-tx.MinGasRatio)*(fullness-start)/(1-start))
+}
+
+// NewPublisherQuotaPolicy returns the policy limiting the count of pending txs of each publisher.
+// The replacements are not limited as they do not increase the count.
+func NewPublisherQuotaPolicy(quota int) AdmissionPolicy {
+	return AdmissionPolicyFunc(func(t *tx.Tx, replaced *tx.Tx, pending *SortedTxMap) error {
+		if replaced == nil && pending.AccountSize(t.Publisher) >= quota {
+			return ErrAccountTxsFull
+		}
+		return nil
+	})
+}
+
+// NewContractQuotaPolicy returns the policy limiting the count of pending txs calling each contract.
+func NewContractQuotaPolicy(quota int) AdmissionPolicy {
+	return AdmissionPolicyFunc(func(t *tx.Tx, replaced *tx.Tx, pending *SortedTxMap) error {
+		if replaced != nil {
+			return nil
+		}
+		for _, c := range txContracts(t) {
+			if pending.ContractSize(c) >= quota {
+				return ErrContractTxsFull
+			}
+		}
+		return nil
+	})
+}
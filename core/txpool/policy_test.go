@@ -0,0 +1,89 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAdmissionPolicies(t *testing.T) {
+	Convey("test admission policies", t, func() {
+		a, err := account.NewKeyPair(nil, crypto.Secp256k1)
+		So(err, ShouldBeNil)
+		pending := NewSortedTxMap()
+		t0 := genTx(a, tx.MaxExpiration)
+		pending.Add(t0)
+
+		Convey("gas ratio floor", func() {
+			So(gasRatioFloor(0.3, 0.5, 1000), ShouldEqual, tx.MinGasRatio)
+			So(gasRatioFloor(0.75, 0.5, 1000), ShouldEqual, 550)
+			So(gasRatioFloor(1.2, 0.5, 1000), ShouldEqual, 1000)
+
+			old := maxCacheTxs
+			maxCacheTxs = 1
+			defer func() { maxCacheTxs = old }()
+			p := NewGasRatioFloorPolicy(0.5, 1000)
+			t1 := genTx(a, tx.MaxExpiration)
+			So(p.Admit(t1, nil, pending), ShouldNotBeNil)
+			t1.GasRatio = 1000
+			So(p.Admit(t1, nil, pending), ShouldBeNil)
+		})
+
+		Convey("publisher quota", func() {
+			p := NewPublisherQuotaPolicy(1)
+			t1 := genTx(a, tx.MaxExpiration)
+			So(p.Admit(t1, nil, pending), ShouldEqual, ErrAccountTxsFull)
+			So(p.Admit(t1, t0, pending), ShouldBeNil)
+
+			b, err := account.NewKeyPair(nil, crypto.Secp256k1)
+			So(err, ShouldBeNil)
+			So(p.Admit(genTx(b, tx.MaxExpiration), nil, pending), ShouldBeNil)
+		})
+
+		Convey("contract quota", func() {
+			So(pending.ContractSize("contract1"), ShouldEqual, 1)
+			p := NewContractQuotaPolicy(1)
+			b, err := account.NewKeyPair(nil, crypto.Secp256k1)
+			So(err, ShouldBeNil)
+			So(p.Admit(genTx(b, tx.MaxExpiration), nil, pending), ShouldEqual, ErrContractTxsFull)
+
+			pending.Del(t0.Hash())
+			So(pending.ContractSize("contract1"), ShouldEqual, 0)
+			So(p.Admit(genTx(b, tx.MaxExpiration), nil, pending), ShouldBeNil)
+		})
+	})
+}
+
+func TestPeerAccounts(t *testing.T) {
+	Convey("test peerAccounts", t, func() {
+		pa := newPeerAccounts()
+		pa.received("a")
+		pa.accepted("a")
+		pa.received("a")
+		pa.rejected("a")
+		for i := 0; i < maxPeerInvalidTxs; i++ {
+			So(pa.markInvalid("b"), ShouldBeFalse)
+		}
+		So(pa.markInvalid("b"), ShouldBeTrue)
+		So(pa.markInvalid("b"), ShouldBeFalse)
+
+		stats := pa.all()
+		So(stats["a"].Received, ShouldEqual, 2)
+		So(stats["a"].Accepted, ShouldEqual, 1)
+		So(stats["a"].Rejected, ShouldEqual, 1)
+		So(stats["b"].Invalid, ShouldEqual, maxPeerInvalidTxs+2)
+
+		pa.clear()
+		So(len(pa.all()), ShouldEqual, 2)
+		So(pa.invalid["b"], ShouldEqual, 0)
+
+		old := peerStatsTTL
+		peerStatsTTL = -1
+		defer func() { peerStatsTTL = old }()
+		pa.clear()
+		So(len(pa.all()), ShouldEqual, 0)
+	})
+}
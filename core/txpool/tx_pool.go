@@ -3,6 +3,7 @@ package txpool
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 	"runtime"
 	"sync"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/event"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/ilog"
@@ -18,6 +20,15 @@ import (
 
 var errDelaytxNotFound = errors.New("delay tx not found")
 
+// invalidTxError is the error of the tx failing the verification by itself, the peers relaying it are penalized.
+type invalidTxError struct {
+	err error
+}
+
+func (e *invalidTxError) Error() string {
+	return fmt.Sprintf("VerifyError %v", e.err)
+}
+
 // TxPImpl defines all the API of txpool package.
 type TxPImpl struct {
 	global            global.BaseVariable
@@ -28,6 +39,10 @@ type TxPImpl struct {
 	mu                sync.RWMutex
 	chP2PTx           chan p2p.IncomingMessage
 	deferServer       *DeferServer
+	records           *txRecords
+	journal           *txJournal
+	policies          []AdmissionPolicy
+	peers             *peerAccounts
 	quitGenerateMode  chan struct{}
 	quitCh            chan struct{}
 }
@@ -39,6 +54,10 @@ func NewTxPoolImpl(global global.BaseVariable, blockCache blockcache.BlockCache,
 		blockchainWrapper: NewBlockchainWrapper(blockCache),
 		p2pService:        p2pService,
 		pendingTx:         NewSortedTxMap(),
+		records:           newTxRecords(),
+		journal:           newTxJournal(journalPath(global)),
+		policies:          DefaultAdmissionPolicies(),
+		peers:             newPeerAccounts(),
 		chP2PTx:           p2pService.Register("txpool message", p2p.PublishTx),
 		quitGenerateMode:  make(chan struct{}),
 		quitCh:            make(chan struct{}),
@@ -53,17 +72,90 @@ func NewTxPoolImpl(global global.BaseVariable, blockCache blockcache.BlockCache,
 	return p, nil
 }
 
-// Start starts the jobs.
+// SetAdmissionPolicies replaces the admission policies of txpool, which should be called before Start.
+func (pool *TxPImpl) SetAdmissionPolicies(policies ...AdmissionPolicy) {
+	pool.policies = policies
+}
+
+// PeerStats returns the count of txs received from each peer.
+func (pool *TxPImpl) PeerStats() map[string]PeerStats {
+	return pool.peers.all()
+}
+
+func journalPath(global global.BaseVariable) string {
+	conf := global.Config()
+	if conf == nil || conf.DB == nil || conf.DB.LdbPath == "" {
+		return ""
+	}
+	return filepath.Join(conf.DB.LdbPath, txJournalFile)
+}
+
+// Start starts the jobs, the pending txs in journal are added back before.
 func (pool *TxPImpl) Start() error {
+	pool.initBlockTx()
+	pool.loadJournal()
 	go pool.deferServer.Start()
 	go pool.loop()
 	return nil
 }
 
-// Stop stops all the jobs.
+// Stop stops all the jobs, and saves the pending txs to journal.
 func (pool *TxPImpl) Stop() {
 	pool.deferServer.Stop()
 	close(pool.quitCh)
+	pool.rotateJournal(true)
+	if err := pool.journal.close(); err != nil {
+		ilog.Warnf("close txpool journal failed: %v", err)
+	}
+}
+
+// loadJournal adds the txs in journal to pending list. The txs expired, in chain or failing verification are dropped.
+func (pool *TxPImpl) loadJournal() {
+	txs, err := pool.journal.load()
+	if err != nil {
+		ilog.Errorf("load txpool journal failed: %v", err)
+	}
+	pool.mu.Lock()
+	count := 0
+	for _, t := range txs {
+		if pool.ExistTxs(t.Hash(), nil) != NotFound {
+			continue
+		}
+		if err := pool.verifyDuplicate(t); err != nil {
+			continue
+		}
+		if err := pool.verifyTx(t); err != nil {
+			continue
+		}
+		pool.addPending(t)
+		count++
+	}
+	pool.mu.Unlock()
+	if len(txs) > 0 {
+		ilog.Infof("Loaded %v txs from txpool journal, %v are dropped.", count, len(txs)-count)
+	}
+	pool.rotateJournal(true)
+}
+
+// rotateJournal rewrites the journal with the pending txs if force is set or the journal has too many stale txs.
+func (pool *TxPImpl) rotateJournal(force bool) {
+	if err := pool.journal.rotate(pool.journalTxs, force); err != nil {
+		ilog.Warnf("rotate txpool journal failed: %v", err)
+	}
+}
+
+// journalTxs returns the pending txs to journal, the defer txs are skipped as they are restored from chain.
+func (pool *TxPImpl) journalTxs() []*tx.Tx {
+	txs := make([]*tx.Tx, 0, pool.pendingTx.Size())
+	iter := pool.pendingTx.Iter()
+	t, ok := iter.Next()
+	for ok {
+		if !t.IsDefer() {
+			txs = append(txs, t)
+		}
+		t, ok = iter.Next()
+	}
+	return txs
 }
 
 // AddDefertx adds defer transaction.
@@ -79,6 +171,8 @@ func (pool *TxPImpl) AddDefertx(txHash []byte) error {
 		}
 	}
 	deferTx := referredTx.DeferTx()
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
 	err = pool.verifyDuplicate(deferTx)
 	if err != nil {
 		return err
@@ -87,12 +181,11 @@ func (pool *TxPImpl) AddDefertx(txHash []byte) error {
 	if err != nil {
 		return err
 	}
-	pool.pendingTx.Add(deferTx)
+	pool.addPending(deferTx)
 	return nil
 }
 
 func (pool *TxPImpl) loop() {
-	pool.initBlockTx()
 	workerCnt := (runtime.NumCPU() + 1) / 2
 	if workerCnt == 0 {
 		workerCnt = 1
@@ -109,6 +202,9 @@ func (pool *TxPImpl) loop() {
 			pool.blockchainWrapper.clearBlock()
 			pool.clearTimeoutTx()
 			pool.mu.Unlock()
+			pool.rotateJournal(false)
+			pool.records.clear()
+			pool.peers.clear()
 			metricsTxPoolSize.Set(float64(pool.pendingTx.Size()), nil)
 		case <-pool.quitCh:
 			return
@@ -141,30 +237,50 @@ func (pool *TxPImpl) verifyWorkers() {
 		select {
 		case <-pool.quitGenerateMode:
 		}
+		peerID := v.From().Pretty()
+		pool.peers.received(peerID)
 		var t tx.Tx
 		err := t.Decode(v.Data())
 		if err != nil {
 			ilog.Errorf("decode tx error. err=%v", err)
+			pool.penalizePeer(peerID)
 			continue
 		}
 		pool.mu.Lock()
 		ret := pool.verifyDuplicate(&t)
 		if ret != nil {
+			pool.recordRejected(t.Hash(), ret)
 			pool.mu.Unlock()
+			pool.peers.rejected(peerID)
 			continue
 		}
 		ret = pool.verifyTx(&t)
 		if ret != nil {
+			pool.recordRejected(t.Hash(), ret)
 			pool.mu.Unlock()
+			if _, ok := ret.(*invalidTxError); ok {
+				pool.penalizePeer(peerID)
+			} else {
+				pool.peers.rejected(peerID)
+			}
 			continue
 		}
-		pool.pendingTx.Add(&t)
+		pool.addPending(&t)
 		pool.mu.Unlock()
+		pool.peers.accepted(peerID)
 		metricsReceivedTxCount.Add(1, map[string]string{"from": "p2p"})
 		pool.p2pService.Broadcast(v.Data(), p2p.PublishTx, p2p.NormalMessage)
 	}
 }
 
+// penalizePeer counts an invalid tx relayed by the peer, and puts the peer to black list if it relays too many.
+func (pool *TxPImpl) penalizePeer(peerID string) {
+	if pool.peers.markInvalid(peerID) {
+		ilog.Warnf("Too many invalid txs from peer, put it to black list. peer=%v", peerID)
+		pool.p2pService.PutPeerToBlack(peerID)
+	}
+}
+
 func (pool *TxPImpl) processDelaytx(blk *block.Block) {
 	for i, t := range blk.Txs {
 		if t.Delay > 0 && blk.Receipts[i].Status.Code == tx.Success {
@@ -195,10 +311,20 @@ func (pool *TxPImpl) AddLinkedNode(linkedNode *blockcache.BlockCacheNode) error
 		return nil
 	}
 	for _, t := range txsToAdd {
-		pool.pendingTx.Add(t)
+		pool.records.add(t.Hash(), TxForked, "")
+		pool.addPending(t)
 	}
 	for _, t := range txsToDel {
-		pool.pendingTx.Del(t.Hash())
+		pool.delPending(t.Hash(), TxPacked, "")
+		// only one of the replaced tx and the replacement can be packed
+		if r := pool.pendingTx.GetReplacement(t.Hash()); r != nil && r.CanReplace(t) {
+			pool.delPending(r.Hash(), TxFailed, ErrReplacedPacked.Error())
+		}
+		if t.IsReplacement() {
+			if r := pool.pendingTx.Get(t.ReplacedTx); r != nil && t.CanReplace(r) {
+				pool.delPending(t.ReplacedTx, TxReplaced, common.Base58Encode(t.Hash()))
+			}
+		}
 	}
 
 	return nil
@@ -206,15 +332,22 @@ func (pool *TxPImpl) AddLinkedNode(linkedNode *blockcache.BlockCacheNode) error
 
 // AddTx add the transaction
 func (pool *TxPImpl) AddTx(t *tx.Tx) error {
+	// lock across the verification and adding, so the concurrent txs are checked against each other
+	pool.mu.Lock()
 	err := pool.verifyDuplicate(t)
 	if err != nil {
+		pool.recordRejected(t.Hash(), err)
+		pool.mu.Unlock()
 		return err
 	}
 	err = pool.verifyTx(t)
 	if err != nil {
+		pool.recordRejected(t.Hash(), err)
+		pool.mu.Unlock()
 		return err
 	}
-	pool.pendingTx.Add(t)
+	pool.addPending(t)
+	pool.mu.Unlock()
 	ilog.Debugf(
 		"Added %v to pendingTx, now size is %v.",
 		common.Base58Encode(t.Hash()),
@@ -228,14 +361,62 @@ func (pool *TxPImpl) AddTx(t *tx.Tx) error {
 
 // DelTx del the transaction
 func (pool *TxPImpl) DelTx(hash []byte) error {
-	pool.pendingTx.Del(hash)
+	pool.delPending(hash, TxDeleted, "")
 	return nil
 }
 
 // DelTxList deletes the tx list in txpool.
 func (pool *TxPImpl) DelTxList(delList []*tx.Tx) {
 	for _, t := range delList {
-		pool.pendingTx.Del(t.Hash())
+		pool.delPending(t.Hash(), TxDropped, "")
+	}
+}
+
+// DropTxs deletes the txs which failed in block generation, errs are the errors of txs, nil for the packed ones.
+func (pool *TxPImpl) DropTxs(txs []*tx.Tx, errs []error) {
+	for i, t := range txs {
+		if i < len(errs) && errs[i] != nil {
+			pool.delPending(t.Hash(), TxFailed, errs[i].Error())
+		}
+	}
+}
+
+// GetTxRecord returns the lifecycle of the tx in txpool.
+func (pool *TxPImpl) GetTxRecord(hash []byte) (*TxRecord, error) {
+	r, ok := pool.records.get(hash)
+	if !ok {
+		return nil, ErrTxNotFound
+	}
+	return r, nil
+}
+
+// addPending adds the tx to pending list and posts the PendingTxAdded event, the pending tx replaced by it is removed.
+func (pool *TxPImpl) addPending(t *tx.Tx) {
+	if replaced := pool.replacedPending(t); replaced != nil && t.CanReplace(replaced) {
+		pool.delPending(replaced.Hash(), TxReplaced, common.Base58Encode(t.Hash()))
+	}
+	pool.pendingTx.Add(t)
+	pool.records.add(t.Hash(), TxPending, "")
+	if !t.IsDefer() {
+		pool.journal.insert(t)
+	}
+	if ec := event.GetCollector(); ec.HasSubscriber(event.PendingTxAdded) {
+		ec.Post(event.NewJSONEvent(event.PendingTxAdded, event.NewTxInfo(t, "")), event.TxMetas(t)...)
+	}
+}
+
+// delPending deletes the tx from pending list, records why it is deleted and posts the PendingTxRemoved event if the tx is in the list
+func (pool *TxPImpl) delPending(hash []byte, typ TxEventType, message string) {
+	pool.records.add(hash, typ, message)
+	ec := event.GetCollector()
+	if !ec.HasSubscriber(event.PendingTxRemoved) {
+		pool.pendingTx.Del(hash)
+		return
+	}
+	t := pool.pendingTx.Get(hash)
+	pool.pendingTx.Del(hash)
+	if t != nil {
+		ec.Post(event.NewJSONEvent(event.PendingTxRemoved, event.NewTxInfo(t, typ.String())), event.TxMetas(t)...)
 	}
 }
 
@@ -253,6 +434,15 @@ func (pool *TxPImpl) ExistTxs(hash []byte, chainBlock *block.Block) FRet {
 	return r
 }
 
+// ExistReplacement determine if the tx replaced by t, or the replacement of t exists in chain. The replacement
+// of t only counts if it is published by the publisher of t with a higher gas ratio.
+func (pool *TxPImpl) ExistReplacement(t *tx.Tx, chainBlock *block.Block) bool {
+	if t.IsReplacement() && pool.blockchainWrapper.existTxInChain(t.ReplacedTx, chainBlock) {
+		return true
+	}
+	return pool.blockchainWrapper.replacedInChain(t, chainBlock)
+}
+
 func (pool *TxPImpl) initBlockTx() {
 	pool.blockchainWrapper.init(pool.global.BlockChain())
 }
@@ -269,12 +459,50 @@ func (pool *TxPImpl) verifyTx(t *tx.Tx) error {
 		return fmt.Errorf("TimeError")
 	}
 	if err := t.VerifySelf(); err != nil {
-		return fmt.Errorf("VerifyError %v", err)
+		return &invalidTxError{err}
+	}
+	if t.IsReplacement() && !tx.IsReplacementEnabled(pool.blockchainWrapper.head.Head.Number+1) {
+		return ErrReplaceDisabled
+	}
+	replaced, err := pool.verifyReplacement(t)
+	if err != nil {
+		return err
+	}
+	for _, p := range pool.policies {
+		if err := p.Admit(t, replaced, pool.pendingTx); err != nil {
+			return err
+		}
 	}
-
 	return nil
 }
 
+// verifyReplacement checks whether t can replace the pending tx it refers to, and returns the tx to be replaced.
+func (pool *TxPImpl) verifyReplacement(t *tx.Tx) (*tx.Tx, error) {
+	replaced := pool.replacedPending(t)
+	if replaced == nil {
+		return nil, nil
+	}
+	if replaced.Publisher != t.Publisher {
+		return nil, ErrReplacePublisher
+	}
+	if t.GasRatio <= replaced.GasRatio {
+		return nil, ErrReplaceUnderpriced
+	}
+	return replaced, nil
+}
+
+// replacedPending returns the pending tx replaced by t, which is the tx t refers to or the former replacement of it.
+// The former replacement of another publisher is ignored, as it does not really replace the tx.
+func (pool *TxPImpl) replacedPending(t *tx.Tx) *tx.Tx {
+	if !t.IsReplacement() {
+		return nil
+	}
+	if r := pool.pendingTx.GetReplacement(t.ReplacedTx); r != nil && r.Publisher == t.Publisher {
+		return r
+	}
+	return pool.pendingTx.Get(t.ReplacedTx)
+}
+
 func (pool *TxPImpl) verifyDuplicate(t *tx.Tx) error {
 	if pool.existTxInPending(t.Hash()) {
 		return ErrDupPendingTx
@@ -282,9 +510,31 @@ func (pool *TxPImpl) verifyDuplicate(t *tx.Tx) error {
 	if pool.blockchainWrapper.existTxInChain(t.Hash(), nil) {
 		return ErrDupChainTx
 	}
+	if r := pool.pendingTx.GetReplacement(t.Hash()); r != nil && r.CanReplace(t) {
+		return ErrTxReplaced
+	}
+	if pool.blockchainWrapper.replacedInChain(t, nil) {
+		return ErrTxReplaced
+	}
+	if t.IsReplacement() && pool.blockchainWrapper.existTxInChain(t.ReplacedTx, nil) {
+		return ErrReplacedPacked
+	}
 	return nil
 }
 
+// recordRejected records the tx rejected by verifyDuplicate or verifyTx. The tx already in pending list is not recorded.
+func (pool *TxPImpl) recordRejected(hash []byte, err error) {
+	switch err {
+	case ErrDupPendingTx:
+	case ErrDupChainTx:
+		pool.records.add(hash, TxDuplicate, err.Error())
+	case ErrTxReplaced:
+		pool.records.add(hash, TxReplaced, err.Error())
+	default:
+		pool.records.add(hash, TxFailed, err.Error())
+	}
+}
+
 func (pool *TxPImpl) existTxInPending(hash []byte) bool {
 	return pool.pendingTx.Get(hash) != nil
 }
@@ -294,7 +544,7 @@ func (pool *TxPImpl) clearTimeoutTx() {
 	t, ok := iter.Next()
 	for ok {
 		if t.IsExpired(time.Now().UnixNano()) && !t.IsDefer() {
-			pool.pendingTx.Del(t.Hash())
+			pool.delPending(t.Hash(), TxExpired, "")
 		}
 		t, ok = iter.Next()
 	}
@@ -2,6 +2,8 @@ package txpool
 
 import (
 	"encoding/json"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -222,6 +224,111 @@ func TestNewTxPImpl(t *testing.T) {
 			r1 := txPool.ExistTxs(t.Hash(), bcn.Block)
 			So(r1, ShouldEqual, NotFound)
 		})
+		Convey("Replacement", func() {
+
+			oldHeight := tx.ReplacementHeight
+			tx.ReplacementHeight = 1
+			defer func() { tx.ReplacementHeight = oldHeight }()
+			t := genTx(accountList[0], tx.MaxExpiration)
+			err := txPool.AddTx(t)
+			So(err, ShouldBeNil)
+
+			err = txPool.AddTx(genReplacement(accountList[0], t, 100))
+			So(err, ShouldEqual, ErrReplaceUnderpriced)
+			err = txPool.AddTx(genReplacement(accountList[1], t, 200))
+			So(err, ShouldEqual, ErrReplacePublisher)
+
+			r1 := genReplacement(accountList[0], t, 200)
+			err = txPool.AddTx(r1)
+			So(err, ShouldBeNil)
+			So(txPool.testPendingTxsNum(), ShouldEqual, 1)
+			So(txPool.ExistTxs(t.Hash(), nil), ShouldEqual, NotFound)
+			record, err := txPool.GetTxRecord(t.Hash())
+			So(err, ShouldBeNil)
+			So(record.Last().Type, ShouldEqual, TxReplaced)
+			err = txPool.AddTx(t)
+			So(err, ShouldEqual, ErrTxReplaced)
+
+			r2 := genReplacement(accountList[0], t, 300)
+			err = txPool.AddTx(r2)
+			So(err, ShouldBeNil)
+			So(txPool.testPendingTxsNum(), ShouldEqual, 1)
+			So(txPool.ExistTxs(r2.Hash(), nil), ShouldEqual, FoundPending)
+		})
+		Convey("Replacement of another publisher", func() {
+
+			oldHeight := tx.ReplacementHeight
+			tx.ReplacementHeight = 1
+			defer func() { tx.ReplacementHeight = oldHeight }()
+			t := genTx(accountList[0], tx.MaxExpiration)
+			fake := genReplacement(accountList[1], t, 200)
+			err := txPool.AddTx(fake)
+			So(err, ShouldBeNil)
+			err = txPool.AddTx(t)
+			So(err, ShouldBeNil)
+			So(txPool.testPendingTxsNum(), ShouldEqual, 2)
+
+			r := genReplacement(accountList[0], t, 200)
+			err = txPool.AddTx(r)
+			So(err, ShouldBeNil)
+			So(txPool.ExistTxs(t.Hash(), nil), ShouldEqual, NotFound)
+			So(txPool.ExistTxs(fake.Hash(), nil), ShouldEqual, FoundPending)
+		})
+		Convey("Replacement disabled", func() {
+
+			t := genTx(accountList[0], tx.MaxExpiration)
+			err := txPool.AddTx(t)
+			So(err, ShouldBeNil)
+			err = txPool.AddTx(genReplacement(accountList[0], t, 200))
+			So(err, ShouldEqual, ErrReplaceDisabled)
+		})
+		Convey("Replacement in chain", func() {
+
+			t := genTx(accountList[0], tx.MaxExpiration)
+			b1 := genBlocks(accountList, witnessList, 1, 0, true)[0]
+			b1.Txs = append(b1.Txs, genReplacement(accountList[1], t, 200), genReplacement(accountList[0], t, 100))
+			txPool.blockchainWrapper.addBlock(b1)
+			So(txPool.ExistReplacement(t, b1), ShouldBeFalse)
+
+			b2 := genBlocks(accountList, witnessList, 1, 0, true)[0]
+			b2.Txs = append(b2.Txs, genReplacement(accountList[0], t, 200))
+			txPool.blockchainWrapper.addBlock(b2)
+			So(txPool.ExistReplacement(t, b2), ShouldBeTrue)
+		})
+		Convey("Account limit", func() {
+
+			txPool.SetAdmissionPolicies(NewPublisherQuotaPolicy(2))
+			for i := 0; i < 2; i++ {
+				err := txPool.AddTx(genTx(accountList[0], tx.MaxExpiration))
+				So(err, ShouldBeNil)
+			}
+			err := txPool.AddTx(genTx(accountList[0], tx.MaxExpiration))
+			So(err, ShouldEqual, ErrAccountTxsFull)
+			err = txPool.AddTx(genTx(accountList[1], tx.MaxExpiration))
+			So(err, ShouldBeNil)
+		})
+		Convey("Concurrent AddTx", func() {
+
+			txPool.SetAdmissionPolicies(NewPublisherQuotaPolicy(2))
+			txs := make([]*tx.Tx, 10)
+			for i := range txs {
+				txs[i] = genTx(accountList[0], tx.MaxExpiration)
+			}
+			var wg sync.WaitGroup
+			var added int32
+			for _, t := range txs {
+				wg.Add(1)
+				go func(t *tx.Tx) {
+					defer wg.Done()
+					if txPool.AddTx(t) == nil {
+						atomic.AddInt32(&added, 1)
+					}
+				}(t)
+			}
+			wg.Wait()
+			So(added, ShouldEqual, 2)
+			So(txPool.testPendingTxsNum(), ShouldEqual, 2)
+		})
 		stopTest(gbl)
 	})
 
@@ -693,6 +800,23 @@ func genTx(a *account.KeyPair, expirationIter int64) *tx.Tx {
 	return t1
 }
 
+func genReplacement(a *account.KeyPair, replaced *tx.Tx, gasRatio int64) *tx.Tx {
+	t := tx.NewTx(replaced.Actions, []string{a.ReadablePubkey()}, replaced.GasLimit, gasRatio, replaced.Expiration, 0, 0)
+	t.ReplacedTx = replaced.Hash()
+
+	sig, err := tx.SignTxContent(t, a.ReadablePubkey(), a)
+	if err != nil {
+		ilog.Debug("failed to SignTxContent")
+	}
+	t.Signs = append(t.Signs, sig)
+
+	t1, err := tx.SignTx(t, a.ReadablePubkey(), []*account.KeyPair{a})
+	if err != nil {
+		ilog.Debug("failed to SignTx")
+	}
+	return t1
+}
+
 func genTxMsg(a *account.KeyPair, expirationIter int64) *p2p.IncomingMessage {
 	t := genTx(a, expirationIter)
 
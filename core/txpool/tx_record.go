@@ -0,0 +1,159 @@
+package txpool
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/iost-official/go-iost/core/event"
+)
+
+// Values of tx records.
+var (
+	maxTxRecords = 100000
+	maxTxEvents  = 16
+	txRecordTTL  = int64(time.Hour)
+)
+
+// TxEventType is the type of the event in the lifecycle of a tx.
+type TxEventType int32
+
+const (
+	// TxPending means the tx is added to pending list.
+	TxPending TxEventType = iota
+	// TxPacked means the tx is packed in a block of the longest chain.
+	TxPacked
+	// TxForked means the block containing the tx is replaced by a fork, so the tx returns to pending list.
+	TxForked
+	// TxExpired means the tx is removed from pending list as it is expired.
+	TxExpired
+	// TxDuplicate means the tx is rejected as it exists in chain.
+	TxDuplicate
+	// TxFailed means the tx is rejected or dropped as it fails verification or execution.
+	TxFailed
+	// TxDeleted means the tx is deleted from pending list.
+	TxDeleted
+	// TxDropped means the tx is dropped as the block generation failed.
+	TxDropped
+	// TxReplaced means the tx is removed or rejected as it is replaced by another tx with higher gas ratio.
+	TxReplaced
+)
+
+var txEventTypeNames = map[TxEventType]string{
+	TxPending:   "pending",
+	TxPacked:    event.TxRemovedPacked,
+	TxForked:    "forked",
+	TxExpired:   event.TxRemovedExpired,
+	TxDuplicate: "duplicate",
+	TxFailed:    "failed",
+	TxDeleted:   event.TxRemovedDeleted,
+	TxDropped:   event.TxRemovedDropped,
+	TxReplaced:  event.TxRemovedReplaced,
+}
+
+// String returns the name of the type, which is also the reason of PendingTxRemoved event.
+func (t TxEventType) String() string {
+	return txEventTypeNames[t]
+}
+
+// TxEvent is an event in the lifecycle of a tx.
+type TxEvent struct {
+	Type    TxEventType
+	Time    int64
+	Message string
+}
+
+// TxRecord is the lifecycle of a tx in txpool.
+type TxRecord struct {
+	Hash   []byte
+	Events []*TxEvent
+
+	updated int64
+	elem    *list.Element
+}
+
+// Last returns the latest event of the tx.
+func (r *TxRecord) Last() *TxEvent {
+	return r.Events[len(r.Events)-1]
+}
+
+// txRecords keeps the records of the recent txs. The records not updated in txRecordTTL are dropped,
+// and the least recently updated ones are dropped if there are more than maxTxRecords.
+type txRecords struct {
+	mu      sync.Mutex
+	records map[string]*TxRecord
+	order   *list.List // the hashes from the least recently updated
+}
+
+func newTxRecords() *txRecords {
+	return &txRecords{
+		records: make(map[string]*TxRecord),
+		order:   list.New(),
+	}
+}
+
+// add appends the event to the record of hash, the event is ignored if it has the same type as the latest one.
+func (rs *txRecords) add(hash []byte, typ TxEventType, message string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	now := time.Now().UnixNano()
+	r, ok := rs.records[string(hash)]
+	if !ok {
+		r = &TxRecord{Hash: hash}
+		r.elem = rs.order.PushBack(r)
+		rs.records[string(hash)] = r
+		for rs.order.Len() > maxTxRecords {
+			rs.remove(rs.order.Front())
+		}
+	} else {
+		if r.Last().Type == typ {
+			return
+		}
+		rs.order.MoveToBack(r.elem)
+	}
+	r.updated = now
+	r.Events = append(r.Events, &TxEvent{Type: typ, Time: now, Message: message})
+	if len(r.Events) > maxTxEvents {
+		r.Events = r.Events[len(r.Events)-maxTxEvents:]
+	}
+}
+
+// get returns a copy of the record of hash.
+func (rs *txRecords) get(hash []byte) (*TxRecord, bool) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	r, ok := rs.records[string(hash)]
+	if !ok {
+		return nil, false
+	}
+	events := make([]*TxEvent, 0, len(r.Events))
+	for _, e := range r.Events {
+		ev := *e
+		events = append(events, &ev)
+	}
+	return &TxRecord{Hash: r.Hash, Events: events}, true
+}
+
+// clear drops the records not updated in txRecordTTL.
+func (rs *txRecords) clear() {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	limit := time.Now().UnixNano() - txRecordTTL
+	for e := rs.order.Front(); e != nil && e.Value.(*TxRecord).updated < limit; e = rs.order.Front() {
+		rs.remove(e)
+	}
+}
+
+func (rs *txRecords) remove(e *list.Element) {
+	r := rs.order.Remove(e).(*TxRecord)
+	delete(rs.records, string(r.Hash))
+}
+
+func (rs *txRecords) size() int {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return len(rs.records)
+}
@@ -0,0 +1,88 @@
+package txpool
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTxRecords(t *testing.T) {
+	Convey("test txRecords", t, func() {
+		rs := newTxRecords()
+
+		Convey("add", func() {
+			rs.add([]byte("a"), TxPending, "")
+			rs.add([]byte("a"), TxPending, "")
+			rs.add([]byte("a"), TxFailed, "VerifyError")
+			r, ok := rs.get([]byte("a"))
+			So(ok, ShouldBeTrue)
+			So(len(r.Events), ShouldEqual, 2)
+			So(r.Events[0].Type, ShouldEqual, TxPending)
+			So(r.Last().Type, ShouldEqual, TxFailed)
+			So(r.Last().Message, ShouldEqual, "VerifyError")
+			So(r.Last().Type.String(), ShouldEqual, "failed")
+
+			_, ok = rs.get([]byte("b"))
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("max events", func() {
+			for i := 0; i < maxTxEvents+2; i++ {
+				rs.add([]byte("a"), TxEventType(i%2), "")
+			}
+			r, _ := rs.get([]byte("a"))
+			So(len(r.Events), ShouldEqual, maxTxEvents)
+			So(r.Last().Type, ShouldEqual, TxPacked)
+		})
+
+		Convey("max records", func() {
+			old := maxTxRecords
+			maxTxRecords = 3
+			defer func() { maxTxRecords = old }()
+			for i := 0; i < 4; i++ {
+				rs.add([]byte(fmt.Sprint(i)), TxPending, "")
+			}
+			rs.add([]byte("1"), TxPacked, "")
+			rs.add([]byte("4"), TxPending, "")
+			So(rs.size(), ShouldEqual, 3)
+			_, ok := rs.get([]byte("2"))
+			So(ok, ShouldBeFalse)
+			_, ok = rs.get([]byte("1"))
+			So(ok, ShouldBeTrue)
+		})
+
+		Convey("clear", func() {
+			rs.add([]byte("a"), TxPending, "")
+			rs.clear()
+			So(rs.size(), ShouldEqual, 1)
+
+			old := txRecordTTL
+			txRecordTTL = -1
+			defer func() { txRecordTTL = old }()
+			rs.clear()
+			So(rs.size(), ShouldEqual, 0)
+		})
+	})
+}
+
+func TestRecordRejected(t *testing.T) {
+	Convey("test recordRejected", t, func() {
+		pool := &TxPImpl{records: newTxRecords()}
+		pool.recordRejected([]byte("a"), ErrDupPendingTx)
+		_, err := pool.GetTxRecord([]byte("a"))
+		So(err, ShouldEqual, ErrTxNotFound)
+
+		pool.recordRejected([]byte("a"), ErrDupChainTx)
+		r, err := pool.GetTxRecord([]byte("a"))
+		So(err, ShouldBeNil)
+		So(r.Last().Type, ShouldEqual, TxDuplicate)
+
+		pool.recordRejected([]byte("b"), errors.New("TimeError"))
+		r, err = pool.GetTxRecord([]byte("b"))
+		So(err, ShouldBeNil)
+		So(r.Last().Type, ShouldEqual, TxFailed)
+		So(r.Last().Message, ShouldEqual, "TimeError")
+	})
+}
@@ -18,6 +18,7 @@ var (
 	clearInterval = 10 * time.Second
 	filterTime    = int64(90 * time.Second)
 	maxCacheTxs   = 10000
+	maxAccountTxs = 64
 	maxTxTimeGap  = 5 * time.Second.Nanoseconds()
 
 	metricsReceivedTxCount = metrics.NewCounter("iost_tx_received_count", []string{"from"})
@@ -27,6 +28,13 @@ var (
 	ErrDupChainTx   = errors.New("tx exists in chain")
 	ErrCacheFull    = errors.New("txpool is full")
 	ErrTxNotFound   = errors.New("tx not found")
+
+	ErrAccountTxsFull     = errors.New("too many pending txs of the publisher")
+	ErrTxReplaced         = errors.New("tx is replaced")
+	ErrReplacedPacked     = errors.New("replaced tx exists in chain")
+	ErrReplacePublisher   = errors.New("replacement should have the same publisher as the replaced tx")
+	ErrReplaceUnderpriced = errors.New("replacement should have higher gas ratio than the replaced tx")
+	ErrReplaceDisabled    = errors.New("replacement is not enabled yet")
 )
 
 // FRet find the return value of the tx
@@ -101,8 +109,9 @@ func (f *forkChain) SetForkBCN(n *blockcache.BlockCacheNode) {
 }
 
 type blockTx struct {
-	txMap        *sync.Map // map[string]*tx.Tx
-	txReceiptMap *sync.Map // map[string]*tx.TxReceipt
+	txMap        *sync.Map           // map[string]*tx.Tx
+	txReceiptMap *sync.Map           // map[string]*tx.TxReceipt
+	replacedMap  map[string][]*tx.Tx // from the replaced tx hash to the replacements, read only after created
 	ParentHash   []byte
 	time         int64
 }
@@ -111,11 +120,15 @@ func newBlockTx(blk *block.Block) *blockTx {
 	b := &blockTx{
 		txMap:        new(sync.Map),
 		txReceiptMap: new(sync.Map),
+		replacedMap:  make(map[string][]*tx.Tx),
 		ParentHash:   blk.Head.ParentHash,
 		time:         blk.Head.Time,
 	}
 	for _, v := range blk.Txs {
 		b.txMap.Store(string(v.Hash()), v)
+		if v.IsReplacement() {
+			b.replacedMap[string(v.ReplacedTx)] = append(b.replacedMap[string(v.ReplacedTx)], v)
+		}
 	}
 	for _, v := range blk.Receipts {
 		b.txReceiptMap.Store(string(v.TxHash), v)
@@ -136,11 +149,18 @@ func (b *blockTx) getTxAndReceipt(hash []byte) (*tx.Tx, *tx.TxReceipt) {
 	return retTx, nil
 }
 
+func (b *blockTx) getReplacements(hash []byte) []*tx.Tx {
+	return b.replacedMap[string(hash)]
+}
+
 // SortedTxMap is a red black tree of tx.
 type SortedTxMap struct {
-	tree  *redblacktree.Tree
-	txMap map[string]*tx.Tx
-	rw    *sync.RWMutex
+	tree        *redblacktree.Tree
+	txMap       map[string]*tx.Tx
+	accountTxs  map[string]int    // the count of txs of each publisher
+	contractTxs map[string]int    // the count of txs calling each contract
+	replacement map[string]*tx.Tx // the replacement of each replaced tx
+	rw          *sync.RWMutex
 }
 
 func compareTx(a, b interface{}) int {
@@ -158,9 +178,12 @@ func compareTx(a, b interface{}) int {
 // NewSortedTxMap returns a new SortedTxMap instance.
 func NewSortedTxMap() *SortedTxMap {
 	return &SortedTxMap{
-		tree:  redblacktree.NewWith(compareTx),
-		txMap: make(map[string]*tx.Tx),
-		rw:    new(sync.RWMutex),
+		tree:        redblacktree.NewWith(compareTx),
+		txMap:       make(map[string]*tx.Tx),
+		accountTxs:  make(map[string]int),
+		contractTxs: make(map[string]int),
+		replacement: make(map[string]*tx.Tx),
+		rw:          new(sync.RWMutex),
 	}
 }
 
@@ -174,9 +197,20 @@ func (st *SortedTxMap) Get(hash []byte) *tx.Tx {
 // Add adds a tx in SortedTxMap.
 func (st *SortedTxMap) Add(tx *tx.Tx) {
 	st.rw.Lock()
+	defer st.rw.Unlock()
+
+	if _, ok := st.txMap[string(tx.Hash())]; ok {
+		return
+	}
 	st.tree.Put(tx, true)
 	st.txMap[string(tx.Hash())] = tx
-	st.rw.Unlock()
+	st.accountTxs[tx.Publisher]++
+	for _, c := range txContracts(tx) {
+		st.contractTxs[c]++
+	}
+	if tx.IsReplacement() {
+		st.replacement[string(tx.ReplacedTx)] = tx
+	}
 }
 
 // Del deletes a tx in SortedTxMap.
@@ -190,6 +224,56 @@ func (st *SortedTxMap) Del(hash []byte) {
 	}
 	st.tree.Remove(tx)
 	delete(st.txMap, string(hash))
+	if st.accountTxs[tx.Publisher]--; st.accountTxs[tx.Publisher] <= 0 {
+		delete(st.accountTxs, tx.Publisher)
+	}
+	for _, c := range txContracts(tx) {
+		if st.contractTxs[c]--; st.contractTxs[c] <= 0 {
+			delete(st.contractTxs, c)
+		}
+	}
+	if tx.IsReplacement() && st.replacement[string(tx.ReplacedTx)] == tx {
+		delete(st.replacement, string(tx.ReplacedTx))
+	}
+}
+
+// AccountSize returns the count of txs of the publisher in SortedTxMap.
+func (st *SortedTxMap) AccountSize(publisher string) int {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+	return st.accountTxs[publisher]
+}
+
+// ContractSize returns the count of txs calling the contract in SortedTxMap.
+func (st *SortedTxMap) ContractSize(contract string) int {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+	return st.contractTxs[contract]
+}
+
+// txContracts returns the distinct contracts called by the tx.
+func txContracts(t *tx.Tx) []string {
+	contracts := make([]string, 0, len(t.Actions))
+	for _, a := range t.Actions {
+		dup := false
+		for _, c := range contracts {
+			if c == a.Contract {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			contracts = append(contracts, a.Contract)
+		}
+	}
+	return contracts
+}
+
+// GetReplacement returns the tx which replaces the tx of hash.
+func (st *SortedTxMap) GetReplacement(hash []byte) *tx.Tx {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+	return st.replacement[string(hash)]
 }
 
 // Size returns the size of SortedTxMap.
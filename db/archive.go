@@ -0,0 +1,271 @@
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/iost-official/go-iost/db/kv"
+)
+
+// The layout of archive in storage, every flush of mvccdb is a new version
+//
+//	/archive/version                  -> last version
+//	/archive/pruned                   -> the history before the version is pruned
+//	/archive/tag/<tag>                -> version of the tag
+//	/archive/data/<table>/<key>/<ver> -> flag + value of the key at version
+var (
+	archiveVersionKey = []byte(string(SEPARATOR) + "archive" + string(SEPARATOR) + "version")
+	archivePrunedKey  = []byte(string(SEPARATOR) + "archive" + string(SEPARATOR) + "pruned")
+	archiveTagPrefix  = []byte(string(SEPARATOR) + "archive" + string(SEPARATOR) + "tag" + string(SEPARATOR))
+	archiveDataPrefix = []byte(string(SEPARATOR) + "archive" + string(SEPARATOR) + "data" + string(SEPARATOR))
+)
+
+// flag of archived value
+const (
+	archiveDeleted byte = iota
+	archivePut
+)
+
+// error of archive
+var (
+	ErrNotArchive      = fmt.Errorf("mvccdb is not in archive mode")
+	ErrTagNotArchived  = fmt.Errorf("tag is not archived")
+	ErrHistoryReadOnly = fmt.Errorf("history of mvccdb is read only")
+	ErrTagPruned       = fmt.Errorf("history of tag is pruned")
+)
+
+// the max count of deleted keys in a batch of pruning
+const archivePruneBatch = 10000
+
+// archive keeps the versioned state of every flushed tag
+type archive struct {
+	storage *kv.Storage
+	version int64
+	pruned  int64
+	rwmu    sync.RWMutex
+}
+
+func newArchive(storage *kv.Storage, tag string) (*archive, error) {
+	a := &archive{
+		storage: storage,
+	}
+	v, err := storage.Get(archiveVersionKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archive version from storage: %v", err)
+	}
+	if len(v) != 0 {
+		a.version = int64(binary.BigEndian.Uint64(v))
+	}
+	v, err = storage.Get(archivePrunedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archive pruned version from storage: %v", err)
+	}
+	if len(v) != 0 {
+		a.pruned = int64(binary.BigEndian.Uint64(v))
+	}
+	if tag == "" {
+		return a, nil
+	}
+	if _, err := a.tagVersion(tag); err != nil {
+		return nil, fmt.Errorf("the flushed state is not archived, archive mode must start from an empty statedb: %v", err)
+	}
+	return a, nil
+}
+
+func encodeVersion(version int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(version))
+	return b
+}
+
+func archiveDataKey(key []byte, version int64) []byte {
+	k := make([]byte, 0, len(archiveDataPrefix)+len(key)+9)
+	k = append(k, archiveDataPrefix...)
+	k = append(k, key...)
+	k = append(k, SEPARATOR)
+	return append(k, encodeVersion(version)...)
+}
+
+// tagVersion returns the archived version of the tag
+func (a *archive) tagVersion(t string) (int64, error) {
+	v, err := a.storage.Get(append(append([]byte{}, archiveTagPrefix...), t...))
+	if err != nil {
+		return 0, err
+	}
+	if len(v) != 8 {
+		return 0, ErrTagNotArchived
+	}
+	version := int64(binary.BigEndian.Uint64(v))
+	a.rwmu.RLock()
+	defer a.rwmu.RUnlock()
+	if version < a.pruned {
+		return 0, ErrTagPruned
+	}
+	return version, nil
+}
+
+// write will put the items of the tag into the batch as the next version
+func (a *archive) write(batch kv.Batch, t string, items []*Item) error {
+	version := a.version + 1
+	if err := batch.Put(archiveVersionKey, encodeVersion(version)); err != nil {
+		return err
+	}
+	if err := batch.Put(append(append([]byte{}, archiveTagPrefix...), t...), encodeVersion(version)); err != nil {
+		return err
+	}
+	for _, item := range items {
+		value := []byte{archivePut}
+		if item.deleted {
+			value = []byte{archiveDeleted}
+		} else {
+			value = append(value, item.value...)
+		}
+		if err := batch.Put(archiveDataKey([]byte(item.table+string(SEPARATOR)+item.key), version), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// commit moves the archive to the next version after the batch is committed
+func (a *archive) commit() {
+	a.version++
+}
+
+// get returns the value of the key at the version, nil if the key doesn't exist
+func (a *archive) get(key []byte, version int64) ([]byte, error) {
+	iter := a.storage.NewIterator(&kv.IteratorOptions{
+		Start:   archiveDataKey(key, 0),
+		End:     archiveDataKey(key, version+1),
+		Reverse: true,
+	})
+	defer iter.Release()
+
+	// skip the longer keys which are prefixed with key and separator
+	size := len(archiveDataKey(key, 0))
+	for iter.Next() {
+		if len(iter.Key()) != size {
+			continue
+		}
+		value := iter.Value()
+		if len(value) == 0 || value[0] == archiveDeleted {
+			return nil, nil
+		}
+		return append([]byte{}, value[1:]...), nil
+	}
+	return nil, iter.Error()
+}
+
+// archiveReader reads the storage at the version of archive
+type archiveReader struct {
+	archive *archive
+	version int64
+}
+
+// Get returns the value of the key at the version
+func (r *archiveReader) Get(key []byte) ([]byte, error) {
+	v, err := r.archive.get(key, r.version)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return []byte{}, nil
+	}
+	return v, nil
+}
+
+// Has returns whether the key exists at the version
+func (r *archiveReader) Has(key []byte) (bool, error) {
+	v, err := r.archive.get(key, r.version)
+	if err != nil {
+		return false, err
+	}
+	return v != nil, nil
+}
+
+// prune removes the history before the version, only the last value of every key
+// not after the version is kept
+func (a *archive) prune(version int64) error {
+	a.rwmu.Lock()
+	if version <= a.pruned {
+		a.rwmu.Unlock()
+		return nil
+	}
+	// refuse the checkout of pruned tags before removing their data
+	a.pruned = version
+	a.rwmu.Unlock()
+
+	batch := a.storage.NewBatch()
+	count := 0
+	del := func(key []byte) error {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+		count++
+		if count < archivePruneBatch {
+			return nil
+		}
+		if err := batch.Commit(); err != nil {
+			return err
+		}
+		batch = a.storage.NewBatch()
+		count = 0
+		return nil
+	}
+
+	// the last key not after the version of current group, and whether it's deleted
+	var group, last []byte
+	var lastDeleted bool
+	flush := func() error {
+		if last != nil && lastDeleted {
+			return del(last)
+		}
+		return nil
+	}
+	iter := a.storage.NewIteratorByPrefix(archiveDataPrefix)
+	for iter.Next() {
+		key := iter.Key()
+		if len(key) < len(archiveDataPrefix)+8 {
+			continue
+		}
+		g := key[:len(key)-8]
+		if !bytes.Equal(g, group) {
+			if err := flush(); err != nil {
+				iter.Release()
+				batch.Discard()
+				return err
+			}
+			group = append([]byte{}, g...)
+			last = nil
+		}
+		if int64(binary.BigEndian.Uint64(key[len(key)-8:])) > version {
+			continue
+		}
+		if last != nil {
+			if err := del(last); err != nil {
+				iter.Release()
+				batch.Discard()
+				return err
+			}
+		}
+		last = append([]byte{}, key...)
+		value := iter.Value()
+		lastDeleted = len(value) == 0 || value[0] == archiveDeleted
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		batch.Discard()
+		return err
+	}
+	if err := flush(); err != nil {
+		batch.Discard()
+		return err
+	}
+	if err := batch.Put(archivePrunedKey, encodeVersion(version)); err != nil {
+		batch.Discard()
+		return err
+	}
+	return batch.Commit()
+}
@@ -0,0 +1,350 @@
+package badger
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger"
+	"github.com/dgraph-io/badger/options"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/db/kv/types"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// The value log is garbage collected periodically, rewriting a file when at
+// least half of it is stale.
+const (
+	gcInterval     = 5 * time.Minute
+	gcDiscardRatio = 0.5
+)
+
+// DB is the badger database
+type DB struct {
+	db        *badger.DB
+	quit      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// NewDB return new badger db
+func NewDB(path string) (*DB, error) {
+	opts := badger.DefaultOptions
+	opts.Dir = path
+	opts.ValueDir = path
+	opts.TableLoadingMode = options.MemoryMap
+	opts.Truncate = true
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, err
+	}
+	d := &DB{
+		db:   db,
+		quit: make(chan struct{}),
+	}
+	d.wg.Add(1)
+	go d.gcLoop()
+	return d, nil
+}
+
+func (d *DB) gcLoop() {
+	defer d.wg.Done()
+	ticker := time.NewTicker(gcInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.quit:
+			return
+		case <-ticker.C:
+			for d.db.RunValueLogGC(gcDiscardRatio) == nil {
+			}
+		}
+	}
+}
+
+// Get return the value of the specify key
+func (d *DB) Get(key []byte) ([]byte, error) {
+	var value []byte
+	err := d.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		if err != nil {
+			return err
+		}
+		value, err = item.ValueCopy(nil)
+		return err
+	})
+	if err == badger.ErrKeyNotFound {
+		return []byte{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		value = []byte{}
+	}
+	return value, nil
+}
+
+// Has returns whether the specified key exists
+func (d *DB) Has(key []byte) (bool, error) {
+	err := d.db.View(func(txn *badger.Txn) error {
+		_, err := txn.Get(key)
+		return err
+	})
+	if err == badger.ErrKeyNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Put will insert the key-value pair
+func (d *DB) Put(key []byte, value []byte) error {
+	return d.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(key, value)
+	})
+}
+
+// Delete will remove the specify key
+func (d *DB) Delete(key []byte) error {
+	return d.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(key)
+	})
+}
+
+// Keys returns the list of key prefixed with prefix
+func (d *DB) Keys(prefix []byte) ([][]byte, error) {
+	keys := make([][]byte, 0)
+	err := d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		iter := txn.NewIterator(opts)
+		defer iter.Close()
+		for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+			keys = append(keys, iter.Item().KeyCopy(nil))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// NewBatch returns a new independent batch
+func (d *DB) NewBatch() types.Batch {
+	return &Batch{
+		txn: d.db.NewTransaction(true),
+	}
+}
+
+// Batch is the write batch of badger, backed by an update transaction. The first
+// failed write, such as the one exceeding the size of the transaction, is kept
+// and returned by Commit without writing anything.
+type Batch struct {
+	txn *badger.Txn
+	err error
+}
+
+// Put will insert the key-value pair into the batch
+func (b *Batch) Put(key []byte, value []byte) error {
+	if b.txn == nil {
+		return types.ErrBatchDone
+	}
+	if b.err == nil {
+		b.err = b.txn.Set(common.CopyBytes(key), common.CopyBytes(value))
+	}
+	return b.err
+}
+
+// Delete will remove the specify key in the batch
+func (b *Batch) Delete(key []byte) error {
+	if b.txn == nil {
+		return types.ErrBatchDone
+	}
+	if b.err == nil {
+		b.err = b.txn.Delete(common.CopyBytes(key))
+	}
+	return b.err
+}
+
+// Commit will write the batch to badger atomically
+func (b *Batch) Commit() error {
+	if b.txn == nil {
+		return types.ErrBatchDone
+	}
+	if b.err != nil {
+		b.Discard()
+		return b.err
+	}
+	err := b.txn.Commit(nil)
+	b.txn = nil
+	return err
+}
+
+// Discard will drop the writes of the batch
+func (b *Batch) Discard() {
+	if b.txn == nil {
+		return
+	}
+	b.txn.Discard()
+	b.txn = nil
+}
+
+// Size returns the size of badger
+func (d *DB) Size() (int64, error) {
+	lsm, vlog := d.db.Size()
+	return lsm + vlog, nil
+}
+
+// Compact will rewrite the stale value log files, the key range is ignored
+// as badger compacts the lsm tree by itself
+func (d *DB) Compact(start, end []byte) error {
+	for {
+		err := d.db.RunValueLogGC(gcDiscardRatio)
+		if err == badger.ErrNoRewrite || err == badger.ErrRejected {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Close will close the database, the later calls return the error of the first one
+func (d *DB) Close() error {
+	d.closeOnce.Do(func() {
+		close(d.quit)
+		d.wg.Wait()
+		d.closeErr = d.db.Close()
+	})
+	return d.closeErr
+}
+
+// NewIteratorByPrefix returns a new iterator by prefix
+func (d *DB) NewIteratorByPrefix(prefix []byte) types.Iterator {
+	r := util.BytesPrefix(prefix)
+	return d.NewIterator(&types.IteratorOptions{
+		Start: r.Start,
+		End:   r.Limit,
+	})
+}
+
+// NewIterator returns a new iterator by options
+func (d *DB) NewIterator(opts *types.IteratorOptions) types.Iterator {
+	txn := d.db.NewTransaction(false)
+	iterOpts := badger.DefaultIteratorOptions
+	iterOpts.Reverse = opts.Reverse
+	return &Iter{
+		txn:     txn,
+		iter:    txn.NewIterator(iterOpts),
+		start:   opts.Start,
+		end:     opts.End,
+		reverse: opts.Reverse,
+		limit:   opts.Limit,
+	}
+}
+
+// Iter is the iterator for badger
+type Iter struct {
+	txn     *badger.Txn
+	iter    *badger.Iterator
+	start   []byte
+	end     []byte
+	reverse bool
+	limit   int
+	count   int
+	started bool
+	err     error
+}
+
+// Next do next item of iterator
+func (i *Iter) Next() bool {
+	if !i.started {
+		i.started = true
+		if i.reverse {
+			return i.seekLast()
+		}
+		i.iter.Seek(i.start)
+	} else {
+		i.iter.Next()
+	}
+	return i.take()
+}
+
+// Seek moves the iterator to the first item not before key in iteration order
+func (i *Iter) Seek(key []byte) bool {
+	i.started = true
+	if i.reverse {
+		if i.end != nil && bytes.Compare(key, i.end) >= 0 {
+			return i.seekLast()
+		}
+		i.iter.Seek(key)
+		return i.take()
+	}
+	if bytes.Compare(key, i.start) < 0 {
+		key = i.start
+	}
+	i.iter.Seek(key)
+	return i.take()
+}
+
+// seekLast moves the reverse iterator to the last key before end
+func (i *Iter) seekLast() bool {
+	if i.end == nil {
+		i.iter.Rewind()
+		return i.take()
+	}
+	i.iter.Seek(i.end)
+	if i.iter.Valid() && bytes.Equal(i.iter.Item().Key(), i.end) {
+		i.iter.Next()
+	}
+	return i.take()
+}
+
+func (i *Iter) take() bool {
+	if !i.iter.Valid() {
+		return false
+	}
+	key := i.iter.Item().Key()
+	if i.end != nil && bytes.Compare(key, i.end) >= 0 {
+		return false
+	}
+	if bytes.Compare(key, i.start) < 0 {
+		return false
+	}
+	if i.limit > 0 && i.count >= i.limit {
+		return false
+	}
+	i.count++
+	return true
+}
+
+// Key returns the key of current item
+func (i *Iter) Key() []byte {
+	return i.iter.Item().Key()
+}
+
+// Value returns the value of current item
+func (i *Iter) Value() []byte {
+	value, err := i.iter.Item().Value()
+	if err != nil {
+		i.err = err
+		return nil
+	}
+	return value
+}
+
+// Error returns the error of iterator
+func (i *Iter) Error() error {
+	return i.err
+}
+
+// Release will release the iterator
+func (i *Iter) Release() {
+	i.iter.Close()
+	i.txn.Discard()
+}
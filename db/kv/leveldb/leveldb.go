@@ -1,8 +1,9 @@
 package leveldb
 
 import (
-	"fmt"
+	"bytes"
 
+	"github.com/iost-official/go-iost/db/kv/types"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/iterator"
 	"github.com/syndtr/goleveldb/leveldb/util"
@@ -10,8 +11,7 @@ import (
 
 // DB is the leveldb databse
 type DB struct {
-	db    *leveldb.DB
-	batch *leveldb.Batch
+	db *leveldb.DB
 }
 
 // NewDB return new leveldb
@@ -21,8 +21,7 @@ func NewDB(path string) (*DB, error) {
 		return nil, err
 	}
 	return &DB{
-		db:    db,
-		batch: nil,
+		db: db,
 	}, nil
 }
 
@@ -43,20 +42,12 @@ func (d *DB) Has(key []byte) (bool, error) {
 
 // Put will insert the key-value pair
 func (d *DB) Put(key []byte, value []byte) error {
-	if d.batch == nil {
-		return d.db.Put(key, value, nil)
-	}
-	d.batch.Put(key, value)
-	return nil
+	return d.db.Put(key, value, nil)
 }
 
 // Delete will remove the specify key
 func (d *DB) Delete(key []byte) error {
-	if d.batch == nil {
-		return d.db.Delete(key, nil)
-	}
-	d.batch.Delete(key)
-	return nil
+	return d.db.Delete(key, nil)
 }
 
 // Keys returns the list of key prefixed with prefix
@@ -77,28 +68,53 @@ func (d *DB) Keys(prefix []byte) ([][]byte, error) {
 	return keys, nil
 }
 
-// BeginBatch will start the batch transaction
-func (d *DB) BeginBatch() error {
-	if d.batch != nil {
-		return fmt.Errorf("not support nested batch write")
+// NewBatch returns a new independent batch
+func (d *DB) NewBatch() types.Batch {
+	return &Batch{
+		db:    d.db,
+		batch: new(leveldb.Batch),
 	}
-	d.batch = new(leveldb.Batch)
-	return nil
 }
 
-// CommitBatch will commit the batch transaction
-func (d *DB) CommitBatch() error {
-	if d.batch == nil {
-		return fmt.Errorf("no batch write to commit")
+// Batch is the write batch of leveldb
+type Batch struct {
+	db    *leveldb.DB
+	batch *leveldb.Batch
+}
+
+// Put will insert the key-value pair into the batch
+func (b *Batch) Put(key []byte, value []byte) error {
+	if b.batch == nil {
+		return types.ErrBatchDone
 	}
-	err := d.db.Write(d.batch, nil)
-	if err != nil {
-		return err
+	b.batch.Put(key, value)
+	return nil
+}
+
+// Delete will remove the specify key in the batch
+func (b *Batch) Delete(key []byte) error {
+	if b.batch == nil {
+		return types.ErrBatchDone
 	}
-	d.batch = nil
+	b.batch.Delete(key)
 	return nil
 }
 
+// Commit will write the batch to leveldb atomically
+func (b *Batch) Commit() error {
+	if b.batch == nil {
+		return types.ErrBatchDone
+	}
+	err := b.db.Write(b.batch, nil)
+	b.batch = nil
+	return err
+}
+
+// Discard will drop the writes of the batch
+func (b *Batch) Discard() {
+	b.batch = nil
+}
+
 // Size returns the size of leveldb
 func (d *DB) Size() (int64, error) {
 	stats := &leveldb.DBStats{}
@@ -112,27 +128,91 @@ func (d *DB) Size() (int64, error) {
 	return total, nil
 }
 
+// Compact will compact the key range [start, end) of leveldb, nil means unbounded
+func (d *DB) Compact(start, end []byte) error {
+	return d.db.CompactRange(util.Range{Start: start, Limit: end})
+}
+
 // Close will close the database
 func (d *DB) Close() error {
 	return d.db.Close()
 }
 
 // NewIteratorByPrefix returns a new iterator by prefix
-func (d *DB) NewIteratorByPrefix(prefix []byte) interface{} {
-	iter := d.db.NewIterator(util.BytesPrefix(prefix), nil)
-	return &Iter{
-		iter: iter,
-	}
+func (d *DB) NewIteratorByPrefix(prefix []byte) types.Iterator {
+	r := util.BytesPrefix(prefix)
+	return d.NewIterator(&types.IteratorOptions{
+		Start: r.Start,
+		End:   r.Limit,
+	})
+}
+
+// NewIterator returns a new iterator by options
+func (d *DB) NewIterator(opts *types.IteratorOptions) types.Iterator {
+	iter := d.db.NewIterator(&util.Range{Start: opts.Start, Limit: opts.End}, nil)
+	return NewIter(iter, opts)
 }
 
 // Iter is the iterator for leveldb
 type Iter struct {
-	iter iterator.Iterator
+	iter    iterator.Iterator
+	reverse bool
+	limit   int
+	count   int
+	started bool
+}
+
+// NewIter returns the range iterator over leveldb style iterator, the range
+// of opts should already be applied to iter
+func NewIter(iter iterator.Iterator, opts *types.IteratorOptions) *Iter {
+	return &Iter{
+		iter:    iter,
+		reverse: opts.Reverse,
+		limit:   opts.Limit,
+	}
 }
 
 // Next do next item of iterator
 func (i *Iter) Next() bool {
-	return i.iter.Next()
+	var ok bool
+	switch {
+	case !i.started && i.reverse:
+		ok = i.iter.Last()
+	case !i.started:
+		ok = i.iter.First()
+	case i.reverse:
+		ok = i.iter.Prev()
+	default:
+		ok = i.iter.Next()
+	}
+	i.started = true
+	return i.take(ok)
+}
+
+// Seek moves the iterator to the first item not before key in iteration order
+func (i *Iter) Seek(key []byte) bool {
+	i.started = true
+	ok := i.iter.Seek(key)
+	if i.reverse {
+		switch {
+		case !ok:
+			ok = i.iter.Last()
+		case !bytes.Equal(i.iter.Key(), key):
+			ok = i.iter.Prev()
+		}
+	}
+	return i.take(ok)
+}
+
+func (i *Iter) take(ok bool) bool {
+	if !ok {
+		return false
+	}
+	if i.limit > 0 && i.count >= i.limit {
+		return false
+	}
+	i.count++
+	return true
 }
 
 // Key returns the key of current item
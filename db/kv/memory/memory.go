@@ -0,0 +1,185 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/iost-official/go-iost/db/kv/leveldb"
+	"github.com/iost-official/go-iost/db/kv/types"
+	goleveldb "github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/comparer"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// DB is the in-memory database, nothing is persisted after Close
+type DB struct {
+	db   *memdb.DB
+	rwmu sync.RWMutex
+}
+
+// NewDB return new memory database, the path is ignored
+func NewDB(path string) (*DB, error) {
+	return &DB{
+		db: memdb.New(comparer.DefaultComparer, 0),
+	}, nil
+}
+
+// Get return the value of the specify key
+func (d *DB) Get(key []byte) ([]byte, error) {
+	d.rwmu.RLock()
+	defer d.rwmu.RUnlock()
+
+	value, err := d.db.Get(key)
+	if err == memdb.ErrNotFound {
+		return []byte{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	v := make([]byte, len(value))
+	copy(v, value)
+	return v, nil
+}
+
+// Has returns whether the specified key exists
+func (d *DB) Has(key []byte) (bool, error) {
+	d.rwmu.RLock()
+	defer d.rwmu.RUnlock()
+
+	return d.db.Contains(key), nil
+}
+
+// Put will insert the key-value pair
+func (d *DB) Put(key []byte, value []byte) error {
+	d.rwmu.Lock()
+	defer d.rwmu.Unlock()
+
+	return d.db.Put(key, value)
+}
+
+// Delete will remove the specify key
+func (d *DB) Delete(key []byte) error {
+	d.rwmu.Lock()
+	defer d.rwmu.Unlock()
+
+	err := d.db.Delete(key)
+	if err == memdb.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+// Keys returns the list of key prefixed with prefix
+func (d *DB) Keys(prefix []byte) ([][]byte, error) {
+	iter := d.db.NewIterator(util.BytesPrefix(prefix))
+	keys := make([][]byte, 0)
+
+	for iter.Next() {
+		key := make([]byte, len(iter.Key()))
+		copy(key, iter.Key())
+		keys = append(keys, key)
+	}
+	iter.Release()
+	err := iter.Error()
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// NewBatch returns a new independent batch
+func (d *DB) NewBatch() types.Batch {
+	return &Batch{
+		d:     d,
+		batch: new(goleveldb.Batch),
+	}
+}
+
+// Batch is the write batch of memory database
+type Batch struct {
+	d     *DB
+	batch *goleveldb.Batch
+}
+
+// Put will insert the key-value pair into the batch
+func (b *Batch) Put(key []byte, value []byte) error {
+	if b.batch == nil {
+		return types.ErrBatchDone
+	}
+	b.batch.Put(key, value)
+	return nil
+}
+
+// Delete will remove the specify key in the batch
+func (b *Batch) Delete(key []byte) error {
+	if b.batch == nil {
+		return types.ErrBatchDone
+	}
+	b.batch.Delete(key)
+	return nil
+}
+
+// Commit will write the batch to memory database atomically
+func (b *Batch) Commit() error {
+	if b.batch == nil {
+		return types.ErrBatchDone
+	}
+	b.d.rwmu.Lock()
+	defer b.d.rwmu.Unlock()
+
+	err := b.batch.Replay(&replayer{db: b.d.db})
+	b.batch = nil
+	return err
+}
+
+// Discard will drop the writes of the batch
+func (b *Batch) Discard() {
+	b.batch = nil
+}
+
+// replayer applies the records of a batch to the memdb
+type replayer struct {
+	db *memdb.DB
+}
+
+func (r *replayer) Put(key, value []byte) {
+	r.db.Put(key, value)
+}
+
+func (r *replayer) Delete(key []byte) {
+	r.db.Delete(key)
+}
+
+// Size returns the size of memory database
+func (d *DB) Size() (int64, error) {
+	return int64(d.db.Size()), nil
+}
+
+// Compact does nothing as memory database has nothing to compact
+func (d *DB) Compact(start, end []byte) error {
+	return nil
+}
+
+// Close will close the database
+func (d *DB) Close() error {
+	d.rwmu.Lock()
+	defer d.rwmu.Unlock()
+
+	d.db.Reset()
+	return nil
+}
+
+// NewIteratorByPrefix returns a new iterator by prefix
+func (d *DB) NewIteratorByPrefix(prefix []byte) types.Iterator {
+	r := util.BytesPrefix(prefix)
+	return d.NewIterator(&types.IteratorOptions{
+		Start: r.Start,
+		End:   r.Limit,
+	})
+}
+
+// NewIterator returns a new iterator by options
+func (d *DB) NewIterator(opts *types.IteratorOptions) types.Iterator {
+	iter := d.db.NewIterator(&util.Range{Start: opts.Start, Limit: opts.End})
+	return leveldb.NewIter(iter, opts)
+}
@@ -1,18 +1,40 @@
 package kv
 
 import (
+	"fmt"
+
+	"github.com/iost-official/go-iost/db/kv/badger"
 	"github.com/iost-official/go-iost/db/kv/leveldb"
+	"github.com/iost-official/go-iost/db/kv/memory"
+	"github.com/iost-official/go-iost/db/kv/types"
 )
 
-// StorageType is the type of storage, include leveldb and rocksdb
+// StorageType is the type of storage, include leveldb, memory and badger
 type StorageType uint8
 
 // Storage type constant
 const (
 	_ StorageType = iota
 	LevelDBStorage
+	MemoryStorage
+	BadgerStorage
 )
 
+// ParseStorageType returns the storage type of the name used in config,
+// an empty name means leveldb
+func ParseStorageType(name string) (StorageType, error) {
+	switch name {
+	case "", "leveldb":
+		return LevelDBStorage, nil
+	case "memory":
+		return MemoryStorage, nil
+	case "badger":
+		return BadgerStorage, nil
+	default:
+		return 0, fmt.Errorf("unknown storage type: %v", name)
+	}
+}
+
 // StorageBackend is the storage backend interface
 type StorageBackend interface {
 	Get(key []byte) ([]byte, error)
@@ -20,11 +42,12 @@ type StorageBackend interface {
 	Has(key []byte) (bool, error)
 	Delete(key []byte) error
 	Keys(prefix []byte) ([][]byte, error)
-	BeginBatch() error
-	CommitBatch() error
+	NewBatch() Batch
 	Size() (int64, error)
+	Compact(start, end []byte) error
 	Close() error
-	NewIteratorByPrefix(prefix []byte) interface{}
+	NewIteratorByPrefix(prefix []byte) IteratorBackend
+	NewIterator(opts *IteratorOptions) IteratorBackend
 }
 
 // Storage is a kv database
@@ -41,32 +64,63 @@ func NewStorage(path string, t StorageType) (*Storage, error) {
 			return nil, err
 		}
 		return &Storage{StorageBackend: sb}, nil
-	default:
-		sb, err := leveldb.NewDB(path)
+	case MemoryStorage:
+		sb, err := memory.NewDB(path)
+		if err != nil {
+			return nil, err
+		}
+		return &Storage{StorageBackend: sb}, nil
+	case BadgerStorage:
+		sb, err := badger.NewDB(path)
 		if err != nil {
 			return nil, err
 		}
 		return &Storage{StorageBackend: sb}, nil
+	default:
+		return nil, fmt.Errorf("unknown storage type: %v", t)
 	}
 }
 
 // NewIteratorByPrefix returns a new iterator by prefix
 func (s *Storage) NewIteratorByPrefix(prefix []byte) *Iterator {
-	ib := s.StorageBackend.NewIteratorByPrefix(prefix).(IteratorBackend)
 	return &Iterator{
-		IteratorBackend: ib,
+		IteratorBackend: s.StorageBackend.NewIteratorByPrefix(prefix),
 	}
 }
 
-// IteratorBackend is the storage iterator backend
-type IteratorBackend interface {
-	Next() bool
-	Key() []byte
-	Value() []byte
-	Error() error
-	Release()
+// NewIterator returns a new iterator over the range [opts.Start, opts.End)
+func (s *Storage) NewIterator(opts *IteratorOptions) *Iterator {
+	return &Iterator{
+		IteratorBackend: s.StorageBackend.NewIterator(opts),
+	}
+}
+
+// PrefixEnd returns the smallest key greater than all keys with the prefix,
+// nil if there is no such key
+func PrefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
 }
 
+// IteratorOptions is the options of the storage iterator
+type IteratorOptions = types.IteratorOptions
+
+// Batch is the independent write batch of storage
+type Batch = types.Batch
+
+// ErrBatchDone is returned when the batch is used after Commit or Discard
+var ErrBatchDone = types.ErrBatchDone
+
+// IteratorBackend is the storage iterator backend
+type IteratorBackend = types.Iterator
+
 // Iterator is the storage iterator
 type Iterator struct {
 	IteratorBackend
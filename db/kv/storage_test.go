@@ -2,6 +2,8 @@ package kv
 
 import (
 	"crypto/rand"
+	"fmt"
+	"os"
 	"os/exec"
 	"reflect"
 	"testing"
@@ -92,6 +94,21 @@ func (suite *StorageTestSuite) TestDelete() {
 	suite.Equal([]byte("value05"), value)
 }
 
+func (suite *StorageTestSuite) TestCompact() {
+	suite.Nil(suite.storage.Delete([]byte("key01")))
+	suite.Nil(suite.storage.Delete([]byte("key02")))
+
+	suite.Nil(suite.storage.Compact([]byte("key"), PrefixEnd([]byte("key"))))
+	suite.Nil(suite.storage.Compact(nil, nil))
+
+	value, err := suite.storage.Get([]byte("key01"))
+	suite.Nil(err)
+	suite.Equal([]byte{}, value)
+	value, err = suite.storage.Get([]byte("key03"))
+	suite.Nil(err)
+	suite.Equal([]byte("value03"), value)
+}
+
 func (suite *StorageTestSuite) TestKeys() {
 	var keys [][]byte
 	var err error
@@ -122,6 +139,65 @@ func (suite *StorageTestSuite) TestKeys() {
 	)
 }
 
+func (suite *StorageTestSuite) iterKeys(iter *Iterator) []string {
+	keys := make([]string, 0)
+	for iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	iter.Release()
+	suite.Nil(iter.Error())
+	return keys
+}
+
+func (suite *StorageTestSuite) TestIterator() {
+	iter := suite.storage.NewIteratorByPrefix([]byte("key"))
+	suite.Equal([]string{"key01", "key02", "key03", "key04", "key05"}, suite.iterKeys(iter))
+
+	iter = suite.storage.NewIterator(&IteratorOptions{
+		Start: []byte("iost03"),
+		End:   []byte("key02"),
+	})
+	suite.Equal([]string{"iost03", "iost04", "iost05", "key01"}, suite.iterKeys(iter))
+
+	iter = suite.storage.NewIterator(&IteratorOptions{
+		Start:   []byte("iost03"),
+		End:     []byte("key02"),
+		Reverse: true,
+	})
+	suite.Equal([]string{"key01", "iost05", "iost04", "iost03"}, suite.iterKeys(iter))
+
+	iter = suite.storage.NewIterator(&IteratorOptions{
+		Reverse: true,
+		Limit:   3,
+	})
+	suite.Equal([]string{"key05", "key04", "key03"}, suite.iterKeys(iter))
+
+	iter = suite.storage.NewIterator(&IteratorOptions{
+		Start: []byte("key"),
+		Limit: 2,
+	})
+	suite.True(iter.Seek([]byte("key031")))
+	suite.Equal("key04", string(iter.Key()))
+	suite.Equal("value04", string(iter.Value()))
+	suite.True(iter.Next())
+	suite.Equal("key05", string(iter.Key()))
+	suite.False(iter.Next())
+	iter.Release()
+
+	iter = suite.storage.NewIterator(&IteratorOptions{
+		Start:   []byte("key"),
+		Reverse: true,
+	})
+	suite.True(iter.Seek([]byte("key031")))
+	suite.Equal("key03", string(iter.Key()))
+	suite.True(iter.Next())
+	suite.Equal("key02", string(iter.Key()))
+	suite.True(iter.Seek([]byte("key09")))
+	suite.Equal("key05", string(iter.Key()))
+	suite.False(iter.Seek([]byte("iost09")))
+	iter.Release()
+}
+
 func (suite *StorageTestSuite) TestBatch() {
 	var value []byte
 	var err error
@@ -136,20 +212,32 @@ func (suite *StorageTestSuite) TestBatch() {
 	suite.Nil(err)
 	suite.Equal([]byte{}, value)
 
-	err = suite.storage.BeginBatch()
-	suite.Nil(err)
-	err = suite.storage.BeginBatch()
-	suite.NotNil(err)
+	batch := suite.storage.NewBatch()
+	other := suite.storage.NewBatch()
 
-	err = suite.storage.Delete([]byte("key04"))
+	err = batch.Delete([]byte("key04"))
+	suite.Nil(err)
+	err = other.Put([]byte("key05"), []byte("value055"))
+	suite.Nil(err)
+	err = batch.Put([]byte("key06"), []byte("value06"))
 	suite.Nil(err)
-	err = suite.storage.Put([]byte("key06"), []byte("value06"))
+	err = other.Delete([]byte("key06"))
+	suite.Nil(err)
+
+	value, err = suite.storage.Get([]byte("key06"))
 	suite.Nil(err)
+	suite.Equal([]byte{}, value)
 
-	err = suite.storage.CommitBatch()
+	err = batch.Commit()
 	suite.Nil(err)
-	err = suite.storage.CommitBatch()
-	suite.NotNil(err)
+	err = batch.Commit()
+	suite.Equal(ErrBatchDone, err)
+	err = batch.Put([]byte("key07"), []byte("value07"))
+	suite.Equal(ErrBatchDone, err)
+
+	other.Discard()
+	err = other.Commit()
+	suite.Equal(ErrBatchDone, err)
 
 	value, err = suite.storage.Get([]byte("key04"))
 	suite.Nil(err)
@@ -162,7 +250,56 @@ func (suite *StorageTestSuite) TestBatch() {
 	suite.Equal([]byte("value06"), value)
 }
 
+func (suite *StorageTestSuite) TestLargeBatch() {
+	// more writes than a transaction of badger can hold, the batch is either
+	// committed entirely or not at all
+	const count = 1 << 18
+	batch := suite.storage.NewBatch()
+	suite.Require().Nil(batch.Delete([]byte("key01")))
+	for i := 0; i < count; i++ {
+		batch.Put([]byte(fmt.Sprintf("large%06d", i)), []byte(fmt.Sprintf("value%06d", i)))
+	}
+	err := batch.Commit()
+	if suite.t == BadgerStorage {
+		suite.NotNil(err)
+	}
+
+	keys, kerr := suite.storage.Keys([]byte("large"))
+	suite.Nil(kerr)
+	value, verr := suite.storage.Get([]byte("key01"))
+	suite.Nil(verr)
+	if err != nil {
+		suite.Equal(0, len(keys))
+		suite.Equal([]byte("value01"), value)
+		return
+	}
+	suite.Equal(count, len(keys))
+	suite.Equal([]byte{}, value)
+	for _, i := range []int{0, count / 2, count - 1} {
+		value, err := suite.storage.Get([]byte(fmt.Sprintf("large%06d", i)))
+		suite.Nil(err)
+		suite.Equal([]byte(fmt.Sprintf("value%06d", i)), value)
+	}
+}
+
+func (suite *StorageTestSuite) TestCloseTwice() {
+	suite.Nil(suite.storage.Close())
+	suite.NotPanics(func() { suite.storage.Close() })
+
+	storage, err := NewStorage(DBPATH, suite.t)
+	suite.Require().Nil(err)
+	suite.storage = storage
+	value, err := suite.storage.Get([]byte("key01"))
+	suite.Nil(err)
+	if suite.t != MemoryStorage {
+		suite.Equal([]byte("value01"), value)
+	}
+}
+
 func (suite *StorageTestSuite) TestRecover() {
+	if suite.t == MemoryStorage {
+		suite.T().Skip("memory storage is not persisted after close")
+	}
 	var value []byte
 	var err error
 
@@ -176,12 +313,11 @@ func (suite *StorageTestSuite) TestRecover() {
 	suite.Nil(err)
 	suite.Equal([]byte{}, value)
 
-	err = suite.storage.BeginBatch()
-	suite.Nil(err)
+	batch := suite.storage.NewBatch()
 
-	err = suite.storage.Delete([]byte("key04"))
+	err = batch.Delete([]byte("key04"))
 	suite.Nil(err)
-	err = suite.storage.Put([]byte("key06"), []byte("value06"))
+	err = batch.Put([]byte("key06"), []byte("value06"))
 	suite.Nil(err)
 
 	err = suite.storage.Close()
@@ -190,9 +326,6 @@ func (suite *StorageTestSuite) TestRecover() {
 	suite.Require().Nil(err)
 	suite.storage = storage
 
-	err = suite.storage.CommitBatch()
-	suite.NotNil(err)
-
 	value, err = suite.storage.Get([]byte("key04"))
 	suite.Nil(err)
 	suite.Equal([]byte("value04"), value)
@@ -207,17 +340,33 @@ func (suite *StorageTestSuite) TestRecover() {
 func (suite *StorageTestSuite) TearDownTest() {
 	err := suite.storage.Close()
 	suite.Nil(err)
-	cmd := exec.Command("rm", "-r", DBPATH)
-	err = cmd.Run()
+	err = os.RemoveAll(DBPATH)
 	suite.Require().Nil(err)
 }
 
 func TestStorageTestSuite(t *testing.T) {
-	suite.Run(t, &StorageTestSuite{t: LevelDBStorage})
+	for _, st := range []StorageType{LevelDBStorage, MemoryStorage, BadgerStorage} {
+		suite.Run(t, &StorageTestSuite{t: st})
+	}
+}
+
+func TestParseStorageType(t *testing.T) {
+	for name, st := range map[string]StorageType{
+		"":        LevelDBStorage,
+		"leveldb": LevelDBStorage,
+		"memory":  MemoryStorage,
+		"badger":  BadgerStorage,
+	} {
+		got, err := ParseStorageType(name)
+		assert.Nil(t, err)
+		assert.Equal(t, st, got)
+	}
+	_, err := ParseStorageType("rocksdb")
+	assert.NotNil(t, err)
 }
 
 func BenchmarkStorage(b *testing.B) {
-	for _, t := range []StorageType{LevelDBStorage} {
+	for _, t := range []StorageType{LevelDBStorage, MemoryStorage, BadgerStorage} {
 		storage, err := NewStorage(DBPATH, t)
 		if err != nil {
 			b.Fatalf("Failed to new storage: %v", err)
@@ -269,7 +418,7 @@ func BenchmarkStorage(b *testing.B) {
 }
 
 func BenchmarkKeys(b *testing.B) {
-	for _, t := range []StorageType{LevelDBStorage} {
+	for _, t := range []StorageType{LevelDBStorage, MemoryStorage, BadgerStorage} {
 		storage, err := NewStorage(DBPATH, t)
 		if err != nil {
 			b.Fatalf("Failed to new storage: %v", err)
@@ -0,0 +1,39 @@
+package types
+
+import "errors"
+
+// ErrBatchDone is returned when the batch is used after Commit or Discard
+var ErrBatchDone = errors.New("batch is already committed or discarded")
+
+// IteratorOptions is the options of the storage iterator
+type IteratorOptions struct {
+	Start   []byte // the first key of the range, included, nil means the first key of storage
+	End     []byte // the last key of the range, excluded, nil means the last key of storage
+	Reverse bool   // iterate the range from the end to the start
+	Limit   int    // the max number of items, 0 means no limit
+}
+
+// Iterator is the storage iterator interface, shared by all storage backends
+type Iterator interface {
+	// Next moves the iterator to the next item in the iteration order,
+	// the first call moves it to the first item of the range.
+	Next() bool
+	// Seek moves the iterator to the first item not before key in the
+	// iteration order, which is the last item not after key when reverse.
+	Seek(key []byte) bool
+	Key() []byte
+	Value() []byte
+	Error() error
+	Release()
+}
+
+// Batch is the independent write batch, its writes are invisible until Commit
+// and applied atomically. A batch can't be used after Commit or Discard.
+// If a write fails, such as the badger batch exceeding the size of a transaction,
+// Commit returns the error and none of the writes is applied.
+type Batch interface {
+	Put(key []byte, value []byte) error
+	Delete(key []byte) error
+	Commit() error
+	Discard()
+}
@@ -67,6 +67,18 @@ func (mr *MockMVCCDBMockRecorder) Commit(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockMVCCDB)(nil).Commit), arg0)
 }
 
+// Compact mocks base method
+func (m *MockMVCCDB) Compact() error {
+	ret := m.ctrl.Call(m, "Compact")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Compact indicates an expected call of Compact
+func (mr *MockMVCCDBMockRecorder) Compact() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compact", reflect.TypeOf((*MockMVCCDB)(nil).Compact))
+}
+
 // CurrentTag mocks base method
 func (m *MockMVCCDB) CurrentTag() string {
 	ret := m.ctrl.Call(m, "CurrentTag")
@@ -141,6 +153,19 @@ func (mr *MockMVCCDBMockRecorder) Has(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Has", reflect.TypeOf((*MockMVCCDB)(nil).Has), arg0, arg1)
 }
 
+// History mocks base method
+func (m *MockMVCCDB) History(arg0 string) (db.MVCCDB, error) {
+	ret := m.ctrl.Call(m, "History", arg0)
+	ret0, _ := ret[0].(db.MVCCDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History
+func (mr *MockMVCCDBMockRecorder) History(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockMVCCDB)(nil).History), arg0)
+}
+
 // Keys mocks base method
 func (m *MockMVCCDB) Keys(arg0, arg1 string) ([]string, error) {
 	ret := m.ctrl.Call(m, "Keys", arg0, arg1)
@@ -154,6 +179,18 @@ func (mr *MockMVCCDBMockRecorder) Keys(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Keys", reflect.TypeOf((*MockMVCCDB)(nil).Keys), arg0, arg1)
 }
 
+// PruneHistory mocks base method
+func (m *MockMVCCDB) PruneHistory(arg0 string) error {
+	ret := m.ctrl.Call(m, "PruneHistory", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PruneHistory indicates an expected call of PruneHistory
+func (mr *MockMVCCDBMockRecorder) PruneHistory(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneHistory", reflect.TypeOf((*MockMVCCDB)(nil).PruneHistory), arg0)
+}
+
 // Put mocks base method
 func (m *MockMVCCDB) Put(arg0, arg1, arg2 string) error {
 	ret := m.ctrl.Call(m, "Put", arg0, arg1, arg2)
@@ -178,3 +215,30 @@ func (m *MockMVCCDB) Size() (int64, error) {
 func (mr *MockMVCCDBMockRecorder) Size() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockMVCCDB)(nil).Size))
 }
+
+// StateProof mocks base method
+func (m *MockMVCCDB) StateProof(arg0, arg1 string) (*db.StateProof, error) {
+	ret := m.ctrl.Call(m, "StateProof", arg0, arg1)
+	ret0, _ := ret[0].(*db.StateProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateProof indicates an expected call of StateProof
+func (mr *MockMVCCDBMockRecorder) StateProof(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateProof", reflect.TypeOf((*MockMVCCDB)(nil).StateProof), arg0, arg1)
+}
+
+// StateRoot mocks base method
+func (m *MockMVCCDB) StateRoot() (string, []byte, error) {
+	ret := m.ctrl.Call(m, "StateRoot")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// StateRoot indicates an expected call of StateRoot
+func (mr *MockMVCCDBMockRecorder) StateRoot() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateRoot", reflect.TypeOf((*MockMVCCDB)(nil).StateRoot))
+}
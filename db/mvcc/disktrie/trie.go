@@ -0,0 +1,382 @@
+package disktrie
+
+import (
+	"encoding/binary"
+	"errors"
+	"sync"
+
+	"github.com/hashicorp/golang-lru"
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// Constant of disk trie
+const (
+	NodeCacheSize = 100000
+)
+
+// error of disk trie
+var (
+	ErrInvalidNode = errors.New("invalid node of disk trie")
+)
+
+// Codec encodes and decodes the values of trie
+type Codec interface {
+	Encode(value interface{}) ([]byte, error)
+	Decode(b []byte) (interface{}, error)
+}
+
+// nodeID identifies the node by its context and the sequence in context,
+// the zero id is the empty node
+type nodeID struct {
+	context uint64
+	seq     uint64
+}
+
+func (id nodeID) bytes() []byte {
+	b := make([]byte, 16)
+	binary.BigEndian.PutUint64(b, id.context)
+	binary.BigEndian.PutUint64(b[8:], id.seq)
+	return b
+}
+
+// Node is node of disk trie, nodes are immutable after their context is frozen
+type Node struct {
+	id       nodeID
+	value    interface{}
+	keys     []byte
+	children []nodeID
+}
+
+func (n *Node) child(b byte) (int, nodeID) {
+	for k := range n.keys {
+		if n.keys[k] == b {
+			return k, n.children[k]
+		}
+	}
+	return -1, nodeID{}
+}
+
+func (n *Node) encode(codec Codec) ([]byte, error) {
+	buf := make([]byte, 0, 16+len(n.keys)*17)
+	tmp := make([]byte, binary.MaxVarintLen64)
+
+	buf = append(buf, tmp[:binary.PutUvarint(tmp, uint64(len(n.keys)))]...)
+	buf = append(buf, n.keys...)
+	for _, c := range n.children {
+		buf = append(buf, c.bytes()...)
+	}
+	if n.value == nil {
+		return append(buf, 0), nil
+	}
+	v, err := codec.Encode(n.value)
+	if err != nil {
+		return nil, err
+	}
+	buf = append(buf, 1)
+	return append(buf, v...), nil
+}
+
+func decodeNode(id nodeID, b []byte, codec Codec) (*Node, error) {
+	l, size := binary.Uvarint(b)
+	if size <= 0 || uint64(len(b)-size) < l*17+1 {
+		return nil, ErrInvalidNode
+	}
+	b = b[size:]
+	n := &Node{
+		id:       id,
+		keys:     append([]byte{}, b[:l]...),
+		children: make([]nodeID, l),
+	}
+	b = b[l:]
+	for i := range n.children {
+		n.children[i] = nodeID{
+			context: binary.BigEndian.Uint64(b),
+			seq:     binary.BigEndian.Uint64(b[8:]),
+		}
+		b = b[16:]
+	}
+	if b[0] == 0 {
+		return n, nil
+	}
+	v, err := codec.Decode(b[1:])
+	if err != nil {
+		return nil, err
+	}
+	n.value = v
+	return n, nil
+}
+
+// Context is the write context of trie, the nodes of context are kept in memory
+// and only visible to its trie until it is frozen by fork, and then persisted in storage
+type Context struct {
+	id     uint64
+	seq    uint64
+	dirty  map[uint64]*Node
+	frozen bool
+}
+
+// Database is the storage of nodes, which is shared by all forks of the trie
+type Database struct {
+	storage  *kv.Storage
+	codec    Codec
+	cache    *lru.Cache
+	contexts map[uint64]*Context
+	lastID   uint64
+	rwmu     sync.RWMutex
+}
+
+// NewDatabase returns the database of trie nodes on the storage,
+// the storage should be empty since nodes are not valid after restart
+func NewDatabase(storage *kv.Storage, codec Codec) (*Database, error) {
+	cache, err := lru.New(NodeCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	d := &Database{
+		storage:  storage,
+		codec:    codec,
+		cache:    cache,
+		contexts: make(map[uint64]*Context),
+	}
+	return d, nil
+}
+
+// NewTrie returns new empty trie in the database
+func (d *Database) NewTrie() *Trie {
+	return &Trie{
+		db: d,
+	}
+}
+
+func (d *Database) newContext() *Context {
+	d.lastID++
+	c := &Context{
+		id:    d.lastID,
+		dirty: make(map[uint64]*Node),
+	}
+	return c
+}
+
+func (d *Database) load(id nodeID) *Node {
+	if id.context == 0 {
+		return nil
+	}
+	c, ok := d.contexts[id.context]
+	if !ok {
+		// the context is freed or not frozen
+		return nil
+	}
+	if n, ok := c.dirty[id.seq]; ok {
+		return n
+	}
+	if n, ok := d.cache.Get(id); ok {
+		return n.(*Node)
+	}
+	b, err := d.storage.Get(id.bytes())
+	if err != nil {
+		ilog.Errorf("Failed to load node of disk trie: %v", err)
+		return nil
+	}
+	if len(b) == 0 {
+		return nil
+	}
+	n, err := decodeNode(id, b, d.codec)
+	if err != nil {
+		ilog.Errorf("Failed to decode node of disk trie: %v", err)
+		return nil
+	}
+	d.cache.Add(id, n)
+	return n
+}
+
+// freeze persists the nodes of context, the nodes are kept in memory if it fails
+func (d *Database) freeze(c *Context) {
+	if c.frozen {
+		return
+	}
+	c.frozen = true
+	d.contexts[c.id] = c
+	if len(c.dirty) == 0 {
+		return
+	}
+	batch := d.storage.NewBatch()
+	for _, n := range c.dirty {
+		b, err := n.encode(d.codec)
+		if err == nil {
+			err = batch.Put(n.id.bytes(), b)
+		}
+		if err != nil {
+			batch.Discard()
+			ilog.Errorf("Failed to encode node of disk trie: %v", err)
+			return
+		}
+	}
+	if err := batch.Commit(); err != nil {
+		ilog.Errorf("Failed to persist nodes of disk trie: %v", err)
+		return
+	}
+	for _, n := range c.dirty {
+		d.cache.Add(n.id, n)
+	}
+	c.dirty = make(map[uint64]*Node)
+}
+
+func (d *Database) free(c *Context) {
+	delete(d.contexts, c.id)
+	c.dirty = nil
+	if c.seq == 0 {
+		return
+	}
+	start := nodeID{context: c.id}
+	end := nodeID{context: c.id + 1}
+	iter := d.storage.NewIterator(&kv.IteratorOptions{
+		Start: start.bytes(),
+		End:   end.bytes(),
+	})
+	batch := d.storage.NewBatch()
+	for iter.Next() {
+		if err := batch.Delete(append([]byte{}, iter.Key()...)); err != nil {
+			ilog.Errorf("Failed to free nodes of disk trie: %v", err)
+		}
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		ilog.Errorf("Failed to free nodes of disk trie: %v", err)
+	}
+	if err := batch.Commit(); err != nil {
+		ilog.Errorf("Failed to free nodes of disk trie: %v", err)
+	}
+}
+
+// Trie is the mvcc trie whose nodes are persisted in storage by copy-on-write
+type Trie struct {
+	db      *Database
+	context *Context
+	root    nodeID
+}
+
+func (t *Trie) load(id nodeID) *Node {
+	if t.context != nil && !t.context.frozen && id.context == t.context.id {
+		return t.context.dirty[id.seq]
+	}
+	return t.db.load(id)
+}
+
+// own returns the node of id in the context of trie, the node is copied if it
+// belongs to other contexts, or created if it doesn't exist
+func (t *Trie) own(id nodeID) *Node {
+	c := t.context
+	old := t.load(id)
+	if old != nil && old.id.context == c.id {
+		return old
+	}
+	c.seq++
+	n := &Node{
+		id: nodeID{context: c.id, seq: c.seq},
+	}
+	if old != nil {
+		n.value = old.value
+		n.keys = append([]byte{}, old.keys...)
+		n.children = append([]nodeID{}, old.children...)
+	}
+	c.dirty[c.seq] = n
+	return n
+}
+
+// Get returns the value of specify key
+func (t *Trie) Get(key []byte) interface{} {
+	t.db.rwmu.RLock()
+	defer t.db.rwmu.RUnlock()
+
+	n := t.load(t.root)
+	for i := 0; n != nil && i < len(key); i++ {
+		_, id := n.child(key[i])
+		n = t.load(id)
+	}
+	if n == nil {
+		return nil
+	}
+	return n.value
+}
+
+// Put will insert the key-value pair
+func (t *Trie) Put(key []byte, value interface{}) {
+	t.db.rwmu.Lock()
+	defer t.db.rwmu.Unlock()
+
+	if t.context == nil || t.context.frozen {
+		t.context = t.db.newContext()
+	}
+	n := t.own(t.root)
+	t.root = n.id
+	for i := range key {
+		k, id := n.child(key[i])
+		child := t.own(id)
+		if k < 0 {
+			n.keys = append(n.keys, key[i])
+			n.children = append(n.children, child.id)
+		} else {
+			n.children[k] = child.id
+		}
+		n = child
+	}
+	n.value = value
+}
+
+// All returns the list of node prefixed with prefix
+func (t *Trie) All(prefix []byte) []interface{} {
+	t.db.rwmu.RLock()
+	defer t.db.rwmu.RUnlock()
+
+	valuelist := []interface{}{}
+	n := t.load(t.root)
+	for i := 0; n != nil && i < len(prefix); i++ {
+		_, id := n.child(prefix[i])
+		n = t.load(id)
+	}
+	if n == nil {
+		return valuelist
+	}
+	stack := []*Node{n}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if n.value != nil {
+			valuelist = append(valuelist, n.value)
+		}
+		for i := len(n.children) - 1; i >= 0; i-- {
+			if c := t.load(n.children[i]); c != nil {
+				stack = append(stack, c)
+			}
+		}
+	}
+	return valuelist
+}
+
+// Fork will fork the trie, the nodes of trie are persisted and shared with the fork
+// thread safe between all forks of the trie
+func (t *Trie) Fork() interface{} {
+	t.db.rwmu.Lock()
+	defer t.db.rwmu.Unlock()
+
+	if t.context != nil {
+		t.db.freeze(t.context)
+	}
+	trie := &Trie{
+		db:   t.db,
+		root: t.root,
+	}
+	return trie
+}
+
+// Free will free the nodes of trie in memory and storage
+func (t *Trie) Free() {
+	t.db.rwmu.Lock()
+	defer t.db.rwmu.Unlock()
+
+	if t.context != nil {
+		t.db.free(t.context)
+	}
+	t.context = nil
+}
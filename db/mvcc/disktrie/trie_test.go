@@ -0,0 +1,94 @@
+package disktrie
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stringCodec struct{}
+
+func (stringCodec) Encode(value interface{}) ([]byte, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value %v", value)
+	}
+	return []byte(s), nil
+}
+
+func (stringCodec) Decode(b []byte) (interface{}, error) {
+	return string(b), nil
+}
+
+func sortedAll(t *Trie, prefix string) []string {
+	values := make([]string, 0)
+	for _, v := range t.All([]byte(prefix)) {
+		values = append(values, v.(string))
+	}
+	sort.Strings(values)
+	return values
+}
+
+func TestTrie(t *testing.T) {
+	defer os.RemoveAll("disktrie")
+	storage, err := kv.NewStorage("disktrie", kv.LevelDBStorage)
+	require.Nil(t, err)
+	defer storage.Close()
+	db, err := NewDatabase(storage, stringCodec{})
+	require.Nil(t, err)
+
+	trie := db.NewTrie()
+	assert.Nil(t, trie.Get([]byte("key01")))
+	assert.Empty(t, trie.All([]byte("")))
+	trie.Put([]byte("key01"), "value01")
+	trie.Put([]byte("key02"), "value02")
+	trie.Put([]byte("key"), "value")
+	trie.Put([]byte("iost"), "value03")
+	assert.Equal(t, "value01", trie.Get([]byte("key01")))
+	assert.Equal(t, "value", trie.Get([]byte("key")))
+	assert.Nil(t, trie.Get([]byte("ke")))
+	assert.Equal(t, []string{"value", "value01", "value02"}, sortedAll(trie, "key"))
+
+	// the nodes are persisted after fork
+	fork1 := trie.Fork().(*Trie)
+	assert.Empty(t, trie.context.dirty)
+	db.cache.Purge()
+	assert.Equal(t, "value01", fork1.Get([]byte("key01")))
+	assert.Equal(t, "value03", fork1.Get([]byte("iost")))
+
+	// the fork is copy on write
+	fork1.Put([]byte("key01"), "value11")
+	fork1.Put([]byte("key03"), "value13")
+	fork2 := trie.Fork().(*Trie)
+	fork2.Put([]byte("key01"), "value21")
+	assert.Equal(t, "value01", trie.Get([]byte("key01")))
+	assert.Equal(t, "value11", fork1.Get([]byte("key01")))
+	assert.Equal(t, "value21", fork2.Get([]byte("key01")))
+	assert.Nil(t, fork2.Get([]byte("key03")))
+	assert.Equal(t, []string{"value", "value01", "value02"}, sortedAll(trie, "key"))
+	assert.Equal(t, []string{"value", "value02", "value11", "value13"}, sortedAll(fork1, "key"))
+
+	// writing the frozen trie doesn't change its forks
+	trie.Put([]byte("key02"), "value02x")
+	assert.Equal(t, "value02x", trie.Get([]byte("key02")))
+	assert.Equal(t, "value02", fork1.Get([]byte("key02")))
+
+	fork3 := fork1.Fork().(*Trie)
+	assert.Equal(t, "value13", fork3.Get([]byte("key03")))
+
+	// the nodes of freed trie are removed
+	fork1.Free()
+	assert.Nil(t, fork3.Get([]byte("key03")))
+	iter := storage.NewIterator(&kv.IteratorOptions{
+		Start: nodeID{context: fork1.root.context}.bytes(),
+		End:   nodeID{context: fork1.root.context + 1}.bytes(),
+	})
+	assert.False(t, iter.Next())
+	iter.Release()
+	assert.Equal(t, "value21", fork2.Get([]byte("key01")))
+}
@@ -1,11 +1,15 @@
 package db
 
 import (
+	"encoding/binary"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sync"
 
 	"github.com/iost-official/go-iost/db/kv"
 	"github.com/iost-official/go-iost/db/mvcc"
+	"github.com/iost-official/go-iost/db/mvcc/disktrie"
 )
 
 //go:generate mockgen -destination mocks/mock_mvccdb.go -package db_mock github.com/iost-official/go-iost/db MVCCDB
@@ -32,13 +36,41 @@ type MVCCDB interface {
 	CurrentTag() string
 	Fork() MVCCDB
 	Flush(t string) error
+	History(t string) (MVCCDB, error)
+	PruneHistory(t string) error
+	StateRoot() (string, []byte, error)
+	StateProof(table string, key string) (*StateProof, error)
+	Compact() error
 	Size() (int64, error)
 	Close() error
 }
 
 // NewMVCCDB return new mvccdb
 func NewMVCCDB(path string) (MVCCDB, error) {
-	return NewCacheMVCCDB(path, mvcc.MapCache)
+	return NewMVCCDBWithStorage(path, kv.LevelDBStorage)
+}
+
+// NewMVCCDBWithStorage return new mvccdb on the specify storage type
+func NewMVCCDBWithStorage(path string, storageType kv.StorageType) (MVCCDB, error) {
+	return NewMVCCDBWithOptions(path, &Options{StorageType: storageType})
+}
+
+// NewArchiveMVCCDB return new mvccdb in archive mode, which keeps the state of every flushed tag
+func NewArchiveMVCCDB(path string, storageType kv.StorageType) (MVCCDB, error) {
+	return NewMVCCDBWithOptions(path, &Options{StorageType: storageType, Archive: true})
+}
+
+// NewMVCCDBWithOptions return new mvccdb with the options
+func NewMVCCDBWithOptions(path string, opts *Options) (MVCCDB, error) {
+	return NewCacheMVCCDB(path, mvcc.MapCache, opts)
+}
+
+// Options is the options of mvccdb
+type Options struct {
+	StorageType kv.StorageType
+	Archive     bool // keep the state of every flushed tag
+	StateTrie   bool // keep the merkle patricia trie of the flushed state for state root and proofs
+	DiskCache   bool // keep the changes of unflushed commits on disk instead of memory
 }
 
 // Item is the value of cache
@@ -49,6 +81,47 @@ type Item struct {
 	deleted bool
 }
 
+// itemCodec encodes the item in the disk cache
+type itemCodec struct{}
+
+// Encode marshals the item
+func (itemCodec) Encode(value interface{}) ([]byte, error) {
+	item, ok := value.(*Item)
+	if !ok {
+		return nil, fmt.Errorf("can't assert Item type")
+	}
+	b := make([]byte, 0, len(item.table)+len(item.key)+len(item.value)+8)
+	tmp := make([]byte, binary.MaxVarintLen64)
+	b = append(b, tmp[:binary.PutUvarint(tmp, uint64(len(item.table)))]...)
+	b = append(b, item.table...)
+	b = append(b, tmp[:binary.PutUvarint(tmp, uint64(len(item.key)))]...)
+	b = append(b, item.key...)
+	if item.deleted {
+		return append(b, 1), nil
+	}
+	b = append(b, 0)
+	return append(b, item.value...), nil
+}
+
+// Decode unmarshals the item
+func (itemCodec) Decode(b []byte) (interface{}, error) {
+	item := &Item{}
+	for _, s := range []*string{&item.table, &item.key} {
+		l, size := binary.Uvarint(b)
+		if size <= 0 || uint64(len(b)-size) < l {
+			return nil, fmt.Errorf("invalid item of disk cache")
+		}
+		*s = string(b[size : size+int(l)])
+		b = b[size+int(l):]
+	}
+	if len(b) == 0 {
+		return nil, fmt.Errorf("invalid item of disk cache")
+	}
+	item.deleted = b[0] == 1
+	item.value = string(b[1:])
+	return item, nil
+}
+
 // Commit is the cache of specify tag
 type Commit struct {
 	mvcc.Cache
@@ -137,18 +210,31 @@ func (m *CommitManager) FreeBefore(c *Commit) {
 	}
 }
 
+// storageReader is the read side of storage, which is the archive for history of mvccdb
+type storageReader interface {
+	Get(key []byte) ([]byte, error)
+	Has(key []byte) (bool, error)
+}
+
 // CacheMVCCDB is the mvcc db with cache
 type CacheMVCCDB struct {
-	head    *Commit
-	stage   mvcc.Cache
-	storage *kv.Storage
-	cm      *CommitManager
-	rwmu    sync.RWMutex
+	head      *Commit
+	stage     mvcc.Cache
+	storage   *kv.Storage
+	reader    storageReader
+	archive   *archive
+	trie      *stateTrie
+	cacheDB   *disktrie.Database
+	cacheKV   *kv.Storage
+	history   bool
+	cacheType mvcc.CacheType
+	cm        *CommitManager
+	rwmu      sync.RWMutex
 }
 
-// NewCacheMVCCDB returns new CacheMVCCDB
-func NewCacheMVCCDB(path string, cacheType mvcc.CacheType) (*CacheMVCCDB, error) {
-	storage, err := kv.NewStorage(path, kv.LevelDBStorage)
+// NewCacheMVCCDB returns new CacheMVCCDB with the options
+func NewCacheMVCCDB(path string, cacheType mvcc.CacheType, opts *Options) (*CacheMVCCDB, error) {
+	storage, err := kv.NewStorage(path, opts.StorageType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to new storage: %v", err)
 	}
@@ -156,21 +242,65 @@ func NewCacheMVCCDB(path string, cacheType mvcc.CacheType) (*CacheMVCCDB, error)
 	cm := NewCommitManager()
 
 	mvccdb := &CacheMVCCDB{
-		head:    nil,
-		stage:   stage,
-		storage: storage,
-		cm:      cm,
+		head:      nil,
+		stage:     stage,
+		storage:   storage,
+		reader:    storage,
+		cacheType: cacheType,
+		cm:        cm,
 	}
 
 	tag, err := storage.Get([]byte(string(SEPARATOR) + "tag"))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get init tag from storage: %v", err)
 	}
+	if opts.Archive {
+		mvccdb.archive, err = newArchive(storage, string(tag))
+		if err != nil {
+			storage.Close()
+			return nil, err
+		}
+	}
+	if opts.StateTrie {
+		mvccdb.trie, err = newStateTrie(storage, string(tag))
+		if err != nil {
+			storage.Close()
+			return nil, err
+		}
+	}
+	if opts.DiskCache {
+		if err := mvccdb.openDiskCache(path, opts.StorageType); err != nil {
+			storage.Close()
+			return nil, err
+		}
+		mvccdb.stage = mvccdb.cacheDB.NewTrie()
+	}
 	mvccdb.Commit(string(tag))
 
 	return mvccdb, nil
 }
 
+// openDiskCache opens the storage of disk cache beside the path, the nodes of
+// last run are removed since the commits are lost after restart
+func (m *CacheMVCCDB) openDiskCache(path string, storageType kv.StorageType) error {
+	cachePath := filepath.Clean(path) + "Cache"
+	if err := os.RemoveAll(cachePath); err != nil {
+		return fmt.Errorf("failed to remove disk cache: %v", err)
+	}
+	storage, err := kv.NewStorage(cachePath, storageType)
+	if err != nil {
+		return fmt.Errorf("failed to new storage of disk cache: %v", err)
+	}
+	cacheDB, err := disktrie.NewDatabase(storage, itemCodec{})
+	if err != nil {
+		storage.Close()
+		return fmt.Errorf("failed to new disk cache: %v", err)
+	}
+	m.cacheKV = storage
+	m.cacheDB = cacheDB
+	return nil
+}
+
 func (m *CacheMVCCDB) isValidTable(table string) bool {
 	if table == "" {
 		return false
@@ -192,7 +322,7 @@ func (m *CacheMVCCDB) Get(table string, key string) (string, error) {
 	k := []byte(table + string(SEPARATOR) + key)
 	v := m.stage.Get(k)
 	if v == nil {
-		v, err := m.storage.Get(k)
+		v, err := m.reader.Get(k)
 		if err != nil {
 			return "", fmt.Errorf("failed to get from storage: %v", err)
 		}
@@ -249,7 +379,7 @@ func (m *CacheMVCCDB) Has(table string, key string) (bool, error) {
 	k := []byte(table + string(SEPARATOR) + key)
 	v := m.stage.Get(k)
 	if v == nil {
-		return m.storage.Has(k)
+		return m.reader.Has(k)
 	}
 	i, ok := v.(*Item)
 	if !ok {
@@ -323,57 +453,174 @@ func (m *CacheMVCCDB) Fork() MVCCDB {
 	defer m.rwmu.RUnlock()
 
 	mvccdb := &CacheMVCCDB{
-		head:    m.head,
-		stage:   m.head.ForkCache(),
-		storage: m.storage,
-		cm:      m.cm,
+		head:      m.head,
+		stage:     m.head.ForkCache(),
+		storage:   m.storage,
+		reader:    m.reader,
+		archive:   m.archive,
+		trie:      m.trie,
+		cacheDB:   m.cacheDB,
+		cacheKV:   m.cacheKV,
+		history:   m.history,
+		cacheType: m.cacheType,
+		cm:        m.cm,
 	}
 	return mvccdb
 }
 
 // Flush will persist the current state of mvccdb
 func (m *CacheMVCCDB) Flush(t string) error {
+	if m.history {
+		return ErrHistoryReadOnly
+	}
 	commit := m.cm.Get(t)
 	if commit == nil {
 		return fmt.Errorf("not found tag: %v", t)
 	}
-	if err := m.storage.BeginBatch(); err != nil {
-		return err
-	}
-	err := m.storage.Put([]byte(string(SEPARATOR)+"tag"), []byte(t))
+	batch := m.storage.NewBatch()
+	err := batch.Put([]byte(string(SEPARATOR)+"tag"), []byte(t))
 	if err != nil {
+		batch.Discard()
 		return err
 	}
-	for _, v := range commit.All([]byte("")) {
+	values := commit.All([]byte(""))
+	items := make([]*Item, 0, len(values))
+	for _, v := range values {
 		item, ok := v.(*Item)
 		if !ok {
+			batch.Discard()
 			return fmt.Errorf("can't assert Item type")
 		}
+		items = append(items, item)
+	}
+	for _, item := range items {
 		if item.deleted {
-			err := m.storage.Delete([]byte(item.table + string(SEPARATOR) + item.key))
+			err := batch.Delete([]byte(item.table + string(SEPARATOR) + item.key))
 			if err != nil {
+				batch.Discard()
 				return err
 			}
 		} else {
-			err := m.storage.Put([]byte(item.table+string(SEPARATOR)+item.key), []byte(item.value))
+			err := batch.Put([]byte(item.table+string(SEPARATOR)+item.key), []byte(item.value))
 			if err != nil {
+				batch.Discard()
 				return err
 			}
 		}
 	}
-	if err := m.storage.CommitBatch(); err != nil {
+	if m.archive != nil {
+		if err := m.archive.write(batch, t, items); err != nil {
+			batch.Discard()
+			return err
+		}
+	}
+	if m.trie != nil {
+		m.trie.mu.Lock()
+		defer m.trie.mu.Unlock()
+
+		if err := m.trie.write(batch, t, items); err != nil {
+			batch.Discard()
+			return err
+		}
+	}
+	if err := batch.Commit(); err != nil {
+		if m.trie != nil {
+			m.trie.trie.Reset()
+		}
 		return err
 	}
+	if m.archive != nil {
+		m.archive.commit()
+	}
+	if m.trie != nil {
+		m.trie.commit(t)
+	}
 	m.cm.FreeBefore(commit)
 	return nil
 }
 
+// History returns the read only mvccdb at the specify flushed tag, only in archive mode
+func (m *CacheMVCCDB) History(t string) (MVCCDB, error) {
+	if m.archive == nil {
+		return nil, ErrNotArchive
+	}
+	version, err := m.archive.tagVersion(t)
+	if err != nil {
+		return nil, err
+	}
+	head := NewCommit(mvcc.NewCache(m.cacheType), t)
+	cm := NewCommitManager()
+	cm.Add(head)
+	mvccdb := &CacheMVCCDB{
+		head:    head,
+		stage:   head.ForkCache(),
+		storage: m.storage,
+		reader: &archiveReader{
+			archive: m.archive,
+			version: version,
+		},
+		archive:   m.archive,
+		history:   true,
+		cacheType: m.cacheType,
+		cm:        cm,
+	}
+	return mvccdb, nil
+}
+
+// PruneHistory removes the history before the specify flushed tag, only in archive mode
+func (m *CacheMVCCDB) PruneHistory(t string) error {
+	if m.archive == nil {
+		return ErrNotArchive
+	}
+	if m.history {
+		return ErrHistoryReadOnly
+	}
+	version, err := m.archive.tagVersion(t)
+	if err != nil {
+		return err
+	}
+	return m.archive.prune(version)
+}
+
+// StateRoot returns the last flushed tag and the merkle root of state at the tag
+func (m *CacheMVCCDB) StateRoot() (string, []byte, error) {
+	if m.trie == nil {
+		return "", nil, ErrNoStateTrie
+	}
+	t, root := m.trie.root()
+	return t, root, nil
+}
+
+// StateProof returns the merkle proof of the key in the table at the last flushed tag
+func (m *CacheMVCCDB) StateProof(table string, key string) (*StateProof, error) {
+	if m.trie == nil {
+		return nil, ErrNoStateTrie
+	}
+	if !m.isValidTable(table) {
+		return nil, ErrTableNotValid
+	}
+	return m.trie.prove([]byte(table + string(SEPARATOR) + key))
+}
+
+// Compact compacts the storage of mvccdb to reclaim the space of removed keys
+func (m *CacheMVCCDB) Compact() error {
+	return m.storage.Compact(nil, nil)
+}
+
 // Size returns the size of mvccdb
 func (m *CacheMVCCDB) Size() (int64, error) {
 	return m.storage.Size()
 }
 
-// Close will close the mvccdb
+// Close will close the mvccdb, the storage is left open for history
 func (m *CacheMVCCDB) Close() error {
+	if m.history {
+		return nil
+	}
+	if m.cacheKV != nil {
+		if err := m.cacheKV.Close(); err != nil {
+			return err
+		}
+	}
 	return m.storage.Close()
 }
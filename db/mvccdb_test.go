@@ -1,12 +1,13 @@
 package db
 
 import (
-	"os/exec"
 	"testing"
 
 	"os"
 	"time"
 
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/db/statetrie"
 	"github.com/iost-official/go-iost/ilog"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -19,10 +20,11 @@ const (
 type MVCCDBTestSuite struct {
 	suite.Suite
 	mvccdb MVCCDB
+	t      kv.StorageType
 }
 
 func (suite *MVCCDBTestSuite) SetupTest() {
-	mvccdb, err := NewMVCCDB(DBPATH)
+	mvccdb, err := NewMVCCDBWithStorage(DBPATH, suite.t)
 	require.Nil(suite.T(), err, "Create MVCCDB should not fail")
 	suite.mvccdb = mvccdb
 	suite.mvccdb.Put("table01", "key01", "value01")
@@ -194,6 +196,9 @@ func (suite *MVCCDBTestSuite) TestFlush() {
 }
 
 func (suite *MVCCDBTestSuite) TestRecovery() {
+	if suite.t == kv.MemoryStorage {
+		suite.T().Skip("memory storage is not persisted after close")
+	}
 	var value string
 	var err error
 
@@ -210,7 +215,7 @@ func (suite *MVCCDBTestSuite) TestRecovery() {
 	err = suite.mvccdb.Close()
 	suite.Nil(err, "Close MVCCDB should not fail")
 
-	mvccdb, err := NewMVCCDB(DBPATH)
+	mvccdb, err := NewMVCCDBWithStorage(DBPATH, suite.t)
 	require.Nil(suite.T(), err, "Create MVCCDB should not fail")
 	suite.mvccdb = mvccdb
 
@@ -255,17 +260,298 @@ func (suite *MVCCDBTestSuite) TestFlushAndCheckout() {
 	suite.Equal("", value)
 }
 
+func (suite *MVCCDBTestSuite) TestHistory() {
+	_, err := suite.mvccdb.History("tag0")
+	suite.Equal(ErrNotArchive, err)
+
+	suite.Nil(suite.mvccdb.Close())
+	suite.Nil(os.RemoveAll(DBPATH))
+	mvccdb, err := NewArchiveMVCCDB(DBPATH, suite.t)
+	require.Nil(suite.T(), err, "Create archive MVCCDB should not fail")
+	suite.mvccdb = mvccdb
+
+	suite.mvccdb.Put("table01", "key01", "value01")
+	suite.mvccdb.Put("table01", "key02", "value02")
+	suite.mvccdb.Put("table01", "key", "value")
+	suite.mvccdb.Commit("tag1")
+	suite.mvccdb.Put("table01", "key01", "value011")
+	suite.mvccdb.Del("table01", "key02")
+	suite.mvccdb.Put("table01", "key03", "value03")
+	suite.mvccdb.Commit("tag2")
+	suite.Nil(suite.mvccdb.Flush("tag1"))
+	suite.Nil(suite.mvccdb.Flush("tag2"))
+	suite.mvccdb.Put("table01", "key01", "value0111")
+	suite.mvccdb.Commit("tag3")
+	suite.Nil(suite.mvccdb.Flush("tag3"))
+
+	_, err = suite.mvccdb.History("tag4")
+	suite.Equal(ErrTagNotArchived, err)
+
+	h1, err := suite.mvccdb.History("tag1")
+	suite.Nil(err)
+	h2, err := suite.mvccdb.History("tag2")
+	suite.Nil(err)
+
+	value, err := h1.Get("table01", "key01")
+	suite.Nil(err)
+	suite.Equal("value01", value)
+	value, err = h1.Get("table01", "key02")
+	suite.Nil(err)
+	suite.Equal("value02", value)
+	value, err = h1.Get("table01", "key")
+	suite.Nil(err)
+	suite.Equal("value", value)
+	has, err := h1.Has("table01", "key03")
+	suite.Nil(err)
+	suite.False(has)
+
+	value, err = h2.Get("table01", "key01")
+	suite.Nil(err)
+	suite.Equal("value011", value)
+	has, err = h2.Has("table01", "key02")
+	suite.Nil(err)
+	suite.False(has)
+	value, err = h2.Get("table01", "key03")
+	suite.Nil(err)
+	suite.Equal("value03", value)
+
+	suite.Nil(h2.Put("table01", "key03", "value033"))
+	value, err = h2.Get("table01", "key03")
+	suite.Nil(err)
+	suite.Equal("value033", value)
+	suite.Equal(ErrHistoryReadOnly, h2.Flush("tag2"))
+	suite.Nil(h2.Close())
+
+	value, err = suite.mvccdb.Get("table01", "key01")
+	suite.Nil(err)
+	suite.Equal("value0111", value)
+	value, err = suite.mvccdb.Get("table01", "key03")
+	suite.Nil(err)
+	suite.Equal("value03", value)
+}
+
+func (suite *MVCCDBTestSuite) TestPruneHistory() {
+	suite.Equal(ErrNotArchive, suite.mvccdb.PruneHistory("tag0"))
+
+	suite.Nil(suite.mvccdb.Close())
+	suite.Nil(os.RemoveAll(DBPATH))
+	mvccdb, err := NewArchiveMVCCDB(DBPATH, suite.t)
+	require.Nil(suite.T(), err, "Create archive MVCCDB should not fail")
+	suite.mvccdb = mvccdb
+
+	suite.mvccdb.Put("table01", "key01", "value01")
+	suite.mvccdb.Put("table01", "key02", "value02")
+	suite.mvccdb.Put("table01", "key03", "value03")
+	suite.mvccdb.Commit("tag1")
+	suite.Nil(suite.mvccdb.Flush("tag1"))
+	suite.mvccdb.Put("table01", "key01", "value011")
+	suite.mvccdb.Del("table01", "key02")
+	suite.mvccdb.Commit("tag2")
+	suite.Nil(suite.mvccdb.Flush("tag2"))
+	suite.mvccdb.Put("table01", "key01", "value0111")
+	suite.mvccdb.Commit("tag3")
+	suite.Nil(suite.mvccdb.Flush("tag3"))
+
+	countArchived := func() int {
+		iter := suite.mvccdb.(*CacheMVCCDB).storage.NewIteratorByPrefix(archiveDataPrefix)
+		defer iter.Release()
+		count := 0
+		for iter.Next() {
+			count++
+		}
+		return count
+	}
+	archived := countArchived()
+
+	suite.Nil(suite.mvccdb.PruneHistory("tag2"))
+	suite.Nil(suite.mvccdb.Compact())
+	suite.True(countArchived() < archived, "pruned history should be removed")
+
+	_, err = suite.mvccdb.History("tag1")
+	suite.Equal(ErrTagPruned, err)
+
+	h2, err := suite.mvccdb.History("tag2")
+	suite.Nil(err)
+	value, err := h2.Get("table01", "key01")
+	suite.Nil(err)
+	suite.Equal("value011", value)
+	has, err := h2.Has("table01", "key02")
+	suite.Nil(err)
+	suite.False(has)
+	value, err = h2.Get("table01", "key03")
+	suite.Nil(err)
+	suite.Equal("value03", value)
+
+	h3, err := suite.mvccdb.History("tag3")
+	suite.Nil(err)
+	value, err = h3.Get("table01", "key01")
+	suite.Nil(err)
+	suite.Equal("value0111", value)
+}
+
+func (suite *MVCCDBTestSuite) TestHistoryRecover() {
+	if suite.t == kv.MemoryStorage {
+		suite.T().Skip("memory storage is not persisted after close")
+	}
+	suite.Nil(suite.mvccdb.Flush("tag0"))
+	suite.Nil(suite.mvccdb.Close())
+
+	_, err := NewArchiveMVCCDB(DBPATH, suite.t)
+	suite.NotNil(err, "Archive mode should not start from a flushed statedb")
+
+	suite.Nil(os.RemoveAll(DBPATH))
+	mvccdb, err := NewArchiveMVCCDB(DBPATH, suite.t)
+	require.Nil(suite.T(), err, "Create archive MVCCDB should not fail")
+	mvccdb.Put("table01", "key01", "value01")
+	mvccdb.Commit("tag1")
+	suite.Nil(mvccdb.Flush("tag1"))
+	suite.Nil(mvccdb.Close())
+
+	mvccdb, err = NewArchiveMVCCDB(DBPATH, suite.t)
+	require.Nil(suite.T(), err, "Reopen archive MVCCDB should not fail")
+	suite.mvccdb = mvccdb
+	mvccdb.Put("table01", "key01", "value011")
+	mvccdb.Commit("tag2")
+	suite.Nil(mvccdb.Flush("tag2"))
+
+	h1, err := mvccdb.History("tag1")
+	suite.Nil(err)
+	value, err := h1.Get("table01", "key01")
+	suite.Nil(err)
+	suite.Equal("value01", value)
+	h2, err := mvccdb.History("tag2")
+	suite.Nil(err)
+	value, err = h2.Get("table01", "key01")
+	suite.Nil(err)
+	suite.Equal("value011", value)
+}
+
+func (suite *MVCCDBTestSuite) TestStateRoot() {
+	_, _, err := suite.mvccdb.StateRoot()
+	suite.Equal(ErrNoStateTrie, err)
+	if suite.t == kv.MemoryStorage {
+		suite.T().Skip("memory storage is not persisted after close")
+	}
+	suite.Nil(suite.mvccdb.Flush("tag0"))
+	suite.Nil(suite.mvccdb.Close())
+
+	// the trie is rebuilt from the flushed state
+	mvccdb, err := NewMVCCDBWithOptions(DBPATH, &Options{StorageType: suite.t, StateTrie: true})
+	require.Nil(suite.T(), err, "Create MVCCDB with state trie should not fail")
+	suite.mvccdb = mvccdb
+	tag, root0, err := mvccdb.StateRoot()
+	suite.Nil(err)
+	suite.Equal("tag0", tag)
+	suite.NotEqual(statetrie.EmptyRoot, root0)
+
+	mvccdb.Put("table01", "key01", "value011")
+	mvccdb.Del("table01", "key02")
+	mvccdb.Commit("tag1")
+	suite.Nil(mvccdb.Flush("tag1"))
+	tag, root1, err := mvccdb.StateRoot()
+	suite.Nil(err)
+	suite.Equal("tag1", tag)
+	suite.NotEqual(root0, root1)
+
+	proof, err := mvccdb.StateProof("table01", "key01")
+	suite.Nil(err)
+	suite.Equal("tag1", proof.Tag)
+	suite.Equal(root1, proof.Root)
+	value, err := statetrie.VerifyProof(root1, []byte("table01/key01"), proof.Nodes)
+	suite.Nil(err)
+	suite.Equal([]byte("value011"), value)
+	proof, err = mvccdb.StateProof("table01", "key02")
+	suite.Nil(err)
+	suite.Nil(proof.Value)
+	value, err = statetrie.VerifyProof(root1, []byte("table01/key02"), proof.Nodes)
+	suite.Nil(err)
+	suite.Nil(value)
+	suite.Nil(mvccdb.Close())
+
+	// the trie is kept after reopen
+	mvccdb, err = NewMVCCDBWithOptions(DBPATH, &Options{StorageType: suite.t, StateTrie: true})
+	require.Nil(suite.T(), err, "Reopen MVCCDB with state trie should not fail")
+	suite.mvccdb = mvccdb
+	tag, root, err := mvccdb.StateRoot()
+	suite.Nil(err)
+	suite.Equal("tag1", tag)
+	suite.Equal(root1, root)
+
+	// the root only depends on the state
+	mvccdb.Put("table01", "key02", "value02")
+	mvccdb.Put("table01", "key01", "value01")
+	mvccdb.Commit("tag2")
+	suite.Nil(mvccdb.Flush("tag2"))
+	_, root, err = mvccdb.StateRoot()
+	suite.Nil(err)
+	suite.Equal(root0, root)
+}
+
+func (suite *MVCCDBTestSuite) TestDiskCache() {
+	suite.Nil(suite.mvccdb.Flush("tag0"))
+	suite.Nil(suite.mvccdb.Close())
+	if suite.t == kv.MemoryStorage {
+		suite.Nil(os.RemoveAll(DBPATH))
+	}
+
+	mvccdb, err := NewMVCCDBWithOptions(DBPATH, &Options{StorageType: suite.t, DiskCache: true})
+	require.Nil(suite.T(), err, "Create MVCCDB with disk cache should not fail")
+	suite.mvccdb = mvccdb
+	mvccdb.Put("table01", "key01", "value011")
+	mvccdb.Del("table01", "key02")
+	mvccdb.Commit("tag1")
+
+	forked := mvccdb.Fork()
+	forked.Put("table01", "key01", "value012")
+	forked.Commit("tag2")
+	mvccdb.Put("table01", "key03", "value031")
+	mvccdb.Commit("tag3")
+
+	for _, c := range []struct {
+		tag   string
+		key   string
+		value string
+	}{
+		{"tag1", "key01", "value011"},
+		{"tag1", "key02", ""},
+		{"tag2", "key01", "value012"},
+		{"tag2", "key03", "value03"},
+		{"tag3", "key01", "value011"},
+		{"tag3", "key03", "value031"},
+	} {
+		if suite.t == kv.MemoryStorage && c.value == "value03" {
+			c.value = ""
+		}
+		suite.True(mvccdb.Checkout(c.tag))
+		value, err := mvccdb.Get("table01", c.key)
+		suite.Nil(err)
+		suite.Equal(c.value, value)
+	}
+
+	suite.Nil(mvccdb.Flush("tag2"))
+	suite.True(mvccdb.Checkout("tag2"))
+	value, err := mvccdb.Get("table01", "key01")
+	suite.Nil(err)
+	suite.Equal("value012", value)
+	has, err := mvccdb.Has("table01", "key02")
+	suite.Nil(err)
+	suite.False(has)
+}
+
 func (suite *MVCCDBTestSuite) TearDownTest() {
 	err := suite.mvccdb.Close()
 	suite.Nil(err, "Close MVCCDB should not fail")
 
-	cmd := exec.Command("rm", "-r", DBPATH)
-	err = cmd.Run()
+	err = os.RemoveAll(DBPATH)
 	require.Nil(suite.T(), err, "Remove database should not fail")
+	err = os.RemoveAll(DBPATH + "Cache")
+	require.Nil(suite.T(), err, "Remove disk cache should not fail")
 }
 
 func TestMVCCDBTestSuite(t *testing.T) {
-	suite.Run(t, new(MVCCDBTestSuite))
+	for _, st := range []kv.StorageType{kv.LevelDBStorage, kv.MemoryStorage, kv.BadgerStorage} {
+		suite.Run(t, &MVCCDBTestSuite{t: st})
+	}
 }
 
 func TestPutTimeout(t *testing.T) {
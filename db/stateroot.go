@@ -0,0 +1,189 @@
+package db
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/db/statetrie"
+)
+
+// The layout of state trie in storage, the trie is keyed by <table>/<key> of the state
+//
+//	/trie/<nibbles> -> node of trie at the nibble path
+//	/trietag        -> the flushed tag which the trie is committed at
+var (
+	stateTriePrefix = []byte(string(SEPARATOR) + "trie" + string(SEPARATOR))
+	stateTrieTagKey = []byte(string(SEPARATOR) + "trietag")
+)
+
+// the max count of keys in a batch of rebuilding state trie
+const stateTrieRebuildBatch = 10000
+
+// error of state trie
+var (
+	ErrNoStateTrie = fmt.Errorf("state trie of mvccdb is not enabled")
+)
+
+// StateProof is the merkle proof of a key against the state root of the flushed tag
+type StateProof struct {
+	Tag   string
+	Root  []byte
+	Value []byte // nil if the key doesn't exist
+	Nodes [][]byte
+}
+
+// stateTrie keeps the merkle patricia trie of the flushed state
+type stateTrie struct {
+	storage *kv.Storage
+	trie    *statetrie.Trie
+	tag     string
+	mu      sync.Mutex
+}
+
+func newStateTrie(storage *kv.Storage, tag string) (*stateTrie, error) {
+	trie, err := statetrie.New(storage, stateTriePrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load state trie: %v", err)
+	}
+	s := &stateTrie{
+		storage: storage,
+		trie:    trie,
+		tag:     tag,
+	}
+	t, err := storage.Get(stateTrieTagKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get state trie tag from storage: %v", err)
+	}
+	if string(t) != tag {
+		// the trie is enabled on an existing statedb, or the last rebuilding is interrupted
+		if err := s.rebuild(tag); err != nil {
+			return nil, fmt.Errorf("failed to rebuild state trie: %v", err)
+		}
+	}
+	return s, nil
+}
+
+// rebuild builds the trie from all the flushed state
+func (s *stateTrie) rebuild(tag string) error {
+	batch := s.storage.NewBatch()
+	iter := s.storage.NewIteratorByPrefix(stateTriePrefix)
+	for iter.Next() {
+		if err := batch.Delete(append([]byte{}, iter.Key()...)); err != nil {
+			iter.Release()
+			batch.Discard()
+			return err
+		}
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		batch.Discard()
+		return err
+	}
+	if err := batch.Commit(); err != nil {
+		return err
+	}
+	if err := s.trie.Reset(); err != nil {
+		return err
+	}
+
+	// the keys of state never start with separator, which is used by the keys of mvccdb itself
+	ranges := []*kv.IteratorOptions{
+		{End: []byte{SEPARATOR}},
+		{Start: kv.PrefixEnd([]byte{SEPARATOR})},
+	}
+	count := 0
+	for _, r := range ranges {
+		iter := s.storage.NewIterator(r)
+		for iter.Next() {
+			if err := s.trie.Put(iter.Key(), iter.Value()); err != nil {
+				iter.Release()
+				return err
+			}
+			count++
+			if count%stateTrieRebuildBatch != 0 {
+				continue
+			}
+			batch := s.storage.NewBatch()
+			if _, err := s.trie.Commit(batch); err != nil {
+				iter.Release()
+				batch.Discard()
+				return err
+			}
+			if err := batch.Commit(); err != nil {
+				iter.Release()
+				return err
+			}
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return err
+		}
+	}
+	batch = s.storage.NewBatch()
+	if _, err := s.trie.Commit(batch); err != nil {
+		batch.Discard()
+		return err
+	}
+	if err := batch.Put(stateTrieTagKey, []byte(tag)); err != nil {
+		batch.Discard()
+		return err
+	}
+	return batch.Commit()
+}
+
+// write will put the changed nodes of trie into the batch, the trie must be locked
+func (s *stateTrie) write(batch kv.Batch, t string, items []*Item) error {
+	for _, item := range items {
+		key := []byte(item.table + string(SEPARATOR) + item.key)
+		var err error
+		if item.deleted {
+			err = s.trie.Delete(key)
+		} else {
+			err = s.trie.Put(key, []byte(item.value))
+		}
+		if err != nil {
+			s.trie.Reset()
+			return err
+		}
+	}
+	if _, err := s.trie.Commit(batch); err != nil {
+		s.trie.Reset()
+		return err
+	}
+	if err := batch.Put(stateTrieTagKey, []byte(t)); err != nil {
+		s.trie.Reset()
+		return err
+	}
+	return nil
+}
+
+// commit moves the trie to the tag after the batch is committed, the trie must be locked
+func (s *stateTrie) commit(t string) {
+	s.tag = t
+}
+
+// root returns the flushed tag and the state root at the tag
+func (s *stateTrie) root() (string, []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.tag, s.trie.Root()
+}
+
+// prove returns the proof of key at the flushed tag
+func (s *stateTrie) prove(key []byte) (*StateProof, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, nodes, err := s.trie.Prove(key)
+	if err != nil {
+		return nil, err
+	}
+	return &StateProof{
+		Tag:   s.tag,
+		Root:  s.trie.Root(),
+		Value: value,
+		Nodes: nodes,
+	}, nil
+}
@@ -0,0 +1,171 @@
+package statetrie
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/iost-official/go-iost/common"
+)
+
+// kind of node
+const (
+	leafNode byte = iota + 1
+	extensionNode
+	branchNode
+)
+
+const hashLength = 32
+
+// dirtyHash marks the child whose hash needs to be recomputed
+var dirtyHash = []byte{}
+
+// error of node
+var (
+	ErrInvalidNode = errors.New("invalid trie node")
+)
+
+// node is the node of trie, the children are referenced by their hashes,
+// extension node uses the first child
+type node struct {
+	kind     byte
+	nibbles  []byte
+	value    []byte
+	children [16][]byte
+}
+
+func isDirty(hash []byte) bool {
+	return hash != nil && len(hash) == 0
+}
+
+func (n *node) childCount() int {
+	count := 0
+	for _, c := range n.children {
+		if c != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// encode marshals the node, all children must be hashed
+func (n *node) encode() []byte {
+	buf := make([]byte, 0, 64)
+	tmp := make([]byte, binary.MaxVarintLen64)
+
+	buf = append(buf, n.kind)
+	buf = append(buf, tmp[:binary.PutUvarint(tmp, uint64(len(n.nibbles)))]...)
+	buf = append(buf, n.nibbles...)
+	if n.value == nil {
+		buf = append(buf, 0)
+	} else {
+		buf = append(buf, 1)
+		buf = append(buf, tmp[:binary.PutUvarint(tmp, uint64(len(n.value)))]...)
+		buf = append(buf, n.value...)
+	}
+	switch n.kind {
+	case extensionNode:
+		buf = append(buf, n.children[0]...)
+	case branchNode:
+		var bitmap uint16
+		for i, c := range n.children {
+			if c != nil {
+				bitmap |= 1 << uint(i)
+			}
+		}
+		buf = append(buf, byte(bitmap>>8), byte(bitmap))
+		for _, c := range n.children {
+			if c != nil {
+				buf = append(buf, c...)
+			}
+		}
+	}
+	return buf
+}
+
+func decodeNode(b []byte) (*node, error) {
+	if len(b) == 0 {
+		return nil, ErrInvalidNode
+	}
+	n := &node{kind: b[0]}
+	b = b[1:]
+	l, size := binary.Uvarint(b)
+	if size <= 0 || uint64(len(b)-size) < l {
+		return nil, ErrInvalidNode
+	}
+	b = b[size:]
+	n.nibbles = append([]byte{}, b[:l]...)
+	b = b[l:]
+	if len(b) == 0 {
+		return nil, ErrInvalidNode
+	}
+	hasValue := b[0] == 1
+	b = b[1:]
+	if hasValue {
+		l, size = binary.Uvarint(b)
+		if size <= 0 || uint64(len(b)-size) < l {
+			return nil, ErrInvalidNode
+		}
+		b = b[size:]
+		n.value = append([]byte{}, b[:l]...)
+		b = b[l:]
+	}
+	switch n.kind {
+	case leafNode:
+	case extensionNode:
+		if len(b) < hashLength {
+			return nil, ErrInvalidNode
+		}
+		n.children[0] = append([]byte{}, b[:hashLength]...)
+		b = b[hashLength:]
+	case branchNode:
+		if len(b) < 2 {
+			return nil, ErrInvalidNode
+		}
+		bitmap := uint16(b[0])<<8 | uint16(b[1])
+		b = b[2:]
+		for i := range n.children {
+			if bitmap&(1<<uint(i)) == 0 {
+				continue
+			}
+			if len(b) < hashLength {
+				return nil, ErrInvalidNode
+			}
+			n.children[i] = append([]byte{}, b[:hashLength]...)
+			b = b[hashLength:]
+		}
+	default:
+		return nil, fmt.Errorf("unknown kind %v of trie node", n.kind)
+	}
+	if len(b) != 0 {
+		return nil, ErrInvalidNode
+	}
+	return n, nil
+}
+
+func hashNode(b []byte) []byte {
+	return common.Sha3(b)
+}
+
+func toNibbles(key []byte) []byte {
+	nibbles := make([]byte, len(key)*2)
+	for i, b := range key {
+		nibbles[i*2] = b >> 4
+		nibbles[i*2+1] = b & 0x0f
+	}
+	return nibbles
+}
+
+func commonPrefix(a, b []byte) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+func concat(a []byte, b ...byte) []byte {
+	r := make([]byte, 0, len(a)+len(b))
+	r = append(r, a...)
+	return append(r, b...)
+}
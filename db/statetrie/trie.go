@@ -0,0 +1,447 @@
+package statetrie
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/db/kv"
+)
+
+// EmptyRoot is the root hash of the empty trie
+var EmptyRoot = common.Sha3([]byte{})
+
+// error of trie
+var (
+	ErrInvalidProof = errors.New("invalid proof of trie")
+)
+
+// Trie is the merkle patricia trie, whose nodes are stored at their nibble paths under the prefix,
+// so the updated nodes overwrite the old ones in place. It is not thread safe.
+type Trie struct {
+	storage *kv.Storage
+	prefix  []byte
+	dirty   map[string]*node
+	root    []byte
+}
+
+// New returns the trie stored in the storage under the prefix
+func New(storage *kv.Storage, prefix []byte) (*Trie, error) {
+	t := &Trie{
+		storage: storage,
+		prefix:  prefix,
+	}
+	if err := t.Reset(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Reset drops the uncommitted changes and reloads the root from storage
+func (t *Trie) Reset() error {
+	t.dirty = make(map[string]*node)
+	b, err := t.storage.Get(t.prefix)
+	if err != nil {
+		return err
+	}
+	if len(b) == 0 {
+		t.root = EmptyRoot
+	} else {
+		t.root = hashNode(b)
+	}
+	return nil
+}
+
+// Root returns the root hash of the committed trie
+func (t *Trie) Root() []byte {
+	return t.root
+}
+
+// Empty returns whether the committed trie is empty
+func (t *Trie) Empty() bool {
+	return bytes.Equal(t.root, EmptyRoot)
+}
+
+func (t *Trie) load(path []byte) (*node, error) {
+	if n, ok := t.dirty[string(path)]; ok {
+		return n, nil
+	}
+	b, err := t.storage.Get(append(common.CopyBytes(t.prefix), path...))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, nil
+	}
+	return decodeNode(b)
+}
+
+func (t *Trie) set(path []byte, n *node) {
+	t.dirty[string(path)] = n
+}
+
+func (t *Trie) del(path []byte) {
+	t.dirty[string(path)] = nil
+}
+
+// Put will insert the key-value pair
+func (t *Trie) Put(key, value []byte) error {
+	return t.insert(nil, toNibbles(key), append([]byte{}, value...))
+}
+
+func (t *Trie) insert(path, key, value []byte) error {
+	n, err := t.load(path)
+	if err != nil {
+		return err
+	}
+	if n == nil {
+		t.set(path, &node{kind: leafNode, nibbles: key, value: value})
+		return nil
+	}
+	switch n.kind {
+	case leafNode:
+		if bytes.Equal(n.nibbles, key) {
+			n.value = value
+			t.set(path, n)
+			return nil
+		}
+		return t.split(path, n, key, value)
+	case extensionNode:
+		if c := commonPrefix(n.nibbles, key); c < len(n.nibbles) {
+			return t.split(path, n, key, value)
+		}
+		if err := t.insert(concat(path, n.nibbles...), key[len(n.nibbles):], value); err != nil {
+			return err
+		}
+		n.children[0] = dirtyHash
+		t.set(path, n)
+		return nil
+	default:
+		if len(key) == 0 {
+			n.value = value
+			t.set(path, n)
+			return nil
+		}
+		if err := t.insert(concat(path, key[0]), key[1:], value); err != nil {
+			return err
+		}
+		n.children[key[0]] = dirtyHash
+		t.set(path, n)
+		return nil
+	}
+}
+
+// split replaces the leaf or extension node n at path with a branch at the end of
+// the common prefix of n and key
+func (t *Trie) split(path []byte, n *node, key, value []byte) error {
+	c := commonPrefix(n.nibbles, key)
+	bp := concat(path, key[:c]...)
+	branch := &node{kind: branchNode}
+
+	rest := n.nibbles[c:]
+	switch {
+	case n.kind == leafNode && len(rest) == 0:
+		branch.value = n.value
+	case n.kind == leafNode:
+		t.set(concat(bp, rest[0]), &node{kind: leafNode, nibbles: rest[1:], value: n.value})
+		branch.children[rest[0]] = dirtyHash
+	case len(rest) == 1:
+		// the child of extension stays at its path
+		branch.children[rest[0]] = n.children[0]
+	default:
+		ext := &node{kind: extensionNode, nibbles: rest[1:]}
+		ext.children[0] = n.children[0]
+		t.set(concat(bp, rest[0]), ext)
+		branch.children[rest[0]] = dirtyHash
+	}
+
+	rest = key[c:]
+	if len(rest) == 0 {
+		branch.value = value
+	} else {
+		t.set(concat(bp, rest[0]), &node{kind: leafNode, nibbles: rest[1:], value: value})
+		branch.children[rest[0]] = dirtyHash
+	}
+
+	if c == 0 {
+		t.set(path, branch)
+		return nil
+	}
+	ext := &node{kind: extensionNode, nibbles: key[:c]}
+	ext.children[0] = dirtyHash
+	t.set(path, ext)
+	t.set(bp, branch)
+	return nil
+}
+
+// Delete will remove the key
+func (t *Trie) Delete(key []byte) error {
+	_, err := t.remove(nil, toNibbles(key))
+	return err
+}
+
+func (t *Trie) remove(path, key []byte) (bool, error) {
+	n, err := t.load(path)
+	if err != nil || n == nil {
+		return false, err
+	}
+	switch n.kind {
+	case leafNode:
+		if !bytes.Equal(n.nibbles, key) {
+			return false, nil
+		}
+		t.del(path)
+		return true, nil
+	case extensionNode:
+		if !bytes.HasPrefix(key, n.nibbles) {
+			return false, nil
+		}
+		cp := concat(path, n.nibbles...)
+		found, err := t.remove(cp, key[len(n.nibbles):])
+		if err != nil || !found {
+			return found, err
+		}
+		child, err := t.load(cp)
+		if err != nil {
+			return false, err
+		}
+		if child == nil {
+			return false, ErrInvalidNode
+		}
+		if child.kind != branchNode {
+			// merge the extension with the leaf or extension child
+			child.nibbles = concat(n.nibbles, child.nibbles...)
+			t.del(cp)
+			t.set(path, child)
+			return true, nil
+		}
+		n.children[0] = dirtyHash
+		t.set(path, n)
+		return true, nil
+	default:
+		if len(key) == 0 {
+			if n.value == nil {
+				return false, nil
+			}
+			n.value = nil
+		} else {
+			i := key[0]
+			if n.children[i] == nil {
+				return false, nil
+			}
+			cp := concat(path, i)
+			found, err := t.remove(cp, key[1:])
+			if err != nil || !found {
+				return found, err
+			}
+			child, err := t.load(cp)
+			if err != nil {
+				return false, err
+			}
+			if child == nil {
+				n.children[i] = nil
+			} else {
+				n.children[i] = dirtyHash
+			}
+		}
+		return true, t.collapse(path, n)
+	}
+}
+
+// collapse replaces the branch which has only one child or value left
+func (t *Trie) collapse(path []byte, n *node) error {
+	count := n.childCount()
+	switch {
+	case count == 0:
+		t.set(path, &node{kind: leafNode, value: n.value})
+		return nil
+	case count > 1 || n.value != nil:
+		t.set(path, n)
+		return nil
+	}
+	var i byte
+	for j, c := range n.children {
+		if c != nil {
+			i = byte(j)
+		}
+	}
+	cp := concat(path, i)
+	child, err := t.load(cp)
+	if err != nil {
+		return err
+	}
+	if child == nil {
+		return ErrInvalidNode
+	}
+	if child.kind == branchNode {
+		ext := &node{kind: extensionNode, nibbles: []byte{i}}
+		ext.children[0] = n.children[i]
+		t.set(path, ext)
+		return nil
+	}
+	child.nibbles = concat([]byte{i}, child.nibbles...)
+	t.del(cp)
+	t.set(path, child)
+	return nil
+}
+
+// Commit writes the changed nodes into the batch and returns the new root hash
+func (t *Trie) Commit(batch kv.Batch) ([]byte, error) {
+	root := EmptyRoot
+	if n, err := t.load(nil); err != nil {
+		return nil, err
+	} else if n != nil {
+		h, err := t.commit(batch, nil)
+		if err != nil {
+			return nil, err
+		}
+		root = h
+	}
+	for path, n := range t.dirty {
+		if n == nil {
+			if err := batch.Delete(append(common.CopyBytes(t.prefix), path...)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	t.dirty = make(map[string]*node)
+	t.root = root
+	return root, nil
+}
+
+func (t *Trie) commit(batch kv.Batch, path []byte) ([]byte, error) {
+	n, err := t.load(path)
+	if err != nil {
+		return nil, err
+	}
+	if n == nil {
+		return nil, ErrInvalidNode
+	}
+	if _, ok := t.dirty[string(path)]; !ok {
+		b, err := t.storage.Get(append(common.CopyBytes(t.prefix), path...))
+		if err != nil {
+			return nil, err
+		}
+		return hashNode(b), nil
+	}
+	for i, c := range n.children {
+		if !isDirty(c) {
+			continue
+		}
+		cp := concat(path, byte(i))
+		if n.kind == extensionNode {
+			cp = concat(path, n.nibbles...)
+		}
+		h, err := t.commit(batch, cp)
+		if err != nil {
+			return nil, err
+		}
+		n.children[i] = h
+	}
+	b := n.encode()
+	if err := batch.Put(append(common.CopyBytes(t.prefix), path...), b); err != nil {
+		return nil, err
+	}
+	return hashNode(b), nil
+}
+
+// Prove returns the value of the key and the encoded nodes from the root to the key,
+// value is nil if the key doesn't exist, the proof is against the committed trie
+func (t *Trie) Prove(key []byte) (value []byte, proof [][]byte, err error) {
+	nibbles := toNibbles(key)
+	var path []byte
+	for {
+		b, err := t.storage.Get(append(common.CopyBytes(t.prefix), path...))
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(b) == 0 {
+			if len(proof) != 0 {
+				return nil, nil, ErrInvalidNode
+			}
+			return nil, proof, nil
+		}
+		proof = append(proof, b)
+		n, err := decodeNode(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch n.kind {
+		case leafNode:
+			if bytes.Equal(n.nibbles, nibbles) {
+				return n.value, proof, nil
+			}
+			return nil, proof, nil
+		case extensionNode:
+			if !bytes.HasPrefix(nibbles, n.nibbles) {
+				return nil, proof, nil
+			}
+			path = concat(path, n.nibbles...)
+			nibbles = nibbles[len(n.nibbles):]
+		default:
+			if len(nibbles) == 0 {
+				return n.value, proof, nil
+			}
+			if n.children[nibbles[0]] == nil {
+				return nil, proof, nil
+			}
+			path = concat(path, nibbles[0])
+			nibbles = nibbles[1:]
+		}
+	}
+}
+
+// VerifyProof checks the proof of key against the root, and returns the proved value,
+// which is nil if the proof shows the key doesn't exist
+func VerifyProof(root []byte, key []byte, proof [][]byte) ([]byte, error) {
+	if len(proof) == 0 {
+		if bytes.Equal(root, EmptyRoot) {
+			return nil, nil
+		}
+		return nil, ErrInvalidProof
+	}
+	nibbles := toNibbles(key)
+	expected := root
+	for i, b := range proof {
+		last := i == len(proof)-1
+		if !bytes.Equal(hashNode(b), expected) {
+			return nil, ErrInvalidProof
+		}
+		n, err := decodeNode(b)
+		if err != nil {
+			return nil, err
+		}
+		switch n.kind {
+		case leafNode:
+			if !last {
+				return nil, ErrInvalidProof
+			}
+			if bytes.Equal(n.nibbles, nibbles) {
+				return n.value, nil
+			}
+			return nil, nil
+		case extensionNode:
+			if !bytes.HasPrefix(nibbles, n.nibbles) {
+				if !last {
+					return nil, ErrInvalidProof
+				}
+				return nil, nil
+			}
+			expected = n.children[0]
+			nibbles = nibbles[len(n.nibbles):]
+		default:
+			if len(nibbles) == 0 || n.children[nibbles[0]] == nil {
+				if !last {
+					return nil, ErrInvalidProof
+				}
+				if len(nibbles) == 0 {
+					return n.value, nil
+				}
+				return nil, nil
+			}
+			expected = n.children[nibbles[0]]
+			nibbles = nibbles[1:]
+		}
+	}
+	return nil, ErrInvalidProof
+}
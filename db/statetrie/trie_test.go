@@ -0,0 +1,155 @@
+package statetrie
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTrie(t *testing.T, path string) (*Trie, *kv.Storage) {
+	storage, err := kv.NewStorage(path, kv.LevelDBStorage)
+	require.Nil(t, err)
+	trie, err := New(storage, []byte("/trie/"))
+	require.Nil(t, err)
+	return trie, storage
+}
+
+func commit(t *testing.T, trie *Trie, storage *kv.Storage) []byte {
+	batch := storage.NewBatch()
+	root, err := trie.Commit(batch)
+	require.Nil(t, err)
+	require.Nil(t, batch.Commit())
+	return root
+}
+
+func TestTrie(t *testing.T) {
+	defer os.RemoveAll("trie1")
+	defer os.RemoveAll("trie2")
+	trie1, storage1 := newTestTrie(t, "trie1")
+	defer storage1.Close()
+	trie2, storage2 := newTestTrie(t, "trie2")
+	defer storage2.Close()
+	assert.Equal(t, EmptyRoot, trie1.Root())
+
+	kvs := map[string]string{
+		"state/b-a":        "1",
+		"state/b-ab":       "2",
+		"state/b-abc":      "3",
+		"state/b-b":        "4",
+		"state/m-a-x":      "5",
+		"state/m-a-y":      "",
+		"state/m-contract": "6",
+		"a":                "7",
+	}
+	keys := make([]string, 0)
+	for k := range kvs {
+		keys = append(keys, k)
+	}
+	for _, k := range keys {
+		require.Nil(t, trie1.Put([]byte(k), []byte(kvs[k])))
+	}
+	root1 := commit(t, trie1, storage1)
+	for i := len(keys) - 1; i >= 0; i-- {
+		require.Nil(t, trie2.Put([]byte(keys[i]), []byte("tmp")))
+	}
+	commit(t, trie2, storage2)
+	for i := len(keys) - 1; i >= 0; i-- {
+		require.Nil(t, trie2.Put([]byte(keys[i]), []byte(kvs[keys[i]])))
+	}
+	root2 := commit(t, trie2, storage2)
+	assert.Equal(t, root1, root2, "root should be independent of the order of puts")
+	assert.NotEqual(t, EmptyRoot, root1)
+
+	for k, v := range kvs {
+		value, proof, err := trie1.Prove([]byte(k))
+		require.Nil(t, err)
+		assert.Equal(t, []byte(v), value)
+		proved, err := VerifyProof(root1, []byte(k), proof)
+		require.Nil(t, err)
+		assert.Equal(t, []byte(v), proved)
+		if proved, err = VerifyProof(root1, []byte(k+"x"), proof); err == nil {
+			assert.Nil(t, proved)
+		}
+	}
+	for _, k := range []string{"state/b-", "state/b-abcd", "state/c", "b", ""} {
+		value, proof, err := trie1.Prove([]byte(k))
+		require.Nil(t, err)
+		assert.Nil(t, value)
+		proved, err := VerifyProof(root1, []byte(k), proof)
+		require.Nil(t, err)
+		assert.Nil(t, proved)
+	}
+	_, proof, err := trie1.Prove([]byte("state/b-a"))
+	require.Nil(t, err)
+	proof[len(proof)-1] = append([]byte{}, proof[len(proof)-1]...)
+	proof[len(proof)-1][len(proof[len(proof)-1])-1] ^= 1
+	_, err = VerifyProof(root1, []byte("state/b-a"), proof)
+	assert.Equal(t, ErrInvalidProof, err)
+
+	require.Nil(t, trie1.Put([]byte("state/b-b"), []byte("44")))
+	require.Nil(t, trie1.Delete([]byte("state/m-a-x")))
+	require.Nil(t, trie1.Delete([]byte("not exist")))
+	require.Nil(t, trie1.Reset())
+	assert.Equal(t, root1, trie1.Root())
+	value, _, err := trie1.Prove([]byte("state/m-a-x"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("5"), value)
+
+	for _, k := range keys {
+		require.Nil(t, trie2.Delete([]byte(k)))
+	}
+	assert.Equal(t, EmptyRoot, commit(t, trie2, storage2))
+	iter := storage2.NewIteratorByPrefix([]byte("/trie/"))
+	assert.False(t, iter.Next(), "all nodes should be removed")
+	iter.Release()
+}
+
+func TestTrieRandom(t *testing.T) {
+	defer os.RemoveAll("trie1")
+	defer os.RemoveAll("trie2")
+	trie1, storage1 := newTestTrie(t, "trie1")
+	defer storage1.Close()
+	trie2, storage2 := newTestTrie(t, "trie2")
+	defer storage2.Close()
+
+	r := rand.New(rand.NewSource(1))
+	kvs := make(map[string]string)
+	for round := 0; round < 20; round++ {
+		for i := 0; i < 100; i++ {
+			k := fmt.Sprintf("state/b-%x", r.Intn(500))
+			if r.Intn(3) == 0 {
+				delete(kvs, k)
+				require.Nil(t, trie1.Delete([]byte(k)))
+			} else {
+				kvs[k] = fmt.Sprint(r.Int())
+				require.Nil(t, trie1.Put([]byte(k), []byte(kvs[k])))
+			}
+		}
+		root := commit(t, trie1, storage1)
+
+		// rebuild the same state from scratch
+		iter := storage2.NewIteratorByPrefix([]byte("/trie/"))
+		for iter.Next() {
+			require.Nil(t, storage2.Delete(append([]byte{}, iter.Key()...)))
+		}
+		iter.Release()
+		require.Nil(t, trie2.Reset())
+		for k, v := range kvs {
+			require.Nil(t, trie2.Put([]byte(k), []byte(v)))
+		}
+		assert.Equal(t, root, commit(t, trie2, storage2))
+
+		for k, v := range kvs {
+			_, proof, err := trie1.Prove([]byte(k))
+			require.Nil(t, err)
+			value, err := VerifyProof(root, []byte(k), proof)
+			require.Nil(t, err)
+			require.Equal(t, []byte(v), value)
+		}
+	}
+}
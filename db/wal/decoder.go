@@ -21,6 +21,7 @@ type decoder struct {
 	r          []*bufio.Reader
 	crc        hash.Hash64
 	lastOffset int64
+	lastSize   int64
 }
 
 const frameSizeLength = 8 // record current frame size in a int64 which is 8 bytes
@@ -80,19 +81,33 @@ func (d *decoder) decodeRecord(log *Log) error {
 		ilog.Error("Failed to unmarshal Log: ", data)
 		return err
 	}
+	if err := log.CheckRecord(); err != nil {
+		if d.isTornWrite(data) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
 
 	// skip pcrc checking if the record type is crcType
+	prevCrc := d.crc.Sum64()
 	if log.Type != LogType_crcType {
 		d.crc.Write(log.Data)
 		if err := log.Check(d.crc.Sum64()); err != nil {
+			// keep the crc of last valid record for truncating
+			d.updateCRC(prevCrc)
 			if d.isTornWrite(data) {
 				return io.ErrUnexpectedEOF
 			}
 			return err
 		}
 	}
+	if err := log.decompress(); err != nil {
+		d.updateCRC(prevCrc)
+		return err
+	}
 	// Got a record, update last offset
-	d.lastOffset += frameSizeLength + recBytes + padBytes
+	d.lastSize = frameSizeLength + recBytes + padBytes
+	d.lastOffset += d.lastSize
 	return nil
 }
 
@@ -146,6 +161,21 @@ func (d *decoder) isTornWrite(data []byte) bool {
 	return false
 }
 
+// checkCRCRecord checks the crc record at the head of file against the crc of decoder,
+// and chains the crc of decoder with the record
+func (d *decoder) checkCRCRecord(log *Log) error {
+	crc := d.crc.Sum64()
+	// current crc of decoder must match the crc of the record.
+	// do no need to match 0 crc, since the decoder is a new one at this case.
+	if crc != 0 && log.Check(crc) != nil {
+		// the corrupted record is not counted
+		d.lastOffset -= d.lastSize
+		return ErrCRCMismatch
+	}
+	d.updateCRC(log.Checksum)
+	return nil
+}
+
 func (d *decoder) updateCRC(prevCrc uint64) {
 	d.crc = pcrc.New(prevCrc, crc64Table)
 }
@@ -21,9 +21,10 @@ type encoder struct {
 	mu sync.Mutex
 	w  *PageWriter
 
-	crc       hash.Hash64
-	buf       []byte
-	uint64buf []byte
+	crc         hash.Hash64
+	buf         []byte
+	uint64buf   []byte
+	compression CompressionType
 }
 
 func newEncoder(w io.Writer, prevCrc uint64, pageOffset int) *encoder {
@@ -52,6 +53,8 @@ func (e *encoder) encode(log *Log) error {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
+	log.compress(e.compression)
+	log.RecordChecksum = log.recordChecksum()
 	e.crc.Write(log.Data)
 	log.Checksum = e.crc.Sum64()
 
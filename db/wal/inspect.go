@@ -0,0 +1,96 @@
+package wal
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Record is a record of WAL read by Inspect
+type Record struct {
+	File       string // the base name of file
+	Offset     int64  // the offset of record in file
+	Size       int64  // the bytes of record in file
+	Type       LogType
+	Compressed bool
+	Data       []byte // the decompressed data
+	Entry      *Entry // the entry of entryType record
+}
+
+// CorruptedError is returned by Inspect when a corrupted record is found,
+// the WAL is truncated at the record by ReadAll
+type CorruptedError struct {
+	File   string
+	Offset int64
+	Err    error
+}
+
+func (e *CorruptedError) Error() string {
+	return fmt.Sprintf("WAL: corrupted record at %v:%v, %v", e.File, e.Offset, e.Err)
+}
+
+// Inspect reads the records in the WAL directory without modifying it, and calls fn
+// with every valid record. It stops at the end of WAL, the first corrupted record,
+// or the error returned by fn.
+func Inspect(dirpath string, fn func(*Record) error) error {
+	w, err := openAtIndex(dirpath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		w.readClose()
+		for _, f := range w.files {
+			f.Close()
+		}
+	}()
+
+	d := w.decoder
+	file := func() string {
+		i := len(w.files) - len(d.r)
+		if i < 0 || i >= len(w.files) {
+			return ""
+		}
+		return filepath.Base(w.files[i].Name())
+	}
+	log := &Log{}
+	for {
+		err := d.decode(log)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			// the torn write at the end of WAL is not a corruption
+			return nil
+		}
+		if err == nil && log.Type == LogType_crcType {
+			err = d.checkCRCRecord(log)
+		}
+		if err != nil {
+			return &CorruptedError{
+				File:   file(),
+				Offset: d.getLastOffset(),
+				Err:    err,
+			}
+		}
+		r := &Record{
+			File:       file(),
+			Offset:     d.getLastOffset() - d.lastSize,
+			Size:       d.lastSize,
+			Type:       log.Type,
+			Compressed: log.Compression != CompressionType_noCompression,
+			Data:       log.Data,
+		}
+		if log.Type == LogType_entryType {
+			r.Entry = &Entry{}
+			if err := proto.Unmarshal(log.Data, r.Entry); err != nil {
+				return &CorruptedError{
+					File:   r.File,
+					Offset: r.Offset,
+					Err:    err,
+				}
+			}
+		}
+		if err := fn(r); err != nil {
+			return err
+		}
+	}
+}
@@ -1,6 +1,23 @@
 package wal
 
-import "errors"
+import (
+	"errors"
+	"hash/crc32"
+
+	"github.com/golang/snappy"
+)
+
+// the min size of entry data to be compressed
+const minCompressBytes = 256
+
+var (
+	crc32Table = crc32.MakeTable(crc32.Castagnoli)
+
+	// ErrRecordChecksum record checksum mismatch
+	ErrRecordChecksum = errors.New("WAL: record checksum mismatch")
+	// ErrUnknownCompression unknown compression type
+	ErrUnknownCompression = errors.New("WAL: unknown compression type")
+)
 
 // Check check whether the log.checksum is same as crc
 func (log *Log) Check(crc uint64) error {
@@ -10,3 +27,52 @@ func (log *Log) Check(crc uint64) error {
 	log.Reset()
 	return errors.New("WAL: crc mismatch")
 }
+
+// compress compresses the data of entry if it gets smaller
+func (log *Log) compress(c CompressionType) {
+	if c != CompressionType_snappyCompression || log.Type != LogType_entryType || len(log.Data) < minCompressBytes {
+		return
+	}
+	data := snappy.Encode(nil, log.Data)
+	if len(data) >= len(log.Data) {
+		return
+	}
+	log.Data = data
+	log.Compression = c
+}
+
+// decompress restores the data of compressed log, the compression is kept for inspection
+func (log *Log) decompress() error {
+	switch log.Compression {
+	case CompressionType_noCompression:
+		return nil
+	case CompressionType_snappyCompression:
+		data, err := snappy.Decode(nil, log.Data)
+		if err != nil {
+			return err
+		}
+		log.Data = data
+		return nil
+	default:
+		return ErrUnknownCompression
+	}
+}
+
+// recordChecksum returns the checksum of the record itself, which is independent of other records
+func (log *Log) recordChecksum() uint32 {
+	crc := crc32.Update(0, crc32Table, []byte{byte(log.Type), byte(log.Compression)})
+	crc = crc32.Update(crc, crc32Table, log.Data)
+	// zero is reserved for the records written without record checksum
+	if crc == 0 {
+		crc = 1
+	}
+	return crc
+}
+
+// CheckRecord check whether the record checksum of log is valid, the logs without record checksum are valid
+func (log *Log) CheckRecord() error {
+	if log.RecordChecksum == 0 || log.RecordChecksum == log.recordChecksum() {
+		return nil
+	}
+	return ErrRecordChecksum
+}
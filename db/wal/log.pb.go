@@ -48,13 +48,40 @@ func (LogType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_d01227d54ddec537, []int{0}
 }
 
+type CompressionType int32
+
+const (
+	CompressionType_noCompression     CompressionType = 0
+	CompressionType_snappyCompression CompressionType = 1
+)
+
+var CompressionType_name = map[int32]string{
+	0: "noCompression",
+	1: "snappyCompression",
+}
+
+var CompressionType_value = map[string]int32{
+	"noCompression":     0,
+	"snappyCompression": 1,
+}
+
+func (x CompressionType) String() string {
+	return proto.EnumName(CompressionType_name, int32(x))
+}
+
+func (CompressionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d01227d54ddec537, []int{1}
+}
+
 type Log struct {
-	Type                 LogType  `protobuf:"varint,1,opt,name=type,proto3,enum=wal.LogType" json:"type,omitempty"`
-	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Checksum             uint64   `protobuf:"varint,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Type                 LogType         `protobuf:"varint,1,opt,name=type,proto3,enum=wal.LogType" json:"type,omitempty"`
+	Data                 []byte          `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Checksum             uint64          `protobuf:"varint,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Compression          CompressionType `protobuf:"varint,4,opt,name=compression,proto3,enum=wal.CompressionType" json:"compression,omitempty"`
+	RecordChecksum       uint32          `protobuf:"varint,5,opt,name=recordChecksum,proto3" json:"recordChecksum,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *Log) Reset()         { *m = Log{} }
@@ -103,6 +130,20 @@ func (m *Log) GetChecksum() uint64 {
 	return 0
 }
 
+func (m *Log) GetCompression() CompressionType {
+	if m != nil {
+		return m.Compression
+	}
+	return CompressionType_noCompression
+}
+
+func (m *Log) GetRecordChecksum() uint32 {
+	if m != nil {
+		return m.RecordChecksum
+	}
+	return 0
+}
+
 type Entry struct {
 	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	ExtraMeta            []byte   `protobuf:"bytes,2,opt,name=ExtraMeta,proto3" json:"ExtraMeta,omitempty"`
@@ -160,6 +201,7 @@ func (m *Entry) GetIndex() uint64 {
 
 func init() {
 	proto.RegisterEnum("wal.LogType", LogType_name, LogType_value)
+	proto.RegisterEnum("wal.CompressionType", CompressionType_name, CompressionType_value)
 	proto.RegisterType((*Log)(nil), "wal.Log")
 	proto.RegisterType((*Entry)(nil), "wal.Entry")
 }
@@ -167,19 +209,23 @@ func init() {
 func init() { proto.RegisterFile("db/wal/log.proto", fileDescriptor_d01227d54ddec537) }
 
 var fileDescriptor_d01227d54ddec537 = []byte{
-	// 210 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x48, 0x49, 0xd2, 0x2f,
-	0x4f, 0xcc, 0xd1, 0xcf, 0xc9, 0x4f, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2e, 0x4f,
-	0xcc, 0x51, 0x0a, 0xe7, 0x62, 0xf6, 0xc9, 0x4f, 0x17, 0x52, 0xe0, 0x62, 0x29, 0xa9, 0x2c, 0x48,
-	0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x33, 0xe2, 0xd1, 0x2b, 0x4f, 0xcc, 0xd1, 0xf3, 0xc9, 0x4f,
-	0x0f, 0xa9, 0x2c, 0x48, 0x0d, 0x02, 0xcb, 0x08, 0x09, 0x71, 0xb1, 0xa4, 0x24, 0x96, 0x24, 0x4a,
-	0x30, 0x29, 0x30, 0x6a, 0xf0, 0x04, 0x81, 0xd9, 0x42, 0x52, 0x5c, 0x1c, 0xc9, 0x19, 0xa9, 0xc9,
-	0xd9, 0xc5, 0xa5, 0xb9, 0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x70, 0xbe, 0x92, 0x3f, 0x17,
-	0xab, 0x6b, 0x5e, 0x49, 0x51, 0x25, 0x5c, 0x23, 0x23, 0x92, 0x46, 0x19, 0x2e, 0x4e, 0xd7, 0x8a,
-	0x92, 0xa2, 0x44, 0xdf, 0x54, 0xb8, 0x89, 0x08, 0x01, 0x21, 0x11, 0x2e, 0xd6, 0xcc, 0xbc, 0x94,
-	0xd4, 0x0a, 0xa8, 0x99, 0x10, 0x8e, 0x96, 0x39, 0x17, 0x3b, 0xd4, 0x45, 0x42, 0xdc, 0x5c, 0xec,
-	0xc9, 0x45, 0xc9, 0x20, 0xa6, 0x00, 0x83, 0x90, 0x00, 0x17, 0x4f, 0x6e, 0x6a, 0x49, 0xa2, 0x4b,
-	0x62, 0x49, 0x22, 0x58, 0x84, 0x49, 0x88, 0x97, 0x8b, 0x33, 0x15, 0x64, 0x35, 0x98, 0xcb, 0x9c,
-	0xc4, 0x06, 0xf6, 0xae, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x16, 0x9a, 0x17, 0x02, 0x01,
-	0x00, 0x00,
+	// 279 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
+	0x14, 0x85, 0x3b, 0x4d, 0xf2, 0xf7, 0xef, 0x6d, 0x52, 0xd3, 0x4b, 0x85, 0x20, 0x2e, 0x42, 0x17,
+	0x12, 0xba, 0x48, 0x41, 0x41, 0x17, 0x2e, 0x6b, 0x77, 0x15, 0x21, 0xf8, 0x02, 0xd3, 0xc9, 0x10,
+	0x8b, 0xc9, 0xcc, 0x30, 0x19, 0x49, 0xf3, 0x64, 0xbe, 0x9e, 0x74, 0xac, 0x69, 0xe8, 0xee, 0x9e,
+	0x6f, 0x86, 0x73, 0xcf, 0xe1, 0x42, 0x98, 0xef, 0x56, 0x0d, 0x2d, 0x57, 0xa5, 0x2c, 0x52, 0xa5,
+	0xa5, 0x91, 0xe8, 0x34, 0xb4, 0x5c, 0x7c, 0x13, 0x70, 0xb6, 0xb2, 0xc0, 0x18, 0x5c, 0xd3, 0x2a,
+	0x1e, 0x91, 0x98, 0x24, 0xd3, 0x7b, 0x3f, 0x6d, 0x68, 0x99, 0x6e, 0x65, 0xf1, 0xde, 0x2a, 0x9e,
+	0xd9, 0x17, 0x44, 0x70, 0x73, 0x6a, 0x68, 0x34, 0x8c, 0x49, 0xe2, 0x67, 0x76, 0xc6, 0x1b, 0xf8,
+	0xcf, 0x3e, 0x38, 0xfb, 0xac, 0xbf, 0xaa, 0xc8, 0x89, 0x49, 0xe2, 0x66, 0x9d, 0xc6, 0x47, 0x98,
+	0x30, 0x59, 0x29, 0xcd, 0xeb, 0x7a, 0x2f, 0x45, 0xe4, 0x5a, 0xe3, 0xb9, 0x35, 0x5e, 0x9f, 0xb9,
+	0x5d, 0xd0, 0xff, 0x88, 0x77, 0x30, 0xd5, 0x9c, 0x49, 0x9d, 0xaf, 0xff, 0x9c, 0xbd, 0x98, 0x24,
+	0x41, 0x76, 0x41, 0x17, 0x6f, 0xe0, 0x6d, 0x84, 0xd1, 0x6d, 0x17, 0x8c, 0xf4, 0x82, 0xdd, 0xc2,
+	0x78, 0x73, 0x30, 0x9a, 0xbe, 0xf2, 0x2e, 0xf1, 0x19, 0xe0, 0x1c, 0xbc, 0xbd, 0xc8, 0xf9, 0xe1,
+	0x94, 0xf9, 0x57, 0x2c, 0x9f, 0x60, 0x74, 0x6a, 0x8c, 0x13, 0x18, 0x31, 0xcd, 0x8e, 0x63, 0x38,
+	0xc0, 0x10, 0xfc, 0x8a, 0x1b, 0xfa, 0x42, 0x0d, 0xb5, 0x64, 0x88, 0x01, 0x8c, 0xf9, 0x71, 0xb5,
+	0x95, 0xce, 0xf2, 0x19, 0xae, 0x2e, 0x1a, 0xe1, 0x0c, 0x02, 0x21, 0x7b, 0x30, 0x1c, 0xe0, 0x35,
+	0xcc, 0x6a, 0x41, 0x95, 0x6a, 0xfb, 0x98, 0xec, 0xfe, 0xd9, 0x63, 0x3c, 0xfc, 0x0c, 0x00, 0xb8,
+	0xad, 0x22, 0x3e, 0xa0, 0x01, 0x00, 0x00,
 }
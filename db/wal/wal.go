@@ -58,12 +58,25 @@ type WAL struct {
 
 	files []*os.File // the locked files the WAL holds (the name is increasing)
 	st    *StreamFile
+
+	compression CompressionType // compression of the entries appended
+}
+
+// Options is the options of WAL
+type Options struct {
+	Compression CompressionType
 }
 
 // Create creates a WAL ready for appending records. The given metadata is
 // recorded at the head of each WAL file, and can be retrieved with ReadAll.
 // If there already are some wal files, it will try to recover from them.
 func Create(dirpath string, metadata []byte) (*WAL, error) {
+	return CreateWithOptions(dirpath, metadata, &Options{})
+}
+
+// CreateWithOptions creates a WAL with the options, the options only affect the
+// records appended, the existing records are read whatever they are compressed or not.
+func CreateWithOptions(dirpath string, metadata []byte, opts *Options) (*WAL, error) {
 	b, err := exists(dirpath)
 	if err != nil {
 		return nil, err
@@ -78,7 +91,12 @@ func Create(dirpath string, metadata []byte) (*WAL, error) {
 
 	if Exist(dirpath) {
 		// Recover
-		return recoverFromDir(dirpath, metadata)
+		w, err := recoverFromDir(dirpath, metadata)
+		if err != nil {
+			return nil, err
+		}
+		w.compression = opts.Compression
+		return w, nil
 	}
 
 	streamFile := newStreamFile(dirpath, SegmentSizeBytes)
@@ -89,9 +107,10 @@ func Create(dirpath string, metadata []byte) (*WAL, error) {
 	}
 
 	w := &WAL{
-		dir:      dirpath,
-		metadata: metadata,
-		st:       streamFile,
+		dir:         dirpath,
+		metadata:    metadata,
+		st:          streamFile,
+		compression: opts.Compression,
 	}
 
 	if w.dirFile, err = OpenDir(w.dir); err != nil {
@@ -102,6 +121,7 @@ func Create(dirpath string, metadata []byte) (*WAL, error) {
 	if err != nil {
 		return nil, err
 	}
+	w.encoder.compression = w.compression
 	w.files = append(w.files, f)
 	if err = w.saveCrc(0); err != nil {
 		return nil, err
@@ -142,17 +162,16 @@ func (w *WAL) ReadAll() (metadata []byte, ents []Entry, err error) {
 			metadata = log.Data
 
 		case LogType_crcType:
-			crc := decoder.crc.Sum64()
-			// current crc of decoder must match the crc of the record.
-			// do no need to match 0 crc, since the decoder is a new one at this case.
-			if crc != 0 && log.Check(crc) != nil {
-				return nil, nil, ErrCRCMismatch
+			if err = decoder.checkCRCRecord(log); err != nil {
+				break
 			}
-			decoder.updateCRC(log.Checksum)
 
 		default:
 			return nil, nil, fmt.Errorf("unexpected block type %d", log.Type)
 		}
+		if err != nil {
+			break
+		}
 	}
 
 	switch w.tail() {
@@ -164,9 +183,13 @@ func (w *WAL) ReadAll() (metadata []byte, ents []Entry, err error) {
 			return nil, nil, err
 		}
 	default:
-		// We must read all of the entries if WAL is opened in write mode.
+		// The records after the first corrupted one are dropped, instead of failing
+		// the whole WAL. They are not reliable since the crc is chained.
 		if err != io.EOF && err != io.ErrUnexpectedEOF {
-			return nil, nil, err
+			ilog.Errorf("WAL: truncate at the corrupted record, %v entries are recovered, err: %v", len(ents), err)
+			if err = w.dropFilesAfterDecoder(); err != nil {
+				return nil, nil, err
+			}
 		}
 		// decodeRecord() will return io.EOF if it detects a zero record,
 		// but this zero record may be followed by non-zero records from
@@ -206,12 +229,34 @@ func (w *WAL) ReadAll() (metadata []byte, ents []Entry, err error) {
 		if err != nil {
 			return
 		}
+		w.encoder.compression = w.compression
 	}
 	w.decoder = nil
 
 	return metadata, ents, err
 }
 
+// dropFilesAfterDecoder removes the files after the one being decoded,
+// so the file being decoded becomes the tail
+func (w *WAL) dropFilesAfterDecoder() error {
+	i := len(w.files) - len(w.decoder.r)
+	if i < 0 || i >= len(w.files) {
+		return nil
+	}
+	for _, f := range w.files[i+1:] {
+		ilog.Warn("WAL: remove the file after the corrupted record: ", f.Name())
+		// the wal files are closed by readClose
+		if strings.HasSuffix(f.Name(), ".wal.tmp") {
+			f.Close()
+		}
+		if err := os.Remove(f.Name()); err != nil {
+			return err
+		}
+	}
+	w.files = w.files[:i+1]
+	return nil
+}
+
 // SaveSingle save single entry, Return entry index and error
 func (w *WAL) SaveSingle(ent Entry) (uint64, error) {
 	w.mu.Lock()
@@ -438,6 +483,7 @@ func (w *WAL) cut() error {
 	if err != nil {
 		return err
 	}
+	w.encoder.compression = w.compression
 
 	if err = w.saveCrc(prevCrc); err != nil {
 		return err
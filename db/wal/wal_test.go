@@ -2,6 +2,7 @@ package wal
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"math"
@@ -348,4 +349,123 @@ func TestRemoveFiles(t *testing.T) {
 	if entries[1].Index != 3 {
 		t.Fatal("Entry Index miss match, should be 3, got: ", entries[1].Index)
 	}
-}
\ No newline at end of file
+}
+func TestCompression(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(p)
+
+	w, err := CreateWithOptions(p, []byte("somedata"), &Options{Compression: CompressionType_snappyCompression})
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+	large := bytes.Repeat([]byte("Entry"), 1000)
+	w.Save([]Entry{{Data: []byte("Entry1")}, {Data: large}})
+	w.Close()
+
+	compressed := make([]bool, 0)
+	err = Inspect(p, func(r *Record) error {
+		if r.Type == LogType_entryType {
+			compressed = append(compressed, r.Compressed)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(compressed) != 2 || compressed[0] || !compressed[1] {
+		t.Fatal("only the large entry should be compressed, got: ", compressed)
+	}
+
+	newW, err := Create(p, []byte("somedata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer newW.Close()
+	_, entries, err := newW.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || !bytes.Equal(entries[1].Data, large) {
+		t.Fatal("compressed entry is not recovered")
+	}
+}
+
+func TestTruncateCorrupted(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(p)
+
+	w, err := Create(p, []byte("somedata"))
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+	for i := 0; i < 5; i++ {
+		w.SaveSingle(Entry{Data: []byte(fmt.Sprintf("Entry%d", i))})
+	}
+	w.Close()
+
+	records := make([]*Record, 0)
+	err = Inspect(p, func(r *Record) error {
+		records = append(records, r)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 7 {
+		t.Fatal("record length not match, should be 7 got: ", len(records))
+	}
+	// corrupt the data of the fourth entry
+	r := records[5]
+	f, err := os.OpenFile(filepath.Join(p, r.File), os.O_RDWR, 0666)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := make([]byte, r.Size)
+	if _, err := f.ReadAt(b, r.Offset); err != nil {
+		t.Fatal(err)
+	}
+	i := bytes.Index(b, []byte("Entry3"))
+	b[i+5] = '9'
+	if _, err := f.WriteAt(b, r.Offset); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	err = Inspect(p, func(r *Record) error { return nil })
+	if e, ok := err.(*CorruptedError); !ok || e.Offset != r.Offset || e.Err != ErrRecordChecksum {
+		t.Fatal("corrupted record not found, got: ", err)
+	}
+
+	newW, err := Create(p, []byte("somedata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, entries, err := newW.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Fatal("Entry length not match, should be 3 got: ", len(entries))
+	}
+	newW.SaveSingle(Entry{Data: []byte("Entry5")})
+	newW.Close()
+
+	newW, err = Create(p, []byte("somedata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer newW.Close()
+	_, entries, err = newW.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 4 || string(entries[3].Data) != "Entry5" {
+		t.Fatal("entries after truncation not match, got: ", entries)
+	}
+}
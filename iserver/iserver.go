@@ -5,6 +5,7 @@ import (
 	"github.com/iost-official/go-iost/consensus"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/global"
+	"github.com/iost-official/go-iost/core/pruner"
 	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/core/txpool"
 	"github.com/iost-official/go-iost/ilog"
@@ -26,11 +27,15 @@ type IServer struct {
 	rpcServer *rpc.Server
 	consensus consensus.Consensus
 	debug     *DebugServer
+	pruner    *pruner.Pruner
 }
 
 // New returns a iserver application
 func New(conf *common.Config) *IServer {
 	tx.ChainID = conf.P2P.ChainID
+	if conf.Version != nil && conf.Version.ReplacementHeight > 0 {
+		tx.ReplacementHeight = conf.Version.ReplacementHeight
+	}
 
 	bv, err := global.New(conf)
 	if err != nil {
@@ -64,6 +69,14 @@ func New(conf *common.Config) *IServer {
 
 	debug := NewDebugServer(conf.Debug, p2pService, blkCache, bv.BlockChain())
 
+	var p *pruner.Pruner
+	if conf.DB.Prune != nil && conf.DB.Prune.Enable {
+		p, err = pruner.New(bv.BlockChain(), bv.StateDB(), conf.DB)
+		if err != nil {
+			ilog.Fatalf("pruner initialization failed, stop the program! err:%v", err)
+		}
+	}
+
 	return &IServer{
 		bv:        bv,
 		p2p:       p2pService,
@@ -71,6 +84,7 @@ func New(conf *common.Config) *IServer {
 		rpcServer: rpcServer,
 		consensus: consensus,
 		debug:     debug,
+		pruner:    p,
 	}
 }
 
@@ -93,6 +107,11 @@ func (s *IServer) Start() error {
 			return err
 		}
 	}
+	if s.pruner != nil {
+		if err := s.pruner.Start(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -102,6 +121,9 @@ func (s *IServer) Stop() {
 	if conf.Debug != nil {
 		s.debug.Stop()
 	}
+	if s.pruner != nil {
+		s.pruner.Stop()
+	}
 	Services := []Service{
 		s.rpcServer,
 		s.consensus,
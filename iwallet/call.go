@@ -13,7 +13,8 @@ var callCmd = &cobra.Command{
 	Long: `Call the method in contracts
 	Would accept arguments as call actions or load transaction request directly from given file (which could be generated by "save" command).
 	An ACTION is a group of 3 arguments: contract name, function name, method parameters.
-	The method parameters should be a string with format '["arg0","arg1",...]'.`,
+	The method parameters should be a string with format '["arg0","arg1",...]'.
+	The parameters are checked and encoded against the abi on chain before the transaction is sent.`,
 	Example: `  iwallet call "token.iost" "transfer" '["iost","user0001","user0002","123.45",""]' --account test0
   iwallet call "token.iost" "transfer" '["iost","user0001","user0002","123.45",""]' --output tx.json`,
 	Args: func(cmd *cobra.Command, args []string) error {
@@ -28,6 +29,11 @@ var callCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
+		if outputTxFile == "" {
+			if err := encodeActions(actions); err != nil {
+				return err
+			}
+		}
 		tx, err := initTxFromActions(actions)
 		if err != nil {
 			return err
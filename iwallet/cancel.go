@@ -0,0 +1,37 @@
+package iwallet
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// cancelCmd represents the cancel command.
+var cancelCmd = &cobra.Command{
+	Use:   "cancel transactionHash",
+	Short: "Cancel a pending transaction",
+	Long: `Cancel a pending transaction by sending a replacement without actions.
+	The replacement is published by the same account with a higher gas ratio, which is the gas ratio of
+	the pending transaction plus 1 unless --gas_ratio is set.`,
+	Example: `  iwallet cancel 7MDfKBeZToQnnfNHD58cbZ7o4Y2AktKLmiEg776HLPBT --account test0
+  iwallet cancel 7MDfKBeZToQnnfNHD58cbZ7o4Y2AktKLmiEg776HLPBT --account test0 --gas_ratio 5`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := checkArgsNumber(cmd, args, "transactionHash"); err != nil {
+			return err
+		}
+		return checkAccount(cmd)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := InitAccount(); err != nil {
+			return err
+		}
+		ratio := 0.0
+		if rootCmd.PersistentFlags().Changed("gas_ratio") {
+			ratio = gasRatio
+		}
+		_, err := iwalletSDK.CancelTx(args[0], ratio)
+		return err
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(cancelCmd)
+}
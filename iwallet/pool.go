@@ -0,0 +1,86 @@
+package iwallet
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/iost-official/go-iost/sdk"
+)
+
+var (
+	poolPublisher string
+	poolContract  string
+	poolCursor    string
+	poolLimit     int32
+)
+
+// poolCmd represents the pool command.
+var poolCmd = &cobra.Command{
+	Use:   "pool",
+	Short: "Transaction pool inspector",
+	Long:  `Inspect the pending transactions in transaction pool of the node`,
+}
+
+var poolListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List pending transactions",
+	Long: `List pending transactions in the packing order, which starts from the highest gas ratio.
+	Use the cursor in the output as --cursor to get the next page.`,
+	Example: `  iwallet pool list
+  iwallet pool list --publisher test0 --limit 10
+  iwallet pool list --contract token.iost --cursor 7MDfKBeZToQnnfNHD58cbZ7o4Y2AktKLmiEg776HLPBT`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		txs, err := iwalletSDK.GetPendingTxs(poolPublisher, poolContract, poolCursor, poolLimit)
+		if err != nil {
+			return err
+		}
+		fmt.Println(sdk.MarshalTextString(txs))
+		return nil
+	},
+}
+
+var poolStatsCmd = &cobra.Command{
+	Use:     "stats",
+	Short:   "Show transaction pool stats",
+	Long:    `Show the size, the gas ratio histogram and the oldest transaction of transaction pool`,
+	Example: `  iwallet pool stats`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		stats, err := iwalletSDK.GetTxPoolStats()
+		if err != nil {
+			return err
+		}
+		fmt.Println(sdk.MarshalTextString(stats))
+		return nil
+	},
+}
+
+var poolStatusCmd = &cobra.Command{
+	Use:   "status transactionHash",
+	Short: "Verify a pending transaction",
+	Long: `Verify a pending transaction again, and show whether it can still be packed with its position
+	in the packing order and its lifecycle in transaction pool`,
+	Example: `  iwallet pool status 7MDfKBeZToQnnfNHD58cbZ7o4Y2AktKLmiEg776HLPBT`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		return checkArgsNumber(cmd, args, "transactionHash")
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		status, err := iwalletSDK.GetPendingTxStatus(args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Println(sdk.MarshalTextString(status))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(poolCmd)
+	poolCmd.AddCommand(poolListCmd)
+	poolListCmd.Flags().StringVarP(&poolPublisher, "publisher", "", "", "only list the transactions published by the account")
+	poolListCmd.Flags().StringVarP(&poolContract, "contract", "", "", "only list the transactions calling the contract")
+	poolListCmd.Flags().StringVarP(&poolCursor, "cursor", "", "", "the cursor returned by the previous page")
+	poolListCmd.Flags().Int32VarP(&poolLimit, "limit", "", 50, "the max count of transactions to list, at most 100")
+	poolCmd.AddCommand(poolStatsCmd)
+	poolCmd.AddCommand(poolStatusCmd)
+}
@@ -78,6 +78,7 @@ func init() {
 	rootCmd.PersistentFlags().StringSliceVarP(&signers, "signers", "", []string{}, "additional signers")
 	rootCmd.PersistentFlags().Float64VarP(&gasLimit, "gas_limit", "l", 1000000, "gas limit for a transaction")
 	rootCmd.PersistentFlags().Float64VarP(&gasRatio, "gas_ratio", "p", 1.0, "gas ratio for a transaction")
+	rootCmd.PersistentFlags().BoolVarP(&estimateGas, "estimate", "", false, "fill gas limit and gas ratio not set by flags with the estimation of the server")
 	rootCmd.PersistentFlags().StringVarP(&amountLimit, "amount_limit", "", "*:unlimited", "amount limit for one transaction, eg iost:300.00|ram:2000")
 	rootCmd.PersistentFlags().Int64VarP(&expiration, "expiration", "e", 90, "expiration time for a transaction in seconds")
 	rootCmd.PersistentFlags().Uint32VarP(&chainID, "chain_id", "", uint32(1024), "chain id which distinguishes different network")
@@ -87,6 +88,7 @@ func init() {
 	rootCmd.PersistentFlags().StringSliceVarP(&signKeyFiles, "sign_key_files", "", []string{}, "optional private key files used for signing, split by comma")
 	rootCmd.PersistentFlags().StringSliceVarP(&signatureFiles, "signature_files", "", []string{}, "optional signature files, split by comma")
 	rootCmd.PersistentFlags().StringVarP(&outputTxFile, "output", "o", "", "output json file to save transaction request")
+	rootCmd.PersistentFlags().StringVarP(&replaceTx, "replace_tx", "", "", "hash of the pending transaction to replace, the gas ratio should be higher than it")
 
 	// Cobra also supports local flags, which will only run
 	// when this action is called directly.
@@ -131,11 +133,13 @@ var (
 
 	gasLimit     float64
 	gasRatio     float64
+	estimateGas  bool
 	expiration   int64
 	amountLimit  string
 	delaySecond  int64
 	txTime       string
 	txTimeDelay  uint32
+	replaceTx    string
 	outputTxFile string
 
 	// Used for multi sig.
@@ -0,0 +1,36 @@
+package iwallet
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/iost-official/go-iost/sdk"
+)
+
+// txStatusCmd represents the txstatus command.
+var txStatusCmd = &cobra.Command{
+	Use:   "txstatus transactionHash",
+	Short: "Find transaction status",
+	Long: `Find transaction status by transaction hash, including its lifecycle in transaction pool,
+which shows the reason if the transaction is removed without being packed`,
+	Example: `  iwallet txstatus 7MDfKBeZToQnnfNHD58cbZ7o4Y2AktKLmiEg776HLPBT`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := checkArgsNumber(cmd, args, "transactionHash"); err != nil {
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		status, err := iwalletSDK.GetTxStatus(args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Println(sdk.MarshalTextString(status))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(txStatusCmd)
+}
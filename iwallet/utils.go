@@ -2,6 +2,7 @@ package iwallet
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/bitly/go-simplejson"
 	"io/ioutil"
@@ -12,8 +13,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/mitchellh/go-homedir"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/iost-official/go-iost/account"
 	"github.com/iost-official/go-iost/common"
@@ -92,6 +96,7 @@ func initTxFromActions(actions []*rpcpb.Action) (*rpcpb.TransactionRequest, erro
 		return nil, err
 	}
 	tx.Signers = signers
+	tx.ReplacedTx = replaceTx
 
 	return tx, nil
 }
@@ -131,9 +136,6 @@ func sendTxGetHash(tx *rpcpb.TransactionRequest) (string, error) {
 	if err := InitAccount(); err != nil {
 		return "", err
 	}
-	if err := handleMultiSig(tx, signatureFiles, signKeyFiles); err != nil {
-		return "", err
-	}
 	if err := checkTxTime(tx); err != nil {
 		return "", err
 	}
@@ -143,9 +145,57 @@ func sendTxGetHash(tx *rpcpb.TransactionRequest) (string, error) {
 		}
 		defer iwalletSDK.CloseConn()
 	}
+	if estimateGas {
+		if err := estimateTx(tx); err != nil {
+			return "", err
+		}
+	}
+	if err := handleMultiSig(tx, signatureFiles, signKeyFiles); err != nil {
+		return "", err
+	}
 	return iwalletSDK.SendTx(tx)
 }
 
+// estimateTx fills the gas limit and gas ratio of tx which are not set by flags with the estimation of the server
+func estimateTx(tx *rpcpb.TransactionRequest) error {
+	if len(signatureFiles) > 0 {
+		return fmt.Errorf("can not set flags --estimate and --signature_files simultaneously")
+	}
+	// the server only checks the public keys of signatures, so the tx is signed again after filled
+	t := proto.Clone(tx).(*rpcpb.TransactionRequest)
+	if err := handleMultiSig(t, signatureFiles, signKeyFiles); err != nil {
+		return err
+	}
+	t, err := iwalletSDK.SignTx(t, signAlgo)
+	if err != nil {
+		return fmt.Errorf("sign tx error %v", err)
+	}
+	r, err := iwalletSDK.EstimateTransaction(t)
+	if err != nil {
+		return fmt.Errorf("estimate tx error %v", err)
+	}
+	if verbose {
+		fmt.Println("Estimation:")
+		fmt.Println(sdk.MarshalTextString(r))
+	}
+	if r.TxReceipt.StatusCode != rpcpb.TxReceipt_SUCCESS {
+		return fmt.Errorf("transaction will fail: %v", r.TxReceipt.Message)
+	}
+	if !rootCmd.PersistentFlags().Changed("gas_limit") {
+		tx.GasLimit = r.GasLimit
+	}
+	if !rootCmd.PersistentFlags().Changed("gas_ratio") {
+		tx.GasRatio = r.GasRatio
+	}
+	if r.PublisherGas < tx.GasLimit {
+		fmt.Printf("Warning: %v has %v gas, less than the gas limit %v\n", accountName, r.PublisherGas, tx.GasLimit)
+	}
+	if !r.RamEnough {
+		fmt.Printf("Warning: %v has %v bytes ram available, less than the usage %v\n", accountName, r.PublisherRam, r.RamUsage[accountName])
+	}
+	return nil
+}
+
 func sendTx(tx *rpcpb.TransactionRequest) error {
 	_, err := sendTxGetHash(tx)
 	return err
@@ -334,6 +384,29 @@ func actionsFromFlags(args []string) ([]*rpcpb.Action, error) {
 	return actions, nil
 }
 
+// encodeActions validates the args of actions against the abis on chain, and replaces the data with the encoded one.
+// It is skipped if the node does not support EncodeAction.
+func encodeActions(actions []*rpcpb.Action) error {
+	for _, a := range actions {
+		resp, err := iwalletSDK.EncodeActionData(a.Contract, a.ActionName, a.Data)
+		if status.Code(err) == codes.Unimplemented {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to encode action %v/%v: %v", a.Contract, a.ActionName, err)
+		}
+		if len(resp.Errors) > 0 {
+			msg := fmt.Sprintf("invalid args of %v/%v, need (%v):", a.Contract, a.ActionName, strings.Join(resp.ArgTypes, ", "))
+			for _, e := range resp.Errors {
+				msg += fmt.Sprintf("\n  arg %v (%v): %v", e.Index, e.Type, e.Error)
+			}
+			return errors.New(msg)
+		}
+		a.Data = resp.Action.Data
+	}
+	return nil
+}
+
 func handleMultiSig(tx *rpcpb.TransactionRequest, signatureFiles []string, signKeyFiles []string) error {
 	if len(signatureFiles) == 0 && len(signKeyFiles) == 0 {
 		return nil
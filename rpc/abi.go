@@ -0,0 +1,109 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/iost-official/go-iost/core/contract"
+	"github.com/iost-official/go-iost/rpc/pb"
+	"github.com/iost-official/go-iost/vm/database"
+)
+
+// abiReader reads the abis of contracts from db, each contract is read only once.
+type abiReader struct {
+	db        *database.Visitor
+	contracts map[string]*contract.Contract
+}
+
+func newABIReader(db *database.Visitor) *abiReader {
+	return &abiReader{
+		db:        db,
+		contracts: make(map[string]*contract.Contract),
+	}
+}
+
+func (r *abiReader) abi(contractID, actionName string) (*contract.ABI, error) {
+	c, ok := r.contracts[contractID]
+	if !ok {
+		c = r.db.Contract(contractID)
+		if c != nil && c.Info == nil {
+			c = nil
+		}
+		r.contracts[contractID] = c
+	}
+	if c == nil {
+		return nil, fmt.Errorf("contract %v not found", contractID)
+	}
+	abi := c.ABI(actionName)
+	if abi == nil {
+		return nil, fmt.Errorf("abi %v not found in contract %v", actionName, contractID)
+	}
+	return abi, nil
+}
+
+// decodeCall decodes the action data or receipt content against the abi.
+func (r *abiReader) decodeCall(contractID, actionName, data string) *rpcpb.DecodedCall {
+	ret := &rpcpb.DecodedCall{
+		Contract:   contractID,
+		ActionName: actionName,
+		Raw:        data,
+	}
+	abi, err := r.abi(contractID, actionName)
+	if err != nil {
+		ret.Error = err.Error()
+		return ret
+	}
+	ret.ArgTypes = abi.Args
+	args, argErrs, err := abi.DecodeArgs(data)
+	if err != nil {
+		ret.Error = err.Error()
+		return ret
+	}
+	ret.Args = args
+	ret.ArgErrors = toPbArgErrors(argErrs)
+	return ret
+}
+
+// decodeReceipt decodes the receipt whose func name is "contract/action".
+func (r *abiReader) decodeReceipt(funcName, content string) *rpcpb.DecodedCall {
+	idx := strings.LastIndex(funcName, "/")
+	if idx < 0 {
+		return &rpcpb.DecodedCall{
+			Contract: funcName,
+			Raw:      content,
+			Error:    fmt.Sprintf("invalid func name %v", funcName),
+		}
+	}
+	return r.decodeCall(funcName[:idx], funcName[idx+1:], content)
+}
+
+func toPbArgErrors(errs []*contract.ArgError) []*rpcpb.ArgError {
+	ret := make([]*rpcpb.ArgError, 0, len(errs))
+	for _, e := range errs {
+		ret = append(ret, &rpcpb.ArgError{
+			Index: int32(e.Index),
+			Type:  e.Type,
+			Error: e.Err.Error(),
+		})
+	}
+	return ret
+}
+
+// decodeReturns renders the json array of action returns, a string is rendered as it is, the others are in json.
+func decodeReturns(ret string) ([]string, error) {
+	var values []json.RawMessage
+	if err := json.Unmarshal([]byte(ret), &values); err != nil {
+		return nil, err
+	}
+	rendered := make([]string, 0, len(values))
+	for _, v := range values {
+		var s string
+		if err := json.Unmarshal(v, &s); err == nil {
+			rendered = append(rendered, s)
+			continue
+		}
+		rendered = append(rendered, string(v))
+	}
+	return rendered, nil
+}
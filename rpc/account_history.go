@@ -0,0 +1,116 @@
+package rpc
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/rpc/pb"
+	"github.com/iost-official/go-iost/vm/database"
+)
+
+// the max count of blocks read in GetAccountHistory and GetAccountUsage
+const maxHistoryBlocks = 1000
+
+// the token bought from ram.iost
+const ramToken = "ram"
+
+// accountBlockHistory returns the resource usage and balance changes of the account in the block,
+// or nil if the account is not involved. The balance changes are parsed from the receipts of token.iost.
+func accountBlockHistory(name string, blk *block.Block) *rpcpb.AccountBlockHistory {
+	h := &rpcpb.AccountBlockHistory{
+		BlockNumber:  blk.Head.Number,
+		Time:         blk.Head.Time,
+		TokenChanges: make(map[string]float64),
+	}
+	involved := false
+	for i, r := range blk.Receipts {
+		if i < len(blk.Txs) && blk.Txs[i].Publisher == name {
+			involved = true
+			h.TxCount++
+			h.GasUsage += float64(r.GasUsage) / 100
+		}
+		if ram, ok := r.RAMUsage[name]; ok {
+			involved = true
+			h.RamUsage += ram
+		}
+		for _, rec := range r.Receipts {
+			if !strings.HasPrefix(rec.FuncName, database.TokenContractName+"/") {
+				continue
+			}
+			token, from, to, amount, ok := parseTokenReceipt(rec.FuncName[len(database.TokenContractName)+1:], rec.Content)
+			if !ok || (from != name && to != name) || from == to {
+				continue
+			}
+			involved = true
+			if from == name {
+				h.TokenChanges[token] -= amount
+			}
+			if to == name {
+				h.TokenChanges[token] += amount
+				if token == ramToken && from == database.RAMContractName {
+					h.RamBought += int64(amount)
+				}
+			}
+		}
+	}
+	if !involved {
+		return nil
+	}
+	return h
+}
+
+// parseTokenReceipt returns the token, the account decreased, the account increased and the amount of the token.iost receipt.
+func parseTokenReceipt(action string, content string) (token, from, to string, amount float64, ok bool) {
+	var args []interface{}
+	if err := json.Unmarshal([]byte(content), &args); err != nil {
+		return
+	}
+	var amountIdx int
+	switch action {
+	case "transfer", "transferFreeze":
+		if len(args) < 4 {
+			return
+		}
+		from, _ = args[1].(string)
+		to, _ = args[2].(string)
+		amountIdx = 3
+	case "issue":
+		if len(args) < 3 {
+			return
+		}
+		to, _ = args[1].(string)
+		amountIdx = 2
+	case "destroy":
+		if len(args) < 3 {
+			return
+		}
+		from, _ = args[1].(string)
+		amountIdx = 2
+	default:
+		return
+	}
+	token, _ = args[0].(string)
+	amountStr, _ := args[amountIdx].(string)
+	amount, err := strconv.ParseFloat(amountStr, 64)
+	if err != nil || token == "" {
+		return
+	}
+	return token, from, to, amount, true
+}
+
+// addAccountUsage adds the block history to the usage.
+func addAccountUsage(u *rpcpb.AccountUsageResponse, h *rpcpb.AccountBlockHistory) {
+	u.BlockCount++
+	u.TxCount += h.TxCount
+	u.GasUsage += h.GasUsage
+	if h.GasUsage > u.MaxBlockGasUsage {
+		u.MaxBlockGasUsage = h.GasUsage
+	}
+	u.RamUsage += h.RamUsage
+	u.RamBought += h.RamBought
+	for token, amount := range h.TokenChanges {
+		u.TokenChanges[token] += amount
+	}
+}
@@ -0,0 +1,113 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/rpc/pb"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseTokenReceipt(t *testing.T) {
+	Convey("test parse token receipt", t, func() {
+		for _, c := range []struct {
+			action  string
+			content string
+			token   string
+			from    string
+			to      string
+			amount  float64
+			ok      bool
+		}{
+			{"transfer", `["iost","alice","bob","10.5",""]`, "iost", "alice", "bob", 10.5, true},
+			{"transferFreeze", `["iost","alice","bob","3","1500000000",""]`, "iost", "alice", "bob", 3, true},
+			{"issue", `["iost","alice","100"]`, "iost", "", "alice", 100, true},
+			{"destroy", `["iost","alice","2.5"]`, "iost", "alice", "", 2.5, true},
+			{"transfer", `["iost","alice","alice","1",""]`, "iost", "alice", "alice", 1, true},
+			{"transfer", `["iost","alice","bob"]`, "", "", "", 0, false},
+			{"transfer", `["iost","alice","bob","x",""]`, "", "", "", 0, false},
+			{"transfer", `["","alice","bob","1",""]`, "", "", "", 0, false},
+			{"transfer", `not json`, "", "", "", 0, false},
+			{"create", `["iost","admin","100",{}]`, "", "", "", 0, false},
+		} {
+			token, from, to, amount, ok := parseTokenReceipt(c.action, c.content)
+			So(ok, ShouldEqual, c.ok)
+			if c.ok {
+				So(token, ShouldEqual, c.token)
+				So(from, ShouldEqual, c.from)
+				So(to, ShouldEqual, c.to)
+				So(amount, ShouldEqual, c.amount)
+			}
+		}
+	})
+}
+
+func genHistoryReceipt(publisher string, gasUsage int64, ram map[string]int64, receipts ...*tx.Receipt) (*tx.Tx, *tx.TxReceipt) {
+	t := tx.NewTx(nil, nil, 1000000, 100, 0, 0, 0)
+	t.Publisher = publisher
+	r := tx.NewTxReceipt(t.Hash())
+	r.GasUsage = gasUsage
+	for k, v := range ram {
+		r.RAMUsage[k] = v
+	}
+	r.Receipts = receipts
+	return t, r
+}
+
+func TestAccountBlockHistory(t *testing.T) {
+	Convey("test account block history", t, func() {
+		blk := &block.Block{
+			Head: &block.BlockHead{Number: 10, Time: 1000},
+		}
+		add := func(t *tx.Tx, r *tx.TxReceipt) {
+			blk.Txs = append(blk.Txs, t)
+			blk.Receipts = append(blk.Receipts, r)
+		}
+		// transfer
+		add(genHistoryReceipt("alice", 300, nil,
+			&tx.Receipt{FuncName: "token.iost/transfer", Content: `["iost","alice","bob","10",""]`}))
+		// issue
+		add(genHistoryReceipt("admin", 100, nil,
+			&tx.Receipt{FuncName: "token.iost/issue", Content: `["iost","alice","5"]`}))
+		// destroy
+		add(genHistoryReceipt("alice", 100, nil,
+			&tx.Receipt{FuncName: "token.iost/destroy", Content: `["iost","alice","1.5"]`}))
+		// self transfer
+		add(genHistoryReceipt("bob", 100, nil,
+			&tx.Receipt{FuncName: "token.iost/transfer", Content: `["iost","bob","bob","7",""]`}))
+		// ram buy
+		add(genHistoryReceipt("alice", 200, map[string]int64{"alice": 30},
+			&tx.Receipt{FuncName: "token.iost/transfer", Content: `["iost","alice","ram.iost","2",""]`},
+			&tx.Receipt{FuncName: "token.iost/transfer", Content: `["ram","ram.iost","alice","1024",""]`},
+			&tx.Receipt{FuncName: "ram.iost/buy", Content: `["alice","alice",1024]`}))
+
+		h := accountBlockHistory("alice", blk)
+		So(h, ShouldNotBeNil)
+		So(h.BlockNumber, ShouldEqual, 10)
+		So(h.Time, ShouldEqual, 1000)
+		So(h.TxCount, ShouldEqual, 3)
+		So(h.GasUsage, ShouldEqual, 6)
+		So(h.RamUsage, ShouldEqual, 30)
+		So(h.RamBought, ShouldEqual, 1024)
+		So(h.TokenChanges, ShouldResemble, map[string]float64{"iost": -10 + 5 - 1.5 - 2, "ram": 1024})
+
+		h = accountBlockHistory("bob", blk)
+		So(h, ShouldNotBeNil)
+		So(h.TxCount, ShouldEqual, 1)
+		So(h.GasUsage, ShouldEqual, 1)
+		So(h.TokenChanges, ShouldResemble, map[string]float64{"iost": 10})
+
+		So(accountBlockHistory("carol", blk), ShouldBeNil)
+
+		u := &rpcpb.AccountUsageResponse{TokenChanges: make(map[string]float64)}
+		addAccountUsage(u, accountBlockHistory("alice", blk))
+		addAccountUsage(u, accountBlockHistory("alice", blk))
+		So(u.BlockCount, ShouldEqual, 2)
+		So(u.TxCount, ShouldEqual, 6)
+		So(u.GasUsage, ShouldEqual, 12)
+		So(u.MaxBlockGasUsage, ShouldEqual, 6)
+		So(u.RamBought, ShouldEqual, 2048)
+		So(u.TokenChanges["iost"], ShouldEqual, -17)
+	})
+}
@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -9,6 +10,7 @@ import (
 	"reflect"
 	"sort"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	simplejson "github.com/bitly/go-simplejson"
@@ -29,8 +31,32 @@ import (
 	"github.com/iost-official/go-iost/verifier"
 	"github.com/iost-official/go-iost/vm/database"
 	"github.com/iost-official/go-iost/vm/host"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// the max count of transactions of a page in GetTxsByAccount and GetTxsByContract
+const maxTxsPageSize = 100
+
+// the max count of blocks or txs in GetBlocksByRange and GetTxReceiptsByHashes if not configured
+const defaultMaxBatchSize = 100
+
+// the count of irreversible blocks read at a time in GetBlocksByRange
+const blocksReadSize = 10
+
+// EstimateTransaction suggests the gas limit with the margin of gas usage, and the gas ratio from the recent blocks
+const (
+	estimateGasMargin = 1.2
+	gasRatioBlocks    = 10
+)
+
+// lastSubscriberID is the id of the last subscriber of event collector, the ids are never reused
+var lastSubscriberID int64
+
+func nextSubscriberID() int64 {
+	return atomic.AddInt64(&lastSubscriberID, 1)
+}
+
 //go:generate mockgen -destination mock_rpc/mock_api.go -package main github.com/iost-official/go-iost/rpc/pb ApiServiceServer
 
 // APIService implements all rpc APIs.
@@ -102,6 +128,10 @@ func (as *APIService) GetChainInfo(context.Context, *rpcpb.EmptyRequest) (*rpcpb
 		netName = as.bv.Config().Version.NetName
 		version = as.bv.Config().Version.ProtocolVersion
 	}
+	var libStateRoot string
+	if tag, root, err := as.bv.StateDB().StateRoot(); err == nil && tag == string(lib.HeadHash()) {
+		libStateRoot = common.Base58Encode(root)
+	}
 	return &rpcpb.ChainInfoResponse{
 		NetName:            netName,
 		ProtocolVersion:    version,
@@ -115,6 +145,7 @@ func (as *APIService) GetChainInfo(context.Context, *rpcpb.EmptyRequest) (*rpcpb
 		LibBlockHash:       common.Base58Encode(lib.HeadHash()),
 		HeadBlockTime:      head.Head.Time,
 		LibBlockTime:       lib.Head.Time,
+		LibStateRoot:       libStateRoot,
 	}, nil
 }
 
@@ -168,6 +199,233 @@ func (as *APIService) GetTxReceiptByTxHash(ctx context.Context, req *rpcpb.TxHas
 	return toPbTxReceipt(receipt), nil
 }
 
+// GetTxStatus returns the status of the transaction and its lifecycle in txpool, so the reason is known
+// if the transaction is removed from txpool without being packed.
+func (as *APIService) GetTxStatus(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.TxStatusResponse, error) {
+	txHashBytes := common.Base58Decode(req.GetHash())
+	ret := &rpcpb.TxStatusResponse{
+		Status:      rpcpb.TxStatusResponse_REMOVED,
+		BlockNumber: -1,
+	}
+	record, recordErr := as.txpool.GetTxRecord(txHashBytes)
+	if recordErr == nil {
+		for _, e := range record.Events {
+			ret.Events = append(ret.Events, &rpcpb.TxEvent{
+				Type:    rpcpb.TxEvent_Type(e.Type),
+				Time:    e.Time,
+				Message: e.Message,
+			})
+		}
+	}
+	if number, err := as.blockchain.GetBlockNumberByTxHash(txHashBytes); err == nil {
+		ret.Status = rpcpb.TxStatusResponse_IRREVERSIBLE
+		ret.BlockNumber = number
+	} else if _, _, err := as.txpool.GetFromChain(txHashBytes); err == nil {
+		ret.Status = rpcpb.TxStatusResponse_PACKED
+	} else if _, err := as.txpool.GetFromPending(txHashBytes); err == nil {
+		ret.Status = rpcpb.TxStatusResponse_PENDING
+	} else if recordErr != nil {
+		return nil, errors.New("tx not found")
+	}
+	return ret, nil
+}
+
+// GetPendingTxs returns the pending transactions in the packing order, which can be filtered by publisher or contract.
+func (as *APIService) GetPendingTxs(ctx context.Context, req *rpcpb.GetPendingTxsRequest) (*rpcpb.GetPendingTxsResponse, error) {
+	match := func(t *tx.Tx) bool {
+		if req.GetPublisher() != "" && t.Publisher != req.GetPublisher() {
+			return false
+		}
+		if req.GetContract() == "" {
+			return true
+		}
+		for _, a := range t.Actions {
+			if a.Contract == req.GetContract() {
+				return true
+			}
+		}
+		return false
+	}
+	var cursor []byte
+	if req.GetCursor() != "" {
+		cursor = common.Base58Decode(req.GetCursor())
+	}
+	limit := txsPageSize(req.GetLimit())
+	ret := &rpcpb.GetPendingTxsResponse{
+		Transactions: make([]*rpcpb.Transaction, 0),
+	}
+	pending, _ := as.txpool.PendingTx()
+	started := cursor == nil
+	// one more tx than limit is taken to know whether there is a next page
+	txs := make([]*tx.Tx, 0, limit+1)
+	iter := pending.Iter()
+	for t, ok := iter.Next(); ok; t, ok = iter.Next() {
+		if !match(t) {
+			continue
+		}
+		ret.Total++
+		if !started {
+			started = bytes.Equal(t.Hash(), cursor)
+			continue
+		}
+		if len(txs) <= limit {
+			txs = append(txs, t)
+		}
+	}
+	if !started {
+		return nil, fmt.Errorf("cursor tx %v is not pending", req.GetCursor())
+	}
+	if len(txs) > limit {
+		txs = txs[:limit]
+		ret.Cursor = common.Base58Encode(txs[limit-1].Hash())
+	}
+	for _, t := range txs {
+		ret.Transactions = append(ret.Transactions, toPbTx(t, nil))
+	}
+	return ret, nil
+}
+
+// the bounds of the gas ratio histogram in GetTxPoolStats
+var gasRatioBuckets = []float64{1, 2, 5, 10, 20, 50, 100}
+
+// GetTxPoolStats returns the size, the gas ratio histogram and the oldest transaction of txpool.
+func (as *APIService) GetTxPoolStats(ctx context.Context, req *rpcpb.EmptyRequest) (*rpcpb.TxPoolStatsResponse, error) {
+	ret := &rpcpb.TxPoolStatsResponse{
+		GasRatioHistogram: make([]*rpcpb.TxPoolStatsResponse_GasRatioBucket, 0, len(gasRatioBuckets)+1),
+	}
+	for i, min := range gasRatioBuckets {
+		max := math.Inf(1)
+		if i+1 < len(gasRatioBuckets) {
+			max = gasRatioBuckets[i+1]
+		}
+		ret.GasRatioHistogram = append(ret.GasRatioHistogram, &rpcpb.TxPoolStatsResponse_GasRatioBucket{
+			MinGasRatio: min,
+			MaxGasRatio: max,
+		})
+	}
+	publishers := make(map[string]bool)
+	var oldest *tx.Tx
+	pending, _ := as.txpool.PendingTx()
+	iter := pending.Iter()
+	for t, ok := iter.Next(); ok; t, ok = iter.Next() {
+		ret.Size++
+		publishers[t.Publisher] = true
+		if oldest == nil || t.Time < oldest.Time {
+			oldest = t
+		}
+		gasRatio := float64(t.GasRatio) / 100
+		for i := len(ret.GasRatioHistogram) - 1; i >= 0; i-- {
+			if gasRatio >= ret.GasRatioHistogram[i].MinGasRatio {
+				ret.GasRatioHistogram[i].Count++
+				break
+			}
+		}
+	}
+	ret.PublisherCount = int64(len(publishers))
+	if oldest != nil {
+		ret.OldestTxHash = common.Base58Encode(oldest.Hash())
+		ret.OldestTxAge = time.Now().UnixNano() - oldest.Time
+	}
+	return ret, nil
+}
+
+// GetPendingTxStatus verifies the pending transaction again, and returns whether it can still be packed
+// with its position in the packing order.
+func (as *APIService) GetPendingTxStatus(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.PendingTxStatusResponse, error) {
+	txHashBytes := common.Base58Decode(req.GetHash())
+	pending, _ := as.txpool.PendingTx()
+	ret := &rpcpb.PendingTxStatusResponse{
+		Rank: -1,
+	}
+	var t *tx.Tx
+	iter := pending.Iter()
+	for pt, ok := iter.Next(); ok; pt, ok = iter.Next() {
+		ret.Rank++
+		if bytes.Equal(pt.Hash(), txHashBytes) {
+			t = pt
+			break
+		}
+	}
+	if t == nil {
+		return nil, errors.New("tx not pending")
+	}
+	ret.Transaction = toPbTx(t, nil)
+	now := time.Now().UnixNano()
+	ret.ExpireIn = t.Expiration - now
+	if t.IsExpired(now) {
+		ret.Error = "tx expired"
+	} else if err := t.VerifySelf(); err != nil {
+		ret.Error = err.Error()
+	} else if _, _, err := as.txpool.GetFromChain(t.Hash()); err == nil {
+		ret.Error = "tx already packed"
+	} else if as.txpool.ExistReplacement(t, nil) {
+		ret.Error = "tx replaced or its replaced tx already packed"
+	}
+	ret.Valid = ret.Error == ""
+	if record, err := as.txpool.GetTxRecord(txHashBytes); err == nil {
+		for _, e := range record.Events {
+			ret.Events = append(ret.Events, &rpcpb.TxEvent{
+				Type:    rpcpb.TxEvent_Type(e.Type),
+				Time:    e.Time,
+				Message: e.Message,
+			})
+		}
+	}
+	return ret, nil
+}
+
+// GetTxsByAccount returns the irreversible transactions published or signed by the account.
+func (as *APIService) GetTxsByAccount(ctx context.Context, req *rpcpb.GetTxsByAccountRequest) (*rpcpb.GetTxsResponse, error) {
+	var role block.TxRole
+	switch req.GetRole() {
+	case rpcpb.GetTxsByAccountRequest_PUBLISHER:
+		role = block.TxRolePublisher
+	case rpcpb.GetTxsByAccountRequest_SIGNER:
+		role = block.TxRoleSigner
+	}
+	limit := txsPageSize(req.GetLimit())
+	txs, err := as.blockchain.GetTxsByAccount(req.GetAccount(), role, common.Base58Decode(req.GetCursor()), req.GetReverse(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return as.toTxsResponse(txs, limit), nil
+}
+
+// GetTxsByContract returns the irreversible transactions calling the contract.
+func (as *APIService) GetTxsByContract(ctx context.Context, req *rpcpb.GetTxsByContractRequest) (*rpcpb.GetTxsResponse, error) {
+	limit := txsPageSize(req.GetLimit())
+	txs, err := as.blockchain.GetTxsByContract(req.GetContract(), req.GetActionName(), common.Base58Decode(req.GetCursor()), req.GetReverse(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return as.toTxsResponse(txs, limit), nil
+}
+
+func txsPageSize(limit int32) int {
+	if limit <= 0 || limit > maxTxsPageSize {
+		return maxTxsPageSize
+	}
+	return int(limit)
+}
+
+func (as *APIService) toTxsResponse(txs []*block.IndexedTx, limit int) *rpcpb.GetTxsResponse {
+	ret := &rpcpb.GetTxsResponse{
+		Transactions: make([]*rpcpb.TransactionResponse, 0, len(txs)),
+		IndexFrom:    as.blockchain.TxIndexFrom(),
+	}
+	for _, t := range txs {
+		ret.Transactions = append(ret.Transactions, &rpcpb.TransactionResponse{
+			Status:      rpcpb.TransactionResponse_IRREVERSIBLE,
+			Transaction: toPbTx(t.Tx, t.Receipt),
+			BlockNumber: t.BlockNumber,
+		})
+	}
+	if len(txs) == limit {
+		ret.Cursor = common.Base58Encode(txs[len(txs)-1].Cursor)
+	}
+	return ret
+}
+
 // GetBlockByHash returns block corresponding to the given hash.
 func (as *APIService) GetBlockByHash(ctx context.Context, req *rpcpb.GetBlockByHashRequest) (*rpcpb.BlockResponse, error) {
 	hashBytes := common.Base58Decode(req.GetHash())
@@ -177,6 +435,9 @@ func (as *APIService) GetBlockByHash(ctx context.Context, req *rpcpb.GetBlockByH
 	)
 	status := rpcpb.BlockResponse_IRREVERSIBLE
 	blk, err = as.blockchain.GetBlockByHash(hashBytes)
+	if err == block.ErrBlockPruned {
+		return nil, err
+	}
 	if err != nil {
 		status = rpcpb.BlockResponse_PENDING
 		blk, err = as.bc.GetBlockByHash(hashBytes)
@@ -199,6 +460,9 @@ func (as *APIService) GetBlockByNumber(ctx context.Context, req *rpcpb.GetBlockB
 	)
 	status := rpcpb.BlockResponse_IRREVERSIBLE
 	blk, err = as.blockchain.GetBlockByNumber(number)
+	if err == block.ErrBlockPruned {
+		return nil, err
+	}
 	if err != nil {
 		status = rpcpb.BlockResponse_PENDING
 		blk, err = as.bc.GetBlockByNumber(number)
@@ -212,9 +476,95 @@ func (as *APIService) GetBlockByNumber(ctx context.Context, req *rpcpb.GetBlockB
 	}, nil
 }
 
+// GetBlocksByRange sends the blocks whose number is in [start_number, end_number), the irreversible
+// ones from block chain and the rest from the longest chain of block cache.
+func (as *APIService) GetBlocksByRange(req *rpcpb.GetBlocksByRangeRequest, res rpcpb.ApiService_GetBlocksByRangeServer) error {
+	start, end := req.GetStartNumber(), req.GetEndNumber()
+	if start < 0 {
+		start = 0
+	}
+	if max := int64(as.maxBatchSize()); end-start > max {
+		return status.Errorf(codes.InvalidArgument, "%v blocks exceed the max batch size %v", end-start, max)
+	}
+	return as.rangeBlocks(start, end, func(blk *block.Block, status rpcpb.BlockResponse_Status) error {
+		return res.Send(&rpcpb.BlockResponse{
+			Status: status,
+			Block:  toPbBlock(blk, req.GetComplete()),
+		})
+	})
+}
+
+// rangeBlocks calls f with the blocks whose number is in [start, end) in order, until the head of the longest chain.
+// ErrBlockPruned is returned if any of the blocks is pruned.
+func (as *APIService) rangeBlocks(start, end int64, f func(*block.Block, rpcpb.BlockResponse_Status) error) error {
+	if start < end && start < as.blockchain.Pruned() {
+		return block.ErrBlockPruned
+	}
+	for number := start; number < end; {
+		var blocks []*block.Block
+		status := rpcpb.BlockResponse_IRREVERSIBLE
+		if number < as.blockchain.Length() {
+			var err error
+			blocks, err = as.blockchain.GetBlocksByRange(number, end, false, blocksReadSize)
+			if err != nil {
+				return err
+			}
+		}
+		if len(blocks) == 0 {
+			status = rpcpb.BlockResponse_PENDING
+			blk, err := as.bc.GetBlockByNumber(number)
+			if err != nil {
+				// beyond the head of the longest chain
+				return nil
+			}
+			blocks = []*block.Block{blk}
+		}
+		for _, blk := range blocks {
+			if err := f(blk, status); err != nil {
+				return err
+			}
+		}
+		number += int64(len(blocks))
+	}
+	return nil
+}
+
+// GetTxReceiptsByHashes returns the receipts of the given txs, the irreversible ones from block chain
+// and the rest from block cache.
+func (as *APIService) GetTxReceiptsByHashes(ctx context.Context, req *rpcpb.TxHashesRequest) (*rpcpb.TxReceiptsResponse, error) {
+	hashes := req.GetHashes()
+	if max := as.maxBatchSize(); len(hashes) > max {
+		return nil, status.Errorf(codes.InvalidArgument, "%v hashes exceed the max batch size %v", len(hashes), max)
+	}
+	ret := &rpcpb.TxReceiptsResponse{
+		Receipts: make([]*rpcpb.TxReceipt, 0, len(hashes)),
+		NotFound: make([]string, 0),
+	}
+	for _, hash := range hashes {
+		txHashBytes := common.Base58Decode(hash)
+		receipt, err := as.blockchain.GetReceiptByTxHash(txHashBytes)
+		if err != nil {
+			_, receipt, err = as.txpool.GetFromChain(txHashBytes)
+		}
+		if err != nil || receipt == nil {
+			ret.NotFound = append(ret.NotFound, hash)
+			continue
+		}
+		ret.Receipts = append(ret.Receipts, toPbTxReceipt(receipt))
+	}
+	return ret, nil
+}
+
+func (as *APIService) maxBatchSize() int {
+	if size := as.bv.Config().RPC.MaxBatchSize; size > 0 {
+		return size
+	}
+	return defaultMaxBatchSize
+}
+
 // GetAccount returns account information corresponding to the given account name.
 func (as *APIService) GetAccount(ctx context.Context, req *rpcpb.GetAccountRequest) (*rpcpb.Account, error) {
-	dbVisitor, _, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, blk, err := as.getStateDBVisitorByNumber(req.ByLongestChain, req.HasBlockNumber, req.BlockNumber)
 	if err != nil {
 		return nil, err
 	}
@@ -236,13 +586,7 @@ func (as *APIService) GetAccount(ctx context.Context, req *rpcpb.GetAccountReque
 	}
 
 	// pack gas information
-	var blkTime int64
-	if req.GetByLongestChain() {
-		blkTime = as.bc.Head().Head.Time
-	} else {
-		blkTime = as.bc.LinkedRoot().Head.Time
-	}
-	pGas := dbVisitor.PGasAtTime(req.GetName(), blkTime)
+	pGas := dbVisitor.PGasAtTime(req.GetName(), blk.Head.Time)
 	tGas := dbVisitor.TGas(req.GetName())
 	totalGas := pGas.Add(tGas)
 	gasLimit := dbVisitor.GasLimit(req.GetName())
@@ -264,7 +608,7 @@ func (as *APIService) GetAccount(ctx context.Context, req *rpcpb.GetAccountReque
 
 	// pack frozen balance information
 	frozen := dbVisitor.AllFreezedTokenBalanceFixed("iost", req.GetName())
-	unfrozen, stillFrozen := as.getUnfrozenToken(frozen, req.ByLongestChain)
+	unfrozen, stillFrozen := as.getUnfrozenToken(frozen, blk.Head.Time)
 	ret.FrozenBalances = stillFrozen
 	ret.Balance += unfrozen
 
@@ -280,9 +624,68 @@ func (as *APIService) GetAccount(ctx context.Context, req *rpcpb.GetAccountReque
 	return ret, nil
 }
 
+// GetAccountHistory returns the resource usage and balance changes of the account in each block of the range.
+func (as *APIService) GetAccountHistory(ctx context.Context, req *rpcpb.AccountHistoryRequest) (*rpcpb.AccountHistoryResponse, error) {
+	ret := &rpcpb.AccountHistoryResponse{
+		Blocks: make([]*rpcpb.AccountBlockHistory, 0),
+	}
+	next, err := as.rangeAccountHistory(req, func(blk *block.Block) {
+		if h := accountBlockHistory(req.GetName(), blk); h != nil {
+			ret.Blocks = append(ret.Blocks, h)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret.NextNumber = next
+	return ret, nil
+}
+
+// GetAccountUsage returns the total resource usage and balance changes of the account in the range.
+func (as *APIService) GetAccountUsage(ctx context.Context, req *rpcpb.AccountHistoryRequest) (*rpcpb.AccountUsageResponse, error) {
+	ret := &rpcpb.AccountUsageResponse{
+		TokenChanges: make(map[string]float64),
+	}
+	next, err := as.rangeAccountHistory(req, func(blk *block.Block) {
+		if ret.StartTime == 0 {
+			ret.StartTime = blk.Head.Time
+		}
+		ret.EndTime = blk.Head.Time
+		if h := accountBlockHistory(req.GetName(), blk); h != nil {
+			addAccountUsage(ret, h)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret.NextNumber = next
+	return ret, nil
+}
+
+// rangeAccountHistory calls f with the blocks of the request, and returns the number after the last block.
+func (as *APIService) rangeAccountHistory(req *rpcpb.AccountHistoryRequest, f func(*block.Block)) (int64, error) {
+	if req.GetName() == "" {
+		return 0, errors.New("account name is required")
+	}
+	start, end := req.GetStartNumber(), req.GetEndNumber()
+	if start < 0 {
+		start = 0
+	}
+	if end-start > maxHistoryBlocks {
+		end = start + maxHistoryBlocks
+	}
+	next := start
+	err := as.rangeBlocks(start, end, func(blk *block.Block, _ rpcpb.BlockResponse_Status) error {
+		f(blk)
+		next = blk.Head.Number + 1
+		return nil
+	})
+	return next, err
+}
+
 // GetTokenBalance returns contract information corresponding to the given contract ID.
 func (as *APIService) GetTokenBalance(ctx context.Context, req *rpcpb.GetTokenBalanceRequest) (*rpcpb.GetTokenBalanceResponse, error) {
-	dbVisitor, _, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, blk, err := as.getStateDBVisitorByNumber(req.ByLongestChain, req.HasBlockNumber, req.BlockNumber)
 	if err != nil {
 		return nil, err
 	}
@@ -293,7 +696,7 @@ func (as *APIService) GetTokenBalance(ctx context.Context, req *rpcpb.GetTokenBa
 	balance := dbVisitor.TokenBalanceFixed(req.GetToken(), req.GetAccount()).ToFloat()
 	// pack frozen balance information
 	frozen := dbVisitor.AllFreezedTokenBalanceFixed(req.GetToken(), req.GetAccount())
-	unfrozen, stillFrozen := as.getUnfrozenToken(frozen, req.ByLongestChain)
+	unfrozen, stillFrozen := as.getUnfrozenToken(frozen, blk.Head.Time)
 	return &rpcpb.GetTokenBalanceResponse{
 		Balance:        balance + unfrozen,
 		FrozenBalances: stillFrozen,
@@ -355,6 +758,82 @@ func (as *APIService) GetContract(ctx context.Context, req *rpcpb.GetContractReq
 	return toPbContract(contract), nil
 }
 
+// EncodeAction validates the typed arguments against the abi of the action, and encodes them into the action data.
+func (as *APIService) EncodeAction(ctx context.Context, req *rpcpb.EncodeActionRequest) (*rpcpb.EncodeActionResponse, error) {
+	dbVisitor, _, err := as.getStateDBVisitor(req.ByLongestChain)
+	if err != nil {
+		return nil, err
+	}
+	abi, err := newABIReader(dbVisitor).abi(req.GetContract(), req.GetActionName())
+	if err != nil {
+		return nil, err
+	}
+	data, argErrs, err := abi.EncodeArgs(req.GetArgs())
+	if err != nil {
+		return nil, err
+	}
+	ret := &rpcpb.EncodeActionResponse{
+		ArgTypes: abi.Args,
+		Errors:   toPbArgErrors(argErrs),
+	}
+	if len(argErrs) > 0 {
+		return ret, nil
+	}
+	if _, err := vm.UnmarshalArgs(abi, data); err != nil {
+		return nil, err
+	}
+	ret.Action = &rpcpb.Action{
+		Contract:   req.GetContract(),
+		ActionName: req.GetActionName(),
+		Data:       data,
+	}
+	return ret, nil
+}
+
+// DecodeTx decodes the actions, receipts and returns of the transaction against the abis in the head block.
+func (as *APIService) DecodeTx(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.DecodeTxResponse, error) {
+	txHashBytes := common.Base58Decode(req.GetHash())
+	t, err := as.blockchain.GetTx(txHashBytes)
+	var receipt *tx.TxReceipt
+	if err == nil {
+		receipt, err = as.blockchain.GetReceiptByTxHash(txHashBytes)
+		if err != nil {
+			return nil, errors.New("txreceipt not found")
+		}
+	} else {
+		t, receipt, err = as.txpool.GetFromChain(txHashBytes)
+		if err != nil {
+			t, err = as.txpool.GetFromPending(txHashBytes)
+			if err != nil {
+				return nil, errors.New("tx not found")
+			}
+		}
+	}
+	dbVisitor, _, err := as.getStateDBVisitor(true)
+	if err != nil {
+		return nil, err
+	}
+	reader := newABIReader(dbVisitor)
+	ret := &rpcpb.DecodeTxResponse{
+		TxHash: req.GetHash(),
+	}
+	for i, a := range t.Actions {
+		call := reader.decodeCall(a.Contract, a.ActionName, a.Data)
+		if receipt != nil && i < len(receipt.Returns) {
+			call.Returns, _ = decodeReturns(receipt.Returns[i])
+		}
+		ret.Actions = append(ret.Actions, call)
+	}
+	if receipt != nil {
+		ret.StatusCode = rpcpb.TxReceipt_StatusCode(receipt.Status.Code)
+		ret.Message = receipt.Status.Message
+		for _, r := range receipt.Receipts {
+			ret.Receipts = append(ret.Receipts, reader.decodeReceipt(r.FuncName, r.Content))
+		}
+	}
+	return ret, nil
+}
+
 // GetGasRatio returns gas ratio information in head block
 func (as *APIService) GetGasRatio(ctx context.Context, req *rpcpb.EmptyRequest) (*rpcpb.GasRatioResponse, error) {
 	ratios := make([]float64, 0)
@@ -406,7 +885,7 @@ func (as *APIService) GetProducerVoteInfo(ctx context.Context, req *rpcpb.GetPro
 
 // GetContractStorage returns contract storage corresponding to the given key and field.
 func (as *APIService) GetContractStorage(ctx context.Context, req *rpcpb.GetContractStorageRequest) (*rpcpb.GetContractStorageResponse, error) {
-	dbVisitor, bcn, err := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, blk, err := as.getStateDBVisitorByNumber(req.ByLongestChain, req.HasBlockNumber, req.BlockNumber)
 	if err != nil {
 		return nil, err
 	}
@@ -430,8 +909,8 @@ func (as *APIService) GetContractStorage(ctx context.Context, req *rpcpb.GetCont
 	}
 	return &rpcpb.GetContractStorageResponse{
 		Data:        data,
-		BlockHash:   common.Base58Encode(bcn.HeadHash()),
-		BlockNumber: bcn.Head.Number,
+		BlockHash:   common.Base58Encode(blk.HeadHash()),
+		BlockNumber: blk.Head.Number,
 	}, nil
 }
 
@@ -494,6 +973,55 @@ func (as *APIService) GetContractStorageFields(ctx context.Context, req *rpcpb.G
 	}, nil
 }
 
+// GetContractStorageProof returns contract storage and its merkle proof against the state root of last irreversible block.
+func (as *APIService) GetContractStorageProof(ctx context.Context, req *rpcpb.GetContractStorageProofRequest) (*rpcpb.GetContractStorageProofResponse, error) {
+	var key string
+	switch {
+	case req.GetField() == "":
+		key = database.BasicPrefix + req.GetId() + database.Separator + req.GetKey()
+	default:
+		key = database.MapPrefix + req.GetId() + database.Separator + req.GetKey() + database.Separator + req.GetField()
+	}
+	proof, err := as.bv.StateDB().StateProof(database.StateTable, key)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := as.blockchain.GetBlockByHash([]byte(proof.Tag))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block of state root: %v", err)
+	}
+	nodes := make([]string, 0, len(proof.Nodes))
+	for _, n := range proof.Nodes {
+		nodes = append(nodes, common.Base58Encode(n))
+	}
+	var data string
+	if proof.Value != nil {
+		value := database.Unmarshal(string(proof.Value))
+		if err, ok := value.(error); ok {
+			return nil, fmt.Errorf("cannot unmarshal %v: %v", string(proof.Value), err)
+		}
+		if reflect.TypeOf(value).Kind() == reflect.String {
+			data = value.(string)
+		} else {
+			bytes, err := json.Marshal(value)
+			if err != nil {
+				return nil, fmt.Errorf("cannot unmarshal %v", value)
+			}
+			data = string(bytes)
+		}
+	}
+	return &rpcpb.GetContractStorageProofResponse{
+		Data:        data,
+		Value:       string(proof.Value),
+		Exist:       proof.Value != nil,
+		TrieKey:     database.StateTable + "/" + key,
+		Proof:       nodes,
+		StateRoot:   common.Base58Encode(proof.Root),
+		BlockHash:   common.Base58Encode(blk.HeadHash()),
+		BlockNumber: blk.Head.Number,
+	}, nil
+}
+
 func (as *APIService) tryTransaction(t *tx.Tx) (*tx.TxReceipt, error) {
 	topBlock := as.bc.Head()
 	blkHead := &block.BlockHead{
@@ -546,6 +1074,25 @@ func (as *APIService) SendTransaction(ctx context.Context, req *rpcpb.Transactio
 	return ret, nil
 }
 
+// CancelTransaction cancels a pending transaction by sending a replacement without actions, which is signed
+// by the publisher of the pending transaction with a higher gas ratio.
+func (as *APIService) CancelTransaction(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.SendTransactionResponse, error) {
+	if req.GetReplacedTx() == "" {
+		return nil, errors.New("replaced_tx is required to cancel a transaction")
+	}
+	if len(req.GetActions()) > 0 {
+		return nil, errors.New("the cancellation should have no actions")
+	}
+	t, err := as.txpool.GetFromPending(common.Base58Decode(req.GetReplacedTx()))
+	if err != nil {
+		return nil, fmt.Errorf("transaction %v is not pending", req.GetReplacedTx())
+	}
+	if t.Publisher != req.GetPublisher() {
+		return nil, errors.New("the cancellation should have the same publisher as the canceled transaction")
+	}
+	return as.SendTransaction(ctx, req)
+}
+
 // ExecTransaction executes a transaction by the node and returns the receipt.
 func (as *APIService) ExecTransaction(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.TxReceipt, error) {
 	if !as.bv.Config().RPC.ExecTx {
@@ -559,6 +1106,81 @@ func (as *APIService) ExecTransaction(ctx context.Context, req *rpcpb.Transactio
 	return toPbTxReceipt(receipt), nil
 }
 
+// EstimateTransaction executes a transaction on the head block with all the gas of publisher, and returns
+// the resource usage with the suggested gas limit and gas ratio.
+func (as *APIService) EstimateTransaction(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.EstimateTransactionResponse, error) {
+	if !as.bv.Config().RPC.ExecTx {
+		return nil, errors.New("The node has't enabled this method")
+	}
+	t := toCoreTx(req)
+	headBlock := as.bc.Head()
+	dbVisitor, err := as.getStateDBVisitorByHash(headBlock.HeadHash())
+	if err != nil {
+		return nil, err
+	}
+	currentGas := dbVisitor.TotalGasAtTime(t.Publisher, headBlock.Head.Time).ChangeDecimal(2)
+	ramInfo := dbVisitor.RAMHandler.GetAccountRAMInfo(t.Publisher)
+	return as.estimateTransaction(t, currentGas, ramInfo.Available, as.tryTransaction)
+}
+
+// estimateTransaction executes the transaction by try with the gas and ram of publisher
+func (as *APIService) estimateTransaction(t *tx.Tx, currentGas *common.Fixed, ram int64, try func(*tx.Tx) (*tx.TxReceipt, error)) (*rpcpb.EstimateTransactionResponse, error) {
+	// the signatures are not verified by the execution, so the gas of tx can be changed
+	t.GasLimit = currentGas.Value
+	if t.GasLimit < tx.MinGasLimit {
+		t.GasLimit = tx.MinGasLimit
+	}
+	if t.GasLimit > tx.MaxGasLimit {
+		t.GasLimit = tx.MaxGasLimit
+	}
+	if t.GasRatio < tx.MinGasRatio {
+		t.GasRatio = tx.MinGasRatio
+	}
+	if t.GasRatio > tx.MaxGasRatio {
+		t.GasRatio = tx.MaxGasRatio
+	}
+	receipt, err := try(t)
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit := int64(float64(receipt.GasUsage) * estimateGasMargin)
+	if gasLimit < tx.MinGasLimit {
+		gasLimit = tx.MinGasLimit
+	}
+	if gasLimit > tx.MaxGasLimit {
+		gasLimit = tx.MaxGasLimit
+	}
+	return &rpcpb.EstimateTransactionResponse{
+		TxReceipt:    toPbTxReceipt(receipt),
+		GasUsage:     float64(receipt.GasUsage) / 100,
+		GasLimit:     float64(gasLimit) / 100,
+		GasRatio:     as.recentGasRatio(),
+		RamUsage:     receipt.RAMUsage,
+		PublisherGas: currentGas.ToFloat(),
+		PublisherRam: ram,
+		GasEnough:    currentGas.Value >= gasLimit,
+		RamEnough:    ram >= receipt.RAMUsage[t.Publisher],
+	}, nil
+}
+
+// recentGasRatio returns the median gas ratio of the txs in the recent blocks of the longest chain
+func (as *APIService) recentGasRatio() float64 {
+	ratios := make([]int64, 0)
+	for bcn, i := as.bc.Head(), 0; bcn != nil && bcn.Block != nil && i < gasRatioBlocks; bcn, i = bcn.GetParent(), i+1 {
+		for _, t := range bcn.Block.Txs {
+			if t.Publisher != "base.iost" {
+				ratios = append(ratios, t.GasRatio)
+			}
+		}
+	}
+	if len(ratios) == 0 {
+		return float64(tx.MinGasRatio) / 100
+	}
+	sort.Slice(ratios, func(i, j int) bool { return ratios[i] < ratios[j] })
+	return float64(ratios[len(ratios)/2]) / 100
+}
+
 // Subscribe used for event.
 func (as *APIService) Subscribe(req *rpcpb.SubscribeRequest, res rpcpb.ApiService_SubscribeServer) error {
 
@@ -570,11 +1192,19 @@ func (as *APIService) Subscribe(req *rpcpb.SubscribeRequest, res rpcpb.ApiServic
 	if req.GetFilter() != nil {
 		filter = &event.Meta{
 			ContractID: req.GetFilter().GetContractId(),
+			ActionName: req.GetFilter().GetActionName(),
 		}
+		if account := req.GetFilter().GetAccount(); account != "" {
+			filter.Accounts = []string{account}
+		}
+	}
+
+	if req.GetFromCursor() != nil {
+		return as.subscribeFrom(topics, filter, req.GetFromCursor(), res)
 	}
 
 	ec := event.GetCollector()
-	id := time.Now().UnixNano()
+	id := nextSubscriberID()
 	ch := ec.Subscribe(id, topics, filter)
 	defer ec.Unsubscribe(id, topics)
 
@@ -588,12 +1218,94 @@ func (as *APIService) Subscribe(req *rpcpb.SubscribeRequest, res rpcpb.ApiServic
 		case <-res.Context().Done():
 			return res.Context().Err()
 		case ev := <-ch:
-			e := &rpcpb.Event{
-				Topic: rpcpb.Event_Topic(ev.Topic),
-				Data:  ev.Data,
-				Time:  ev.Time,
+			err := res.Send(&rpcpb.SubscribeResponse{Event: toPbEvent(ev)})
+			if err != nil {
+				ilog.Errorf("stream send failed. err=%v", err)
+				return err
+			}
+		}
+	}
+}
+
+// subscribeFrom sends the events of the chain topics after the cursor by reading the irreversible blocks,
+// and reads the new blocks whenever a NewLibBlock event comes, so none of them is dropped even if the
+// client is slow. The events of the other topics are sent as they come.
+func (as *APIService) subscribeFrom(topics []event.Topic, filter *event.Meta, from *rpcpb.EventCursor, res rpcpb.ApiService_SubscribeServer) error {
+	cursor := &event.Cursor{
+		BlockNumber:  from.BlockNumber,
+		TxIndex:      from.TxIndex,
+		ReceiptIndex: from.ReceiptIndex,
+	}
+	if pruned := as.blockchain.Pruned(); cursor.BlockNumber < pruned {
+		return fmt.Errorf("the blocks before %v are pruned", pruned)
+	}
+	chainTopics := make([]event.Topic, 0)
+	liveTopics := make([]event.Topic, 0)
+	for _, t := range topics {
+		if event.IsChainTopic(t) {
+			chainTopics = append(chainTopics, t)
+		} else {
+			liveTopics = append(liveTopics, t)
+		}
+	}
+
+	// subscribe before reading the blocks, so the blocks pushed meanwhile are read by the next round
+	ec := event.GetCollector()
+	libID := nextSubscriberID()
+	libCh := ec.Subscribe(libID, []event.Topic{event.NewLibBlock}, nil)
+	defer ec.Unsubscribe(libID, []event.Topic{event.NewLibBlock})
+	var liveCh <-chan *event.Event
+	if len(liveTopics) > 0 {
+		liveID := nextSubscriberID()
+		liveCh = ec.Subscribe(liveID, liveTopics, filter)
+		defer ec.Unsubscribe(liveID, liveTopics)
+	}
+
+	next := cursor.BlockNumber
+	sendChainEvents := func() error {
+		if len(chainTopics) == 0 {
+			return nil
+		}
+		for ; next < as.blockchain.Length(); next++ {
+			if err := res.Context().Err(); err != nil {
+				return err
+			}
+			blk, err := as.blockchain.GetBlockByNumber(next)
+			if err != nil {
+				return fmt.Errorf("fail to get block %v, err:%v", next, err)
+			}
+			for _, ev := range event.ChainEvents(blk, chainTopics, filter) {
+				if !cursor.Less(ev.Cursor) {
+					continue
+				}
+				if err := res.Send(&rpcpb.SubscribeResponse{Event: toPbEvent(ev)}); err != nil {
+					return err
+				}
+				cursor = ev.Cursor
+			}
+		}
+		return nil
+	}
+	if err := sendChainEvents(); err != nil {
+		return err
+	}
+
+	timeup := time.NewTimer(time.Hour)
+	for {
+		select {
+		case <-timeup.C:
+			return nil
+		case <-as.quitCh:
+			return nil
+		case <-res.Context().Done():
+			return res.Context().Err()
+		case <-libCh:
+			if err := sendChainEvents(); err != nil {
+				ilog.Errorf("stream send failed. err=%v", err)
+				return err
 			}
-			err := res.Send(&rpcpb.SubscribeResponse{Event: e})
+		case ev := <-liveCh:
+			err := res.Send(&rpcpb.SubscribeResponse{Event: toPbEvent(ev)})
 			if err != nil {
 				ilog.Errorf("stream send failed. err=%v", err)
 				return err
@@ -602,6 +1314,22 @@ func (as *APIService) Subscribe(req *rpcpb.SubscribeRequest, res rpcpb.ApiServic
 	}
 }
 
+func toPbEvent(ev *event.Event) *rpcpb.Event {
+	e := &rpcpb.Event{
+		Topic: rpcpb.Event_Topic(ev.Topic),
+		Data:  ev.Data,
+		Time:  ev.Time,
+	}
+	if ev.Cursor != nil {
+		e.Cursor = &rpcpb.EventCursor{
+			BlockNumber:  ev.Cursor.BlockNumber,
+			TxIndex:      ev.Cursor.TxIndex,
+			ReceiptIndex: ev.Cursor.ReceiptIndex,
+		}
+	}
+	return e
+}
+
 // GetVoterBonus returns the bonus a voter can claim.
 func (as *APIService) GetVoterBonus(ctx context.Context, req *rpcpb.GetAccountRequest) (*rpcpb.VoterBonus, error) {
 	ret := &rpcpb.VoterBonus{
@@ -813,13 +1541,42 @@ func (as *APIService) getStateDBVisitor(longestChain bool) (*database.Visitor, *
 	return nil, nil, err
 }
 
-func (as *APIService) getUnfrozenToken(frozens []database.FreezeItemFixed, longestChain bool) (float64, []*rpcpb.FrozenBalance) {
-	var blockTime int64
-	if longestChain {
-		blockTime = as.bc.Head().Head.Time
+// getStateDBVisitorByNumber returns the state at the given block number if hasNumber, otherwise by getStateDBVisitor.
+// The state of flushed blocks is read from the history of statedb, which needs archive mode.
+func (as *APIService) getStateDBVisitorByNumber(longestChain bool, hasNumber bool, number int64) (*database.Visitor, *block.Block, error) {
+	if !hasNumber {
+		db, bcn, err := as.getStateDBVisitor(longestChain)
+		if err != nil {
+			return nil, nil, err
+		}
+		return db, bcn.Block, nil
+	}
+	if number < 0 {
+		return nil, nil, fmt.Errorf("invalid block number %v", number)
+	}
+	var blk *block.Block
+	var err error
+	if number > as.bc.LinkedRoot().Head.Number {
+		blk, err = as.bc.GetBlockByNumber(number)
 	} else {
-		blockTime = as.bc.LinkedRoot().Head.Time
+		// only the head is needed, which is kept for the pruned blocks
+		blk, err = as.blockchain.GetBlockHeaderByNumber(number)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("get block %v failed: %v", number, err)
+	}
+	stateDB := as.bv.StateDB().Fork()
+	if stateDB.Checkout(string(blk.HeadHash())) {
+		return database.NewVisitor(0, stateDB), blk, nil
 	}
+	stateDB, err = as.bv.StateDB().History(string(blk.HeadHash()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("state of block %v is not available: %v", number, err)
+	}
+	return database.NewVisitor(0, stateDB), blk, nil
+}
+
+func (as *APIService) getUnfrozenToken(frozens []database.FreezeItemFixed, blockTime int64) (float64, []*rpcpb.FrozenBalance) {
 	var unfrozen float64
 	var stillFrozen []*rpcpb.FrozenBalance
 	for _, f := range frozens {
@@ -0,0 +1,487 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/event"
+	core_mock "github.com/iost-official/go-iost/core/mocks"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/core/txpool"
+	txpool_mock "github.com/iost-official/go-iost/core/txpool/mock"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/rpc/pb"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func genPendingTx(a *account.KeyPair, publisher string, contract string, gasRatio int64, expiration int64) *tx.Tx {
+	t := tx.NewTx([]*tx.Action{tx.NewAction(contract, "transfer", "[]")}, nil, 1000000, gasRatio, expiration, 0, tx.ChainID)
+	t, _ = tx.SignTx(t, publisher, []*account.KeyPair{a})
+	return t
+}
+
+func pendingHashes(txs []*rpcpb.Transaction) []string {
+	hashes := make([]string, 0, len(txs))
+	for _, t := range txs {
+		hashes = append(hashes, t.Hash)
+	}
+	return hashes
+}
+
+func TestTxPoolAPI(t *testing.T) {
+	Convey("test txpool api", t, func() {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a, _ := account.NewKeyPair(nil, crypto.Secp256k1)
+		expiration := time.Now().UnixNano() + int64(time.Minute)
+		a400 := genPendingTx(a, "alice", "token.iost", 400, expiration)
+		b300 := genPendingTx(a, "bob", "token.iost", 300, expiration)
+		a200 := genPendingTx(a, "alice", "vote.iost", 200, expiration)
+		b100 := genPendingTx(a, "bob", "vote.iost", 100, expiration)
+		expired := genPendingTx(a, "bob", "vote.iost", 100, time.Now().UnixNano()-1)
+		pending := txpool.NewSortedTxMap()
+		for _, t := range []*tx.Tx{b100, a200, a400, b300} {
+			pending.Add(t)
+		}
+		pool := txpool_mock.NewMockTxPool(ctl)
+		pool.EXPECT().PendingTx().Return(pending, nil).AnyTimes()
+		as := &APIService{txpool: pool}
+
+		Convey("get pending txs", func() {
+			res, err := as.GetPendingTxs(context.Background(), &rpcpb.GetPendingTxsRequest{Limit: 3})
+			So(err, ShouldBeNil)
+			So(res.Total, ShouldEqual, 4)
+			So(pendingHashes(res.Transactions), ShouldResemble, []string{
+				common.Base58Encode(a400.Hash()), common.Base58Encode(b300.Hash()), common.Base58Encode(a200.Hash()),
+			})
+			So(res.Cursor, ShouldEqual, common.Base58Encode(a200.Hash()))
+
+			res, err = as.GetPendingTxs(context.Background(), &rpcpb.GetPendingTxsRequest{Limit: 3, Cursor: res.Cursor})
+			So(err, ShouldBeNil)
+			So(pendingHashes(res.Transactions), ShouldResemble, []string{common.Base58Encode(b100.Hash())})
+			So(res.Cursor, ShouldEqual, "")
+
+			res, err = as.GetPendingTxs(context.Background(), &rpcpb.GetPendingTxsRequest{Limit: 4})
+			So(err, ShouldBeNil)
+			So(len(res.Transactions), ShouldEqual, 4)
+			So(res.Cursor, ShouldEqual, "")
+
+			res, err = as.GetPendingTxs(context.Background(), &rpcpb.GetPendingTxsRequest{Publisher: "alice", Contract: "vote.iost"})
+			So(err, ShouldBeNil)
+			So(res.Total, ShouldEqual, 1)
+			So(pendingHashes(res.Transactions), ShouldResemble, []string{common.Base58Encode(a200.Hash())})
+
+			_, err = as.GetPendingTxs(context.Background(), &rpcpb.GetPendingTxsRequest{Cursor: common.Base58Encode(expired.Hash())})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("get txpool stats", func() {
+			res, err := as.GetTxPoolStats(context.Background(), &rpcpb.EmptyRequest{})
+			So(err, ShouldBeNil)
+			So(res.Size, ShouldEqual, 4)
+			So(res.PublisherCount, ShouldEqual, 2)
+			So(res.OldestTxHash, ShouldEqual, common.Base58Encode(a400.Hash()))
+			counts := make([]int64, 0)
+			for _, b := range res.GasRatioHistogram {
+				counts = append(counts, b.Count)
+			}
+			So(counts, ShouldResemble, []int64{1, 3, 0, 0, 0, 0, 0})
+		})
+
+		Convey("get pending tx status", func() {
+			pool.EXPECT().GetFromChain(gomock.Any()).Return(nil, nil, errors.New("not found")).AnyTimes()
+			pool.EXPECT().ExistReplacement(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+			pool.EXPECT().GetTxRecord(b300.Hash()).Return(&txpool.TxRecord{
+				Hash:   b300.Hash(),
+				Events: []*txpool.TxEvent{{Type: txpool.TxPending, Time: 1}},
+			}, nil)
+			res, err := as.GetPendingTxStatus(context.Background(), &rpcpb.TxHashRequest{Hash: common.Base58Encode(b300.Hash())})
+			So(err, ShouldBeNil)
+			So(res.Valid, ShouldBeTrue)
+			So(res.Rank, ShouldEqual, 1)
+			So(len(res.Events), ShouldEqual, 1)
+			So(res.Events[0].Type, ShouldEqual, rpcpb.TxEvent_PENDING)
+
+			pending.Add(expired)
+			pool.EXPECT().GetTxRecord(expired.Hash()).Return(nil, txpool.ErrTxNotFound)
+			res, err = as.GetPendingTxStatus(context.Background(), &rpcpb.TxHashRequest{Hash: common.Base58Encode(expired.Hash())})
+			So(err, ShouldBeNil)
+			So(res.Valid, ShouldBeFalse)
+			So(res.Error, ShouldEqual, "tx expired")
+
+			_, err = as.GetPendingTxStatus(context.Background(), &rpcpb.TxHashRequest{Hash: common.Base58Encode([]byte("unknown"))})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+// fakeSubscribeServer implements the methods of ApiService_SubscribeServer called by Subscribe
+type fakeSubscribeServer struct {
+	rpcpb.ApiService_SubscribeServer
+	ctx    context.Context
+	events chan *rpcpb.Event
+}
+
+func (s *fakeSubscribeServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeSubscribeServer) Send(res *rpcpb.SubscribeResponse) error {
+	s.events <- res.Event
+	return nil
+}
+
+func genReceiptBlock(number int64) *block.Block {
+	t := tx.NewTx([]*tx.Action{tx.NewAction("token.iost", "transfer", "[]")}, nil, 100000, 100, 0, 0, 0)
+	t.Publisher = "alice"
+	r := tx.NewTxReceipt(t.Hash())
+	r.Receipts = []*tx.Receipt{
+		{FuncName: "token.iost/transfer", Content: "a"},
+		{FuncName: "token.iost/transfer", Content: "b"},
+	}
+	return &block.Block{
+		Head:     &block.BlockHead{Number: number, Time: number},
+		Txs:      []*tx.Tx{t},
+		Receipts: []*tx.TxReceipt{r},
+	}
+}
+
+func TestSubscribeFrom(t *testing.T) {
+	Convey("test subscribe from cursor", t, func() {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		length := int64(3)
+		chain := core_mock.NewMockChain(ctl)
+		chain.EXPECT().Pruned().Return(int64(1)).AnyTimes()
+		chain.EXPECT().Length().DoAndReturn(func() int64 { return atomic.LoadInt64(&length) }).AnyTimes()
+		chain.EXPECT().GetBlockByNumber(gomock.Any()).DoAndReturn(func(number int64) (*block.Block, error) {
+			return genReceiptBlock(number), nil
+		}).AnyTimes()
+		as := &APIService{blockchain: chain, quitCh: make(chan struct{})}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		res := &fakeSubscribeServer{ctx: ctx, events: make(chan *rpcpb.Event, 10)}
+		req := &rpcpb.SubscribeRequest{
+			Topics:     []rpcpb.Event_Topic{rpcpb.Event_NEW_LIB_BLOCK, rpcpb.Event_CONTRACT_RECEIPT},
+			FromCursor: &rpcpb.EventCursor{BlockNumber: 1, TxIndex: 0, ReceiptIndex: 0},
+		}
+		done := make(chan error)
+		go func() {
+			done <- as.Subscribe(req, res)
+		}()
+
+		expect := func(cursors ...*rpcpb.EventCursor) {
+			for _, c := range cursors {
+				select {
+				case e := <-res.events:
+					So(e.Cursor, ShouldResemble, c)
+				case <-time.After(time.Second):
+					So("timeout", ShouldBeEmpty)
+				}
+			}
+		}
+		// the events after the cursor are replayed
+		expect(
+			&rpcpb.EventCursor{BlockNumber: 1, TxIndex: 0, ReceiptIndex: 1},
+			&rpcpb.EventCursor{BlockNumber: 2, TxIndex: -1, ReceiptIndex: -1},
+			&rpcpb.EventCursor{BlockNumber: 2, TxIndex: 0, ReceiptIndex: 0},
+			&rpcpb.EventCursor{BlockNumber: 2, TxIndex: 0, ReceiptIndex: 1},
+		)
+
+		// the new irreversible block is read from chain, not from the live events
+		atomic.StoreInt64(&length, 4)
+		event.GetCollector().Post(event.NewEvent(event.NewLibBlock, "3"))
+		expect(
+			&rpcpb.EventCursor{BlockNumber: 3, TxIndex: -1, ReceiptIndex: -1},
+			&rpcpb.EventCursor{BlockNumber: 3, TxIndex: 0, ReceiptIndex: 0},
+			&rpcpb.EventCursor{BlockNumber: 3, TxIndex: 0, ReceiptIndex: 1},
+		)
+		So(len(res.events), ShouldEqual, 0)
+
+		cancel()
+		So(<-done, ShouldEqual, context.Canceled)
+
+		// the pruned blocks can't be replayed
+		So(as.subscribeFrom(nil, nil, &rpcpb.EventCursor{BlockNumber: 0}, res), ShouldNotBeNil)
+	})
+}
+
+func TestNextSubscriberID(t *testing.T) {
+	Convey("test next subscriber id", t, func() {
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		ids := make(map[int64]bool)
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					id := nextSubscriberID()
+					mu.Lock()
+					ids[id] = true
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+		So(len(ids), ShouldEqual, 800)
+	})
+}
+
+// fakeBlocksServer implements the methods of ApiService_GetBlocksByRangeServer called by GetBlocksByRange
+type fakeBlocksServer struct {
+	rpcpb.ApiService_GetBlocksByRangeServer
+	blocks []*rpcpb.BlockResponse
+}
+
+func (s *fakeBlocksServer) Send(res *rpcpb.BlockResponse) error {
+	s.blocks = append(s.blocks, res)
+	return nil
+}
+
+func (s *fakeBlocksServer) numbers(status rpcpb.BlockResponse_Status) []int64 {
+	numbers := make([]int64, 0)
+	for _, b := range s.blocks {
+		if b.Status == status {
+			numbers = append(numbers, b.Block.Number)
+		}
+	}
+	return numbers
+}
+
+func newBatchTestService(ctl *gomock.Controller) (*APIService, *core_mock.MockChain) {
+	bv := core_mock.NewMockBaseVariable(ctl)
+	bv.EXPECT().Config().Return(&common.Config{RPC: &common.RPCConfig{MaxBatchSize: 3}}).AnyTimes()
+	chain := core_mock.NewMockChain(ctl)
+	return &APIService{bv: bv, blockchain: chain}, chain
+}
+
+func TestBlocksByRangeAPI(t *testing.T) {
+	Convey("test get blocks by range", t, func() {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a, _ := account.NewKeyPair(nil, crypto.Secp256k1)
+		// the blocks before 2 are pruned, 2-4 are irreversible and 5-6 are in block cache
+		as, chain := newBatchTestService(ctl)
+		chain.EXPECT().Pruned().Return(int64(2)).AnyTimes()
+		chain.EXPECT().Length().Return(int64(5)).AnyTimes()
+		chain.EXPECT().GetBlocksByRange(gomock.Any(), gomock.Any(), false, blocksReadSize).DoAndReturn(
+			func(start, end int64, reverse bool, limit int) ([]*block.Block, error) {
+				blocks := make([]*block.Block, 0)
+				for n := start; n < end && n < 5 && len(blocks) < limit; n++ {
+					blocks = append(blocks, genGraphQLBlock(a, n, 1))
+				}
+				return blocks, nil
+			}).AnyTimes()
+		as.bc = &fakeBlockCache{blocks: []*block.Block{genGraphQLBlock(a, 5, 0), genGraphQLBlock(a, 6, 0)}}
+
+		res := &fakeBlocksServer{}
+		So(as.GetBlocksByRange(&rpcpb.GetBlocksByRangeRequest{StartNumber: 3, EndNumber: 6}, res), ShouldBeNil)
+		So(res.numbers(rpcpb.BlockResponse_IRREVERSIBLE), ShouldResemble, []int64{3, 4})
+		So(res.numbers(rpcpb.BlockResponse_PENDING), ShouldResemble, []int64{5})
+		So(len(res.blocks[0].Block.Transactions), ShouldEqual, 0)
+
+		res = &fakeBlocksServer{}
+		So(as.GetBlocksByRange(&rpcpb.GetBlocksByRangeRequest{StartNumber: 4, EndNumber: 6, Complete: true}, res), ShouldBeNil)
+		So(len(res.blocks), ShouldEqual, 2)
+		So(len(res.blocks[0].Block.Transactions), ShouldEqual, 1)
+
+		// the range stops at the head of block cache
+		res = &fakeBlocksServer{}
+		So(as.GetBlocksByRange(&rpcpb.GetBlocksByRangeRequest{StartNumber: 5, EndNumber: 8}, res), ShouldBeNil)
+		So(res.numbers(rpcpb.BlockResponse_PENDING), ShouldResemble, []int64{5, 6})
+
+		res = &fakeBlocksServer{}
+		So(as.GetBlocksByRange(&rpcpb.GetBlocksByRangeRequest{StartNumber: 7, EndNumber: 7}, res), ShouldBeNil)
+		So(len(res.blocks), ShouldEqual, 0)
+
+		err := as.GetBlocksByRange(&rpcpb.GetBlocksByRangeRequest{StartNumber: 3, EndNumber: 7}, &fakeBlocksServer{})
+		So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+
+		err = as.GetBlocksByRange(&rpcpb.GetBlocksByRangeRequest{StartNumber: 1, EndNumber: 3}, &fakeBlocksServer{})
+		So(err, ShouldEqual, block.ErrBlockPruned)
+		err = as.GetBlocksByRange(&rpcpb.GetBlocksByRangeRequest{StartNumber: -1, EndNumber: 2}, &fakeBlocksServer{})
+		So(err, ShouldEqual, block.ErrBlockPruned)
+	})
+}
+
+func TestTxReceiptsByHashesAPI(t *testing.T) {
+	Convey("test get tx receipts by hashes", t, func() {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		as, chain := newBatchTestService(ctl)
+		pool := txpool_mock.NewMockTxPool(ctl)
+		as.txpool = pool
+
+		irreversible := tx.NewTxReceipt([]byte("irreversible"))
+		packed := tx.NewTxReceipt([]byte("packed"))
+		chain.EXPECT().GetReceiptByTxHash([]byte("irreversible")).Return(irreversible, nil)
+		chain.EXPECT().GetReceiptByTxHash(gomock.Any()).Return(nil, errors.New("receipt not found")).AnyTimes()
+		pool.EXPECT().GetFromChain([]byte("packed")).Return(nil, packed, nil)
+		pool.EXPECT().GetFromChain(gomock.Any()).Return(nil, nil, errors.New("tx not found")).AnyTimes()
+
+		hashes := []string{common.Base58Encode([]byte("irreversible")), common.Base58Encode([]byte("packed")), common.Base58Encode([]byte("unknown"))}
+		res, err := as.GetTxReceiptsByHashes(context.Background(), &rpcpb.TxHashesRequest{Hashes: hashes})
+		So(err, ShouldBeNil)
+		So(len(res.Receipts), ShouldEqual, 2)
+		So(res.Receipts[0].TxHash, ShouldEqual, hashes[0])
+		So(res.Receipts[1].TxHash, ShouldEqual, hashes[1])
+		So(res.NotFound, ShouldResemble, hashes[2:])
+
+		res, err = as.GetTxReceiptsByHashes(context.Background(), &rpcpb.TxHashesRequest{Hashes: []string{"0OIl"}})
+		So(err, ShouldBeNil)
+		So(len(res.Receipts), ShouldEqual, 0)
+		So(res.NotFound, ShouldResemble, []string{"0OIl"})
+
+		_, err = as.GetTxReceiptsByHashes(context.Background(), &rpcpb.TxHashesRequest{Hashes: append(hashes, hashes[0])})
+		So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+	})
+}
+
+// fakeHeadCache implements the Head of BlockCache with the parents of head node
+type fakeHeadCache struct {
+	blockcache.BlockCache
+	head *blockcache.BlockCacheNode
+}
+
+func (bc *fakeHeadCache) Head() *blockcache.BlockCacheNode {
+	return bc.head
+}
+
+// genRatioCache returns a block cache whose blocks have the txs of gas ratios, the last block is the head
+func genRatioCache(publisher string, ratios ...[]int64) *fakeHeadCache {
+	var head *blockcache.BlockCacheNode
+	for i, rs := range ratios {
+		blk := &block.Block{Head: &block.BlockHead{Number: int64(i)}}
+		for _, r := range rs {
+			t := tx.NewTx(nil, nil, 1000000, r, 0, 0, 0)
+			t.Publisher = publisher
+			blk.Txs = append(blk.Txs, t)
+		}
+		node := blockcache.NewBCN(nil, blk)
+		node.SetParent(head)
+		head = node
+	}
+	return &fakeHeadCache{head: head}
+}
+
+func TestEstimateTransaction(t *testing.T) {
+	Convey("test estimate transaction", t, func() {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		bv := core_mock.NewMockBaseVariable(ctl)
+		bv.EXPECT().Config().Return(&common.Config{RPC: &common.RPCConfig{ExecTx: false}}).AnyTimes()
+		as := &APIService{bv: bv, bc: genRatioCache("alice", []int64{300}, []int64{100, 200})}
+
+		var tried *tx.Tx
+		try := func(usage int64, ram int64) func(*tx.Tx) (*tx.TxReceipt, error) {
+			return func(t *tx.Tx) (*tx.TxReceipt, error) {
+				tried = t
+				r := tx.NewTxReceipt(t.Hash())
+				r.GasUsage = usage
+				r.RAMUsage["alice"] = ram
+				return r, nil
+			}
+		}
+		gas := func(value int64) *common.Fixed {
+			return &common.Fixed{Value: value, Decimal: 2}
+		}
+		newTx := func(ratio int64) *tx.Tx {
+			t := tx.NewTx(nil, nil, 1, ratio, 0, 0, 0)
+			t.Publisher = "alice"
+			return t
+		}
+
+		Convey("exec tx disabled", func() {
+			_, err := as.EstimateTransaction(context.Background(), &rpcpb.TransactionRequest{})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "The node has't enabled this method")
+		})
+
+		Convey("gas limit and ratio of try", func() {
+			_, err := as.estimateTransaction(newTx(50), gas(1000000), 0, try(1000, 0))
+			So(err, ShouldBeNil)
+			So(tried.GasLimit, ShouldEqual, 1000000)
+			So(tried.GasRatio, ShouldEqual, tx.MinGasRatio)
+
+			_, err = as.estimateTransaction(newTx(20000), gas(100), 0, try(1000, 0))
+			So(err, ShouldBeNil)
+			So(tried.GasLimit, ShouldEqual, tx.MinGasLimit)
+			So(tried.GasRatio, ShouldEqual, tx.MaxGasRatio)
+
+			_, err = as.estimateTransaction(newTx(200), gas(tx.MaxGasLimit*10), 0, try(1000, 0))
+			So(err, ShouldBeNil)
+			So(tried.GasLimit, ShouldEqual, tx.MaxGasLimit)
+			So(tried.GasRatio, ShouldEqual, 200)
+		})
+
+		Convey("suggested gas limit", func() {
+			res, err := as.estimateTransaction(newTx(100), gas(1000000), 100, try(700001, 50))
+			So(err, ShouldBeNil)
+			So(res.GasUsage, ShouldEqual, 7000.01)
+			// 700001 * 1.2 is rounded down
+			So(res.GasLimit, ShouldEqual, 8400.01)
+			So(res.GasRatio, ShouldEqual, 2)
+			So(res.PublisherGas, ShouldEqual, 10000)
+			So(res.PublisherRam, ShouldEqual, 100)
+			So(res.RamUsage, ShouldResemble, map[string]int64{"alice": 50})
+			So(res.GasEnough, ShouldBeTrue)
+			So(res.RamEnough, ShouldBeTrue)
+
+			res, err = as.estimateTransaction(newTx(100), gas(840000), 49, try(700001, 50))
+			So(err, ShouldBeNil)
+			So(res.GasEnough, ShouldBeFalse)
+			So(res.RamEnough, ShouldBeFalse)
+
+			res, err = as.estimateTransaction(newTx(100), gas(1000000), 0, try(1000, 0))
+			So(err, ShouldBeNil)
+			So(res.GasLimit, ShouldEqual, float64(tx.MinGasLimit)/100)
+			So(res.GasEnough, ShouldBeTrue)
+
+			res, err = as.estimateTransaction(newTx(100), gas(tx.MaxGasLimit), 0, try(tx.MaxGasLimit, 0))
+			So(err, ShouldBeNil)
+			So(res.GasLimit, ShouldEqual, float64(tx.MaxGasLimit)/100)
+			So(res.GasEnough, ShouldBeTrue)
+		})
+
+		Convey("try failed", func() {
+			_, err := as.estimateTransaction(newTx(100), gas(1000000), 0, func(*tx.Tx) (*tx.TxReceipt, error) {
+				return nil, errors.New("out of gas")
+			})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "out of gas")
+		})
+	})
+}
+
+func TestRecentGasRatio(t *testing.T) {
+	Convey("test recent gas ratio", t, func() {
+		as := &APIService{bc: genRatioCache("alice")}
+		So(as.recentGasRatio(), ShouldEqual, float64(tx.MinGasRatio)/100)
+
+		as.bc = genRatioCache("base.iost", []int64{500})
+		So(as.recentGasRatio(), ShouldEqual, float64(tx.MinGasRatio)/100)
+
+		as.bc = genRatioCache("alice", []int64{100, 400}, []int64{300}, []int64{200, 100})
+		So(as.recentGasRatio(), ShouldEqual, 2)
+
+		// only the txs of recent blocks are counted
+		ratios := [][]int64{{10000}, {10000}, {10000}}
+		for i := 0; i < gasRatioBlocks; i++ {
+			ratios = append(ratios, []int64{100})
+		}
+		as.bc = genRatioCache("alice", ratios...)
+		So(as.recentGasRatio(), ShouldEqual, 1)
+	})
+}
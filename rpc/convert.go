@@ -62,6 +62,7 @@ func toPbTx(t *tx.Tx, tr *tx.TxReceipt) *rpcpb.Transaction {
 		Publisher:  t.Publisher,
 		ReferredTx: common.Base58Encode(t.ReferredTx),
 		TxReceipt:  toPbTxReceipt(tr),
+		ReplacedTx: common.Base58Encode(t.ReplacedTx),
 	}
 	for _, a := range t.Actions {
 		ret.Actions = append(ret.Actions, toPbAction(a))
@@ -176,6 +177,7 @@ func toCoreTx(t *rpcpb.TransactionRequest) *tx.Tx {
 		ChainID:    t.ChainId,
 		Signers:    t.Signers,
 		Publisher:  t.Publisher,
+		ReplacedTx: common.Base58Decode(t.ReplacedTx),
 	}
 	for _, a := range t.Actions {
 		ret.Actions = append(ret.Actions, &tx.Action{
@@ -0,0 +1,553 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/rpc/pb"
+	"github.com/iost-official/go-iost/vm/database"
+	"github.com/iost-official/go-iost/vm/host"
+)
+
+const (
+	// the method name of graphql requests checked by the limiter
+	graphqlMethod = "GraphQL"
+	// the max depth of a graphql query if not configured
+	defaultGraphQLMaxDepth = 10
+	// the max complexity of a graphql query if not configured
+	defaultGraphQLMaxComplexity = 50000
+)
+
+var errGraphQLComplexity = errors.New("query exceeds the max complexity")
+
+const graphqlSchema = `
+scalar Long
+
+schema {
+	query: Query
+}
+
+type Query {
+	chainInfo: ChainInfo!
+	block(number: Long, hash: String): Block
+	tx(hash: String!): Tx
+	receipt(hash: String!): Receipt
+	account(name: String!): Account
+}
+
+type ChainInfo {
+	headBlock: Block!
+	libBlock: Block!
+}
+
+type Block {
+	number: Long!
+	hash: String!
+	parentHash: String!
+	witness: String!
+	time: Long!
+	status: String!
+	txCount: Int!
+	txs: [Tx!]!
+}
+
+type Tx {
+	hash: String!
+	status: String!
+	blockNumber: Long
+	time: Long!
+	expiration: Long!
+	gasRatio: Float!
+	gasLimit: Float!
+	signers: [String!]!
+	actions: [Action!]!
+	publisher: Account!
+	receipt: Receipt
+}
+
+type Action {
+	contract: String!
+	actionName: String!
+	data: String!
+}
+
+type Receipt {
+	txHash: String!
+	gasUsage: Float!
+	ramUsage: [RAMUsage!]!
+	statusCode: String!
+	message: String!
+	returns: [String!]!
+	receipts: [ContractReceipt!]!
+}
+
+type RAMUsage {
+	account: String!
+	usage: Long!
+}
+
+type ContractReceipt {
+	funcName: String!
+	content: String!
+}
+
+type Account {
+	name: String!
+	balance: Float!
+	tokenBalance(token: String!): Float!
+	gas: Float!
+	ram: RAMInfo!
+	votes: [VoteInfo!]!
+	producerVotes: Float
+}
+
+type RAMInfo {
+	available: Long!
+	used: Long!
+	total: Long!
+}
+
+type VoteInfo {
+	option: String!
+	votes: Float!
+	clearedVotes: Float!
+}
+`
+
+// Long is the graphql scalar of int64, which is decoded from number or string.
+type Long int64
+
+// ImplementsGraphQLType implements graphql.Unmarshaler.
+func (Long) ImplementsGraphQLType(name string) bool {
+	return name == "Long"
+}
+
+// UnmarshalGraphQL implements graphql.Unmarshaler.
+func (l *Long) UnmarshalGraphQL(input interface{}) error {
+	switch v := input.(type) {
+	case int32:
+		*l = Long(v)
+	case float64:
+		*l = Long(v)
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return err
+		}
+		*l = Long(n)
+	default:
+		return fmt.Errorf("invalid Long %v", input)
+	}
+	return nil
+}
+
+type graphqlStateKey struct{}
+
+// graphqlState is the state db of a query, so the accounts of the query are read from the same block.
+// It also counts the complexity of the query, which is the number of the objects and state reads resolved.
+type graphqlState struct {
+	once       sync.Once
+	db         *database.Visitor
+	blk        *block.Block
+	err        error
+	complexity int64
+}
+
+func withGraphQLState(ctx context.Context) context.Context {
+	return context.WithValue(ctx, graphqlStateKey{}, &graphqlState{})
+}
+
+type graphqlResolver struct {
+	as            *APIService
+	maxComplexity int64
+}
+
+func newGraphQLSchema(as *APIService, maxDepth int, maxComplexity int) (*graphql.Schema, error) {
+	if maxDepth <= 0 {
+		maxDepth = defaultGraphQLMaxDepth
+	}
+	if maxComplexity <= 0 {
+		maxComplexity = defaultGraphQLMaxComplexity
+	}
+	r := &graphqlResolver{as: as, maxComplexity: int64(maxComplexity)}
+	return graphql.ParseSchema(graphqlSchema, r, graphql.MaxDepth(maxDepth))
+}
+
+// charge adds n to the complexity of the query, and returns the error if it exceeds the max complexity.
+// The max depth can't stop the wide queries, such as the aliases of many blocks or the txs of large blocks.
+func (r *graphqlResolver) charge(ctx context.Context, n int) error {
+	s, ok := ctx.Value(graphqlStateKey{}).(*graphqlState)
+	if !ok {
+		return nil
+	}
+	if atomic.AddInt64(&s.complexity, int64(n)) > r.maxComplexity {
+		return errGraphQLComplexity
+	}
+	return nil
+}
+
+func (r *graphqlResolver) stateDB(ctx context.Context) (*database.Visitor, *block.Block, error) {
+	s, ok := ctx.Value(graphqlStateKey{}).(*graphqlState)
+	if !ok {
+		s = &graphqlState{}
+	}
+	s.once.Do(func() {
+		var bcn *blockcache.BlockCacheNode
+		s.db, bcn, s.err = r.as.getStateDBVisitor(true)
+		if s.err == nil {
+			s.blk = bcn.Block
+		}
+	})
+	return s.db, s.blk, s.err
+}
+
+func (r *graphqlResolver) ChainInfo(ctx context.Context) (*chainInfoResolver, error) {
+	if err := r.charge(ctx, 2); err != nil {
+		return nil, err
+	}
+	return &chainInfoResolver{r: r}, nil
+}
+
+func (r *graphqlResolver) Block(ctx context.Context, args struct {
+	Number *Long
+	Hash   *string
+}) (*blockResolver, error) {
+	if err := r.charge(ctx, 1); err != nil {
+		return nil, err
+	}
+	var (
+		blk *block.Block
+		err error
+	)
+	status := rpcpb.BlockResponse_IRREVERSIBLE
+	switch {
+	case args.Hash != nil:
+		hash := common.Base58Decode(*args.Hash)
+		blk, err = r.as.blockchain.GetBlockByHash(hash)
+		if err == block.ErrBlockPruned {
+			return nil, err
+		}
+		if err != nil {
+			status = rpcpb.BlockResponse_PENDING
+			blk, err = r.as.bc.GetBlockByHash(hash)
+		}
+	case args.Number != nil:
+		blk, err = r.as.blockchain.GetBlockByNumber(int64(*args.Number))
+		if err == block.ErrBlockPruned {
+			return nil, err
+		}
+		if err != nil {
+			status = rpcpb.BlockResponse_PENDING
+			blk, err = r.as.bc.GetBlockByNumber(int64(*args.Number))
+		}
+	default:
+		return nil, errors.New("number or hash is required")
+	}
+	if err != nil {
+		return nil, nil
+	}
+	return &blockResolver{r: r, blk: blk, status: status}, nil
+}
+
+func (r *graphqlResolver) Tx(ctx context.Context, args struct{ Hash string }) (*txResolver, error) {
+	if err := r.charge(ctx, 1); err != nil {
+		return nil, err
+	}
+	hash := common.Base58Decode(args.Hash)
+	if t, err := r.as.blockchain.GetTx(hash); err == nil {
+		ret := &txResolver{r: r, t: t, status: rpcpb.TransactionResponse_IRREVERSIBLE, blockNumber: -1}
+		ret.receipt, _ = r.as.blockchain.GetReceiptByTxHash(hash)
+		if n, err := r.as.blockchain.GetBlockNumberByTxHash(hash); err == nil {
+			ret.blockNumber = n
+		}
+		return ret, nil
+	}
+	if t, receipt, err := r.as.txpool.GetFromChain(hash); err == nil {
+		return &txResolver{r: r, t: t, receipt: receipt, status: rpcpb.TransactionResponse_PACKED, blockNumber: -1}, nil
+	}
+	if t, err := r.as.txpool.GetFromPending(hash); err == nil {
+		return &txResolver{r: r, t: t, status: rpcpb.TransactionResponse_PENDING, blockNumber: -1}, nil
+	}
+	return nil, nil
+}
+
+func (r *graphqlResolver) Receipt(ctx context.Context, args struct{ Hash string }) (*receiptResolver, error) {
+	if err := r.charge(ctx, 1); err != nil {
+		return nil, err
+	}
+	hash := common.Base58Decode(args.Hash)
+	receipt, err := r.as.blockchain.GetReceiptByTxHash(hash)
+	if err != nil {
+		_, receipt, err = r.as.txpool.GetFromChain(hash)
+		if err != nil {
+			return nil, nil
+		}
+	}
+	return &receiptResolver{r: r, receipt: receipt}, nil
+}
+
+func (r *graphqlResolver) Account(ctx context.Context, args struct{ Name string }) (*accountResolver, error) {
+	if err := r.charge(ctx, 1); err != nil {
+		return nil, err
+	}
+	db, blk, err := r.stateDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if acc, _ := host.ReadAuth(db, args.Name); acc == nil {
+		return nil, nil
+	}
+	return &accountResolver{r: r, db: db, blk: blk, name: args.Name}, nil
+}
+
+type chainInfoResolver struct {
+	r *graphqlResolver
+}
+
+func (c *chainInfoResolver) HeadBlock() *blockResolver {
+	return &blockResolver{r: c.r, blk: c.r.as.bc.Head().Block, status: rpcpb.BlockResponse_PENDING}
+}
+
+func (c *chainInfoResolver) LibBlock() *blockResolver {
+	return &blockResolver{r: c.r, blk: c.r.as.bc.LinkedRoot().Block, status: rpcpb.BlockResponse_IRREVERSIBLE}
+}
+
+type blockResolver struct {
+	r      *graphqlResolver
+	blk    *block.Block
+	status rpcpb.BlockResponse_Status
+}
+
+func (b *blockResolver) Number() Long       { return Long(b.blk.Head.Number) }
+func (b *blockResolver) Hash() string       { return common.Base58Encode(b.blk.HeadHash()) }
+func (b *blockResolver) ParentHash() string { return common.Base58Encode(b.blk.Head.ParentHash) }
+func (b *blockResolver) Witness() string    { return b.blk.Head.Witness }
+func (b *blockResolver) Time() Long         { return Long(b.blk.Head.Time) }
+func (b *blockResolver) Status() string     { return b.status.String() }
+func (b *blockResolver) TxCount() int32     { return int32(len(b.blk.Txs)) }
+
+func (b *blockResolver) Txs(ctx context.Context) ([]*txResolver, error) {
+	if err := b.r.charge(ctx, len(b.blk.Txs)); err != nil {
+		return nil, err
+	}
+	status := rpcpb.TransactionResponse_PACKED
+	if b.status == rpcpb.BlockResponse_IRREVERSIBLE {
+		status = rpcpb.TransactionResponse_IRREVERSIBLE
+	}
+	ret := make([]*txResolver, 0, len(b.blk.Txs))
+	for i, t := range b.blk.Txs {
+		tr := &txResolver{r: b.r, t: t, status: status, blockNumber: b.blk.Head.Number}
+		if i < len(b.blk.Receipts) {
+			tr.receipt = b.blk.Receipts[i]
+		}
+		ret = append(ret, tr)
+	}
+	return ret, nil
+}
+
+type txResolver struct {
+	r           *graphqlResolver
+	t           *tx.Tx
+	receipt     *tx.TxReceipt
+	status      rpcpb.TransactionResponse_Status
+	blockNumber int64
+}
+
+func (t *txResolver) Hash() string      { return common.Base58Encode(t.t.Hash()) }
+func (t *txResolver) Status() string    { return t.status.String() }
+func (t *txResolver) Time() Long        { return Long(t.t.Time) }
+func (t *txResolver) Expiration() Long  { return Long(t.t.Expiration) }
+func (t *txResolver) GasRatio() float64 { return float64(t.t.GasRatio) / 100 }
+func (t *txResolver) GasLimit() float64 { return float64(t.t.GasLimit) / 100 }
+func (t *txResolver) Signers() []string { return t.t.Signers }
+
+func (t *txResolver) BlockNumber() *Long {
+	if t.blockNumber < 0 {
+		return nil
+	}
+	n := Long(t.blockNumber)
+	return &n
+}
+
+func (t *txResolver) Actions(ctx context.Context) ([]*actionResolver, error) {
+	if err := t.r.charge(ctx, len(t.t.Actions)); err != nil {
+		return nil, err
+	}
+	ret := make([]*actionResolver, 0, len(t.t.Actions))
+	for _, a := range t.t.Actions {
+		ret = append(ret, &actionResolver{a})
+	}
+	return ret, nil
+}
+
+func (t *txResolver) Publisher(ctx context.Context) (*accountResolver, error) {
+	if err := t.r.charge(ctx, 1); err != nil {
+		return nil, err
+	}
+	db, blk, err := t.r.stateDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &accountResolver{r: t.r, db: db, blk: blk, name: t.t.Publisher}, nil
+}
+
+func (t *txResolver) Receipt() *receiptResolver {
+	if t.receipt == nil {
+		return nil
+	}
+	return &receiptResolver{r: t.r, receipt: t.receipt}
+}
+
+type actionResolver struct {
+	a *tx.Action
+}
+
+func (a *actionResolver) Contract() string   { return a.a.Contract }
+func (a *actionResolver) ActionName() string { return a.a.ActionName }
+func (a *actionResolver) Data() string       { return a.a.Data }
+
+type receiptResolver struct {
+	r       *graphqlResolver
+	receipt *tx.TxReceipt
+}
+
+func (r *receiptResolver) TxHash() string    { return common.Base58Encode(r.receipt.TxHash) }
+func (r *receiptResolver) GasUsage() float64 { return float64(r.receipt.GasUsage) / 100 }
+func (r *receiptResolver) StatusCode() string {
+	return rpcpb.TxReceipt_StatusCode(r.receipt.Status.Code).String()
+}
+func (r *receiptResolver) Message() string   { return r.receipt.Status.Message }
+func (r *receiptResolver) Returns() []string { return r.receipt.Returns }
+
+func (r *receiptResolver) RAMUsage(ctx context.Context) ([]*ramUsageResolver, error) {
+	if err := r.r.charge(ctx, len(r.receipt.RAMUsage)); err != nil {
+		return nil, err
+	}
+	ret := make([]*ramUsageResolver, 0, len(r.receipt.RAMUsage))
+	for account, usage := range r.receipt.RAMUsage {
+		ret = append(ret, &ramUsageResolver{account: account, usage: usage})
+	}
+	return ret, nil
+}
+
+func (r *receiptResolver) Receipts(ctx context.Context) ([]*contractReceiptResolver, error) {
+	if err := r.r.charge(ctx, len(r.receipt.Receipts)); err != nil {
+		return nil, err
+	}
+	ret := make([]*contractReceiptResolver, 0, len(r.receipt.Receipts))
+	for _, rec := range r.receipt.Receipts {
+		ret = append(ret, &contractReceiptResolver{rec})
+	}
+	return ret, nil
+}
+
+type ramUsageResolver struct {
+	account string
+	usage   int64
+}
+
+func (r *ramUsageResolver) Account() string { return r.account }
+func (r *ramUsageResolver) Usage() Long     { return Long(r.usage) }
+
+type contractReceiptResolver struct {
+	r *tx.Receipt
+}
+
+func (r *contractReceiptResolver) FuncName() string { return r.r.FuncName }
+func (r *contractReceiptResolver) Content() string  { return r.r.Content }
+
+type accountResolver struct {
+	r    *graphqlResolver
+	db   *database.Visitor
+	blk  *block.Block
+	name string
+}
+
+func (a *accountResolver) Name() string { return a.name }
+
+func (a *accountResolver) Balance(ctx context.Context) (float64, error) {
+	return a.TokenBalance(ctx, struct{ Token string }{"iost"})
+}
+
+func (a *accountResolver) TokenBalance(ctx context.Context, args struct{ Token string }) (float64, error) {
+	if err := a.r.charge(ctx, 1); err != nil {
+		return 0, err
+	}
+	balance := a.db.TokenBalanceFixed(args.Token, a.name).ToFloat()
+	frozen := a.db.AllFreezedTokenBalanceFixed(args.Token, a.name)
+	unfrozen, _ := a.r.as.getUnfrozenToken(frozen, a.blk.Head.Time)
+	return balance + unfrozen, nil
+}
+
+func (a *accountResolver) Gas(ctx context.Context) (float64, error) {
+	if err := a.r.charge(ctx, 1); err != nil {
+		return 0, err
+	}
+	return a.db.PGasAtTime(a.name, a.blk.Head.Time).Add(a.db.TGas(a.name)).ToFloat(), nil
+}
+
+func (a *accountResolver) RAM(ctx context.Context) (*ramInfoResolver, error) {
+	if err := a.r.charge(ctx, 1); err != nil {
+		return nil, err
+	}
+	info := a.db.RAMHandler.GetAccountRAMInfo(a.name)
+	return &ramInfoResolver{available: info.Available, used: info.Used, total: info.Total}, nil
+}
+
+func (a *accountResolver) Votes(ctx context.Context) ([]*voteInfoResolver, error) {
+	if err := a.r.charge(ctx, 1); err != nil {
+		return nil, err
+	}
+	votes := a.db.VoteHandler.GetAccountVoteInfo(a.name)
+	ret := make([]*voteInfoResolver, 0, len(votes))
+	for _, v := range votes {
+		ret = append(ret, &voteInfoResolver{
+			option:       v.Option,
+			votes:        v.Votes.ToFloat(),
+			clearedVotes: v.ClearedVotes.ToFloat(),
+		})
+	}
+	return ret, nil
+}
+
+// ProducerVotes returns nil if the account is not a producer.
+func (a *accountResolver) ProducerVotes(ctx context.Context) (*float64, error) {
+	if err := a.r.charge(ctx, 1); err != nil {
+		return nil, err
+	}
+	votes, err := a.db.VoteHandler.GetProducerVotes(a.name)
+	if err != nil || votes == nil {
+		return nil, nil
+	}
+	v := votes.ToFloat()
+	return &v, nil
+}
+
+type ramInfoResolver struct {
+	available int64
+	used      int64
+	total     int64
+}
+
+func (r *ramInfoResolver) Available() Long { return Long(r.available) }
+func (r *ramInfoResolver) Used() Long      { return Long(r.used) }
+func (r *ramInfoResolver) Total() Long     { return Long(r.total) }
+
+type voteInfoResolver struct {
+	option       string
+	votes        float64
+	clearedVotes float64
+}
+
+func (v *voteInfoResolver) Option() string        { return v.option }
+func (v *voteInfoResolver) Votes() float64        { return v.votes }
+func (v *voteInfoResolver) ClearedVotes() float64 { return v.clearedVotes }
@@ -0,0 +1,201 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	core_mock "github.com/iost-official/go-iost/core/mocks"
+	"github.com/iost-official/go-iost/core/tx"
+	txpool_mock "github.com/iost-official/go-iost/core/txpool/mock"
+	"github.com/iost-official/go-iost/crypto"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeBlockCache implements the methods of BlockCache called by the graphql resolvers
+type fakeBlockCache struct {
+	blockcache.BlockCache
+	head   *block.Block
+	lib    *block.Block
+	blocks []*block.Block
+}
+
+func (bc *fakeBlockCache) Head() *blockcache.BlockCacheNode {
+	return &blockcache.BlockCacheNode{Block: bc.head}
+}
+
+func (bc *fakeBlockCache) LinkedRoot() *blockcache.BlockCacheNode {
+	return &blockcache.BlockCacheNode{Block: bc.lib}
+}
+
+func (bc *fakeBlockCache) GetBlockByNumber(number int64) (*block.Block, error) {
+	for _, blk := range bc.blocks {
+		if blk.Head.Number == number {
+			return blk, nil
+		}
+	}
+	return nil, errors.New("block not found")
+}
+
+func (bc *fakeBlockCache) GetBlockByHash(hash []byte) (*block.Block, error) {
+	for _, blk := range bc.blocks {
+		if string(blk.HeadHash()) == string(hash) {
+			return blk, nil
+		}
+	}
+	return nil, errors.New("block not found")
+}
+
+func genGraphQLTx(a *account.KeyPair, publisher string, contract string, gasRatio int64) *tx.Tx {
+	t := tx.NewTx([]*tx.Action{tx.NewAction(contract, "transfer", "[]")}, nil, 1000000, gasRatio, time.Now().UnixNano()+int64(time.Minute), 0, tx.ChainID)
+	t, _ = tx.SignTx(t, publisher, []*account.KeyPair{a})
+	return t
+}
+
+func genGraphQLBlock(a *account.KeyPair, number int64, txCount int) *block.Block {
+	blk := &block.Block{
+		Head: &block.BlockHead{
+			Version:    1,
+			ParentHash: []byte("parent"),
+			Number:     number,
+			Witness:    "witness",
+			Time:       number * int64(time.Second),
+		},
+	}
+	for i := 0; i < txCount; i++ {
+		t := genGraphQLTx(a, "alice", "token.iost", 100)
+		r := tx.NewTxReceipt(t.Hash())
+		r.GasUsage = 200
+		blk.Txs = append(blk.Txs, t)
+		blk.Receipts = append(blk.Receipts, r)
+	}
+	blk.CalculateHeadHash()
+	return blk
+}
+
+func TestGraphQL(t *testing.T) {
+	Convey("test graphql", t, func() {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		a, _ := account.NewKeyPair(nil, crypto.Secp256k1)
+		lib := genGraphQLBlock(a, 5, 2)
+		head := genGraphQLBlock(a, 6, 1)
+		chain := core_mock.NewMockChain(ctl)
+		chain.EXPECT().GetBlockByNumber(int64(5)).Return(lib, nil).AnyTimes()
+		chain.EXPECT().GetBlockByNumber(int64(6)).Return(nil, errors.New("block not found")).AnyTimes()
+		chain.EXPECT().GetBlockByNumber(int64(7)).Return(nil, errors.New("block not found")).AnyTimes()
+		chain.EXPECT().GetBlockByNumber(int64(1)).Return(nil, block.ErrBlockPruned).AnyTimes()
+		chain.EXPECT().GetBlockByHash(head.HeadHash()).Return(nil, errors.New("block not found")).AnyTimes()
+		pool := txpool_mock.NewMockTxPool(ctl)
+		as := &APIService{
+			bc:         &fakeBlockCache{head: head, lib: lib, blocks: []*block.Block{head}},
+			blockchain: chain,
+			txpool:     pool,
+		}
+		schema, err := newGraphQLSchema(as, 0, 0)
+		So(err, ShouldBeNil)
+		query := func(q string) (map[string]interface{}, int) {
+			resp := schema.Exec(withGraphQLState(context.Background()), q, "", nil)
+			var data map[string]interface{}
+			if resp.Data != nil {
+				So(json.Unmarshal(resp.Data, &data), ShouldBeNil)
+			}
+			return data, len(resp.Errors)
+		}
+
+		Convey("chain info", func() {
+			data, errs := query(`{ chainInfo { headBlock { number status } libBlock { number hash status } } }`)
+			So(errs, ShouldEqual, 0)
+			info := data["chainInfo"].(map[string]interface{})
+			So(info["headBlock"], ShouldResemble, map[string]interface{}{"number": 6.0, "status": "PENDING"})
+			So(info["libBlock"], ShouldResemble, map[string]interface{}{
+				"number": 5.0,
+				"hash":   common.Base58Encode(lib.HeadHash()),
+				"status": "IRREVERSIBLE",
+			})
+		})
+
+		Convey("block", func() {
+			data, errs := query(`{ block(number: "5") { number witness time txCount txs { hash status blockNumber gasRatio receipt { txHash gasUsage statusCode } } } }`)
+			So(errs, ShouldEqual, 0)
+			blk := data["block"].(map[string]interface{})
+			So(blk["number"], ShouldEqual, 5)
+			So(blk["witness"], ShouldEqual, "witness")
+			So(blk["time"], ShouldEqual, 5000000000)
+			So(blk["txCount"], ShouldEqual, 2)
+			txs := blk["txs"].([]interface{})
+			So(len(txs), ShouldEqual, 2)
+			So(txs[0], ShouldResemble, map[string]interface{}{
+				"hash":        common.Base58Encode(lib.Txs[0].Hash()),
+				"status":      "IRREVERSIBLE",
+				"blockNumber": 5.0,
+				"gasRatio":    1.0,
+				"receipt": map[string]interface{}{
+					"txHash":     common.Base58Encode(lib.Txs[0].Hash()),
+					"gasUsage":   2.0,
+					"statusCode": "SUCCESS",
+				},
+			})
+
+			data, errs = query(`{ block(number: 6) { number status } }`)
+			So(errs, ShouldEqual, 0)
+			So(data["block"], ShouldResemble, map[string]interface{}{"number": 6.0, "status": "PENDING"})
+
+			data, errs = query(`{ block(hash: "` + common.Base58Encode(head.HeadHash()) + `") { number } }`)
+			So(errs, ShouldEqual, 0)
+			So(data["block"], ShouldResemble, map[string]interface{}{"number": 6.0})
+
+			data, errs = query(`{ block(number: 7) { number } }`)
+			So(errs, ShouldEqual, 0)
+			So(data["block"], ShouldBeNil)
+
+			_, errs = query(`{ block(number: 1) { number } }`)
+			So(errs, ShouldEqual, 1)
+			_, errs = query(`{ block { number } }`)
+			So(errs, ShouldEqual, 1)
+		})
+
+		Convey("tx", func() {
+			pending := genGraphQLTx(a, "bob", "vote.iost", 200)
+			hash := pending.Hash()
+			chain.EXPECT().GetTx(hash).Return(nil, errors.New("tx not found"))
+			pool.EXPECT().GetFromChain(hash).Return(nil, nil, errors.New("tx not found"))
+			pool.EXPECT().GetFromPending(hash).Return(pending, nil)
+			data, errs := query(`{ tx(hash: "` + common.Base58Encode(hash) + `") { status blockNumber gasRatio actions { contract actionName data } receipt { gasUsage } } }`)
+			So(errs, ShouldEqual, 0)
+			So(data["tx"], ShouldResemble, map[string]interface{}{
+				"status":      "PENDING",
+				"blockNumber": nil,
+				"gasRatio":    2.0,
+				"actions":     []interface{}{map[string]interface{}{"contract": "vote.iost", "actionName": "transfer", "data": "[]"}},
+				"receipt":     nil,
+			})
+		})
+
+		Convey("max depth", func() {
+			schema, err := newGraphQLSchema(as, 3, 0)
+			So(err, ShouldBeNil)
+			resp := schema.Exec(context.Background(), `{ block(number: 5) { txs { receipt { txHash } } } }`, "", nil)
+			So(len(resp.Errors), ShouldBeGreaterThan, 0)
+		})
+
+		Convey("max complexity", func() {
+			schema, err := newGraphQLSchema(as, 0, 4)
+			So(err, ShouldBeNil)
+			resp := schema.Exec(withGraphQLState(context.Background()), `{ block(number: 5) { txs { hash } } }`, "", nil)
+			So(len(resp.Errors), ShouldEqual, 0)
+			resp = schema.Exec(withGraphQLState(context.Background()), `{ block(number: 5) { txs { actions { contract } } } }`, "", nil)
+			So(len(resp.Errors), ShouldEqual, 1)
+			So(resp.Errors[0].Message, ShouldEqual, errGraphQLComplexity.Error())
+			resp = schema.Exec(withGraphQLState(context.Background()), `{ a: block(number: 5) { number } b: block(number: 5) { number } c: block(number: 5) { number } d: block(number: 5) { number } e: block(number: 5) { number } }`, "", nil)
+			So(len(resp.Errors), ShouldEqual, 1)
+		})
+	})
+}
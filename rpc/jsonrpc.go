@@ -0,0 +1,490 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/websocket"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/rpc/pb"
+	"google.golang.org/grpc/status"
+)
+
+// The JSON-RPC 2.0 server mirrors every method of ApiService over HTTP and WebSocket. The methods
+// are resolved from ApiServiceClient generated from rpc.proto, and the calls go through the grpc
+// server like the gateway, so they can't drift from the grpc service.
+//
+// The method name is the name of rpc in lower camel case, e.g. getChainInfo, and the params is
+// the request message in the same json as the body of gateway, or an array of the message.
+// The server streaming methods like subscribe are only available over WebSocket and can't be called
+// by notification, which return the subscription id and send the responses of stream as notifications:
+//
+//	{"jsonrpc":"2.0","method":"subscription","params":{"subscription":"1","result":{...}}}
+//
+// The subscription is closed by {"jsonrpc":"2.0","id":2,"method":"unsubscribe","params":["1"]}.
+
+const (
+	jsonrpcVersion          = "2.0"
+	jsonrpcMaxSubscriptions = 16
+	jsonrpcWriteTimeout     = 10 * time.Second
+
+	jsonrpcSubscriptionMethod = "subscription"
+	jsonrpcUnsubscribeMethod  = "unsubscribe"
+)
+
+// error codes of JSON-RPC 2.0
+const (
+	jsonrpcParseError     = -32700
+	jsonrpcInvalidRequest = -32600
+	jsonrpcMethodNotFound = -32601
+	jsonrpcInvalidParams  = -32602
+	jsonrpcInternalError  = -32603
+	jsonrpcServerError    = -32000
+)
+
+var protoMessageType = reflect.TypeOf((*proto.Message)(nil)).Elem()
+
+type jsonrpcMethod struct {
+	name    string       // the method name of ApiServiceClient
+	request reflect.Type // the type of request message
+	stream  bool
+}
+
+var jsonrpcMethods = newJSONRPCMethods()
+
+func newJSONRPCMethods() map[string]*jsonrpcMethod {
+	methods := make(map[string]*jsonrpcMethod)
+	t := reflect.TypeOf((*rpcpb.ApiServiceClient)(nil)).Elem()
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		// func(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response or Stream, error)
+		if m.Type.NumIn() != 3 || m.Type.NumOut() != 2 || m.Type.In(1).Kind() != reflect.Ptr {
+			continue
+		}
+		name := []rune(m.Name)
+		name[0] = unicode.ToLower(name[0])
+		methods[string(name)] = &jsonrpcMethod{
+			name:    m.Name,
+			request: m.Type.In(1).Elem(),
+			stream:  !m.Type.Out(0).Implements(protoMessageType),
+		}
+	}
+	return methods
+}
+
+type jsonrpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *jsonrpcError) Error() string {
+	return fmt.Sprintf("jsonrpc error %v: %v", e.Code, e.Message)
+}
+
+type jsonrpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *jsonrpcError   `json:"error,omitempty"`
+}
+
+type jsonrpcNotification struct {
+	JSONRPC string                    `json:"jsonrpc"`
+	Method  string                    `json:"method"`
+	Params  jsonrpcSubscriptionResult `json:"params"`
+}
+
+type jsonrpcSubscriptionResult struct {
+	Subscription string          `json:"subscription"`
+	Result       json.RawMessage `json:"result"`
+}
+
+func newJSONRPCError(code int, format string, args ...interface{}) *jsonrpcError {
+	return &jsonrpcError{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// jsonrpcHandler serves JSON-RPC 2.0 over HTTP POST and WebSocket
+type jsonrpcHandler struct {
+	client         rpcpb.ApiServiceClient
+	allowOrigins   []string
+	maxRequestSize int64
+	upgrader       websocket.Upgrader
+	marshaler      *jsonpb.Marshaler
+	unmarshaler    *jsonpb.Unmarshaler
+
+	quitCh chan struct{}
+}
+
+func newJSONRPCHandler(client rpcpb.ApiServiceClient, allowOrigins []string, maxRequestSize int, quitCh chan struct{}) *jsonrpcHandler {
+	h := &jsonrpcHandler{
+		client:         client,
+		allowOrigins:   allowOrigins,
+		maxRequestSize: int64(maxRequestSize),
+		marshaler:      &jsonpb.Marshaler{OrigName: true, EmitDefaults: true},
+		unmarshaler:    &jsonpb.Unmarshaler{},
+		quitCh:         quitCh,
+	}
+	h.upgrader = websocket.Upgrader{
+		CheckOrigin: h.checkOrigin,
+	}
+	return h
+}
+
+func (h *jsonrpcHandler) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	for _, o := range h.allowOrigins {
+		if o == "*" || o == origin {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *jsonrpcHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if websocket.IsWebSocketUpgrade(r) {
+		h.serveWebSocket(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, h.maxRequestSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	resp := h.handle(forwardClient(r.Context(), r), body, nil)
+	if resp == nil {
+		// all the requests are notifications
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(resp)
+}
+
+func (h *jsonrpcHandler) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	ws, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		ilog.Debugf("upgrade websocket failed. err=%v", err)
+		return
+	}
+	ws.SetReadLimit(h.maxRequestSize)
+	c := newJSONRPCConn(h, ws, forwardClient(context.Background(), r))
+	defer c.close()
+
+	go func() {
+		select {
+		case <-h.quitCh:
+			c.close()
+		case <-c.ctx.Done():
+		}
+	}()
+	for {
+		_, payload, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		resp := h.handle(c.ctx, payload, c)
+		if resp != nil {
+			if err := c.write(resp); err != nil {
+				return
+			}
+		}
+		// the subscriptions start after their ids are responded
+		c.start()
+	}
+}
+
+// handle returns the response of single or batch request, nil if no response is needed
+func (h *jsonrpcHandler) handle(ctx context.Context, payload []byte, c *jsonrpcConn) []byte {
+	payload = bytes.TrimSpace(payload)
+	if len(payload) == 0 || payload[0] != '[' {
+		return h.marshalResponse(h.handleRequest(ctx, payload, c, false))
+	}
+	var batch []json.RawMessage
+	if err := json.Unmarshal(payload, &batch); err != nil {
+		return h.marshalResponse(h.errorResponse(nil, newJSONRPCError(jsonrpcParseError, "parse error: %v", err)))
+	}
+	if len(batch) == 0 {
+		return h.marshalResponse(h.errorResponse(nil, newJSONRPCError(jsonrpcInvalidRequest, "empty batch")))
+	}
+	resps := make([]*jsonrpcResponse, 0, len(batch))
+	for _, req := range batch {
+		if resp := h.handleRequest(ctx, req, c, true); resp != nil {
+			resps = append(resps, resp)
+		}
+	}
+	if len(resps) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(resps)
+	if err != nil {
+		ilog.Errorf("marshal jsonrpc response failed. err=%v", err)
+		return nil
+	}
+	return b
+}
+
+func (h *jsonrpcHandler) handleRequest(ctx context.Context, payload []byte, c *jsonrpcConn, inBatch bool) *jsonrpcResponse {
+	var req jsonrpcRequest
+	if err := json.Unmarshal(payload, &req); err != nil {
+		if inBatch {
+			return h.errorResponse(nil, newJSONRPCError(jsonrpcInvalidRequest, "invalid request: %v", err))
+		}
+		return h.errorResponse(nil, newJSONRPCError(jsonrpcParseError, "parse error: %v", err))
+	}
+	if req.JSONRPC != jsonrpcVersion || req.Method == "" {
+		return h.errorResponse(req.ID, newJSONRPCError(jsonrpcInvalidRequest, "invalid request"))
+	}
+	result, err := h.call(ctx, &req, c)
+	if req.ID == nil {
+		// notification
+		return nil
+	}
+	if err != nil {
+		return h.errorResponse(req.ID, err)
+	}
+	return &jsonrpcResponse{
+		JSONRPC: jsonrpcVersion,
+		ID:      req.ID,
+		Result:  result,
+	}
+}
+
+func (h *jsonrpcHandler) call(ctx context.Context, req *jsonrpcRequest, c *jsonrpcConn) (json.RawMessage, *jsonrpcError) {
+	if req.Method == jsonrpcUnsubscribeMethod && c != nil {
+		var ids []string
+		if err := json.Unmarshal(req.Params, &ids); err != nil || len(ids) != 1 {
+			return nil, newJSONRPCError(jsonrpcInvalidParams, "invalid params, expect [subscription id]")
+		}
+		return json.RawMessage(strconv.FormatBool(c.unsubscribe(ids[0]))), nil
+	}
+	m, ok := jsonrpcMethods[req.Method]
+	if !ok {
+		return nil, newJSONRPCError(jsonrpcMethodNotFound, "method %v not found", req.Method)
+	}
+	in, err := h.unmarshalParams(m, req.Params)
+	if err != nil {
+		return nil, newJSONRPCError(jsonrpcInvalidParams, "invalid params: %v", err)
+	}
+	if m.stream {
+		if c == nil {
+			return nil, newJSONRPCError(jsonrpcMethodNotFound, "method %v is only available over websocket", req.Method)
+		}
+		if req.ID == nil {
+			// the client can't unsubscribe without the subscription id
+			return nil, newJSONRPCError(jsonrpcInvalidRequest, "method %v can't be called by notification", req.Method)
+		}
+		id, err := c.subscribe(m, in)
+		if err != nil {
+			return nil, err
+		}
+		return json.RawMessage(strconv.Quote(id)), nil
+	}
+	out := reflect.ValueOf(h.client).MethodByName(m.name).Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(in)})
+	if e := out[1].Interface(); e != nil {
+		return nil, newJSONRPCError(jsonrpcServerError, "%v", status.Convert(e.(error)).Message())
+	}
+	result, err := h.marshaler.MarshalToString(out[0].Interface().(proto.Message))
+	if err != nil {
+		return nil, newJSONRPCError(jsonrpcInternalError, "marshal result failed: %v", err)
+	}
+	return json.RawMessage(result), nil
+}
+
+// unmarshalParams decodes the request message from the params, which is the message or an array of it
+func (h *jsonrpcHandler) unmarshalParams(m *jsonrpcMethod, params json.RawMessage) (proto.Message, error) {
+	in := reflect.New(m.request).Interface().(proto.Message)
+	params = bytes.TrimSpace(params)
+	if len(params) > 0 && params[0] == '[' {
+		var arr []json.RawMessage
+		if err := json.Unmarshal(params, &arr); err != nil {
+			return nil, err
+		}
+		switch len(arr) {
+		case 0:
+			params = nil
+		case 1:
+			params = arr[0]
+		default:
+			return nil, fmt.Errorf("expect one request message, got %v", len(arr))
+		}
+	}
+	if len(params) == 0 || string(params) == "null" {
+		return in, nil
+	}
+	if err := h.unmarshaler.Unmarshal(bytes.NewReader(params), in); err != nil {
+		return nil, err
+	}
+	return in, nil
+}
+
+func (h *jsonrpcHandler) errorResponse(id json.RawMessage, err *jsonrpcError) *jsonrpcResponse {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	return &jsonrpcResponse{
+		JSONRPC: jsonrpcVersion,
+		ID:      id,
+		Error:   err,
+	}
+}
+
+func (h *jsonrpcHandler) marshalResponse(resp *jsonrpcResponse) []byte {
+	if resp == nil {
+		return nil
+	}
+	b, err := json.Marshal(resp)
+	if err != nil {
+		ilog.Errorf("marshal jsonrpc response failed. err=%v", err)
+		return nil
+	}
+	return b
+}
+
+// jsonrpcConn is the websocket connection with its subscriptions
+type jsonrpcConn struct {
+	h      *jsonrpcHandler
+	ws     *websocket.Conn
+	wmu    sync.Mutex
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	lastID  uint64
+	subs    map[string]context.CancelFunc
+	pending []chan struct{}
+}
+
+func newJSONRPCConn(h *jsonrpcHandler, ws *websocket.Conn, parent context.Context) *jsonrpcConn {
+	ctx, cancel := context.WithCancel(parent)
+	return &jsonrpcConn{
+		h:      h,
+		ws:     ws,
+		ctx:    ctx,
+		cancel: cancel,
+		subs:   make(map[string]context.CancelFunc),
+	}
+}
+
+func (c *jsonrpcConn) write(b []byte) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+
+	c.ws.SetWriteDeadline(time.Now().Add(jsonrpcWriteTimeout))
+	return c.ws.WriteMessage(websocket.TextMessage, b)
+}
+
+func (c *jsonrpcConn) subscribe(m *jsonrpcMethod, in proto.Message) (string, *jsonrpcError) {
+	c.mu.Lock()
+	if len(c.subs) >= jsonrpcMaxSubscriptions {
+		c.mu.Unlock()
+		return "", newJSONRPCError(jsonrpcServerError, "too many subscriptions, at most %v", jsonrpcMaxSubscriptions)
+	}
+	c.lastID++
+	id := strconv.FormatUint(c.lastID, 10)
+	ctx, cancel := context.WithCancel(c.ctx)
+	c.subs[id] = cancel
+	ready := make(chan struct{})
+	c.pending = append(c.pending, ready)
+	c.mu.Unlock()
+
+	out := reflect.ValueOf(c.h.client).MethodByName(m.name).Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(in)})
+	if e := out[1].Interface(); e != nil {
+		c.unsubscribe(id)
+		return "", newJSONRPCError(jsonrpcServerError, "%v", status.Convert(e.(error)).Message())
+	}
+	recv := out[0].MethodByName("Recv")
+	go func() {
+		defer c.unsubscribe(id)
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return
+		}
+		for {
+			ret := recv.Call(nil)
+			if e := ret[1].Interface(); e != nil {
+				return
+			}
+			if err := c.notify(id, ret[0].Interface().(proto.Message)); err != nil {
+				return
+			}
+		}
+	}()
+	return id, nil
+}
+
+// start lets the subscriptions created by the last request send notifications
+func (c *jsonrpcConn) start() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, ready := range c.pending {
+		close(ready)
+	}
+	c.pending = nil
+}
+
+func (c *jsonrpcConn) unsubscribe(id string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cancel, ok := c.subs[id]
+	if !ok {
+		return false
+	}
+	cancel()
+	delete(c.subs, id)
+	return true
+}
+
+func (c *jsonrpcConn) notify(id string, msg proto.Message) error {
+	result, err := c.h.marshaler.MarshalToString(msg)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(&jsonrpcNotification{
+		JSONRPC: jsonrpcVersion,
+		Method:  jsonrpcSubscriptionMethod,
+		Params: jsonrpcSubscriptionResult{
+			Subscription: id,
+			Result:       json.RawMessage(result),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return c.write(b)
+}
+
+func (c *jsonrpcConn) close() {
+	c.cancel()
+	c.ws.Close()
+}
@@ -0,0 +1,142 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/iost-official/go-iost/rpc/pb"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeAPIClient implements the methods of ApiServiceClient called by the tests
+type fakeAPIClient struct {
+	rpcpb.ApiServiceClient
+}
+
+func (c *fakeAPIClient) GetChainInfo(ctx context.Context, in *rpcpb.EmptyRequest, opts ...grpc.CallOption) (*rpcpb.ChainInfoResponse, error) {
+	return &rpcpb.ChainInfoResponse{NetName: "test", HeadBlock: 10}, nil
+}
+
+func (c *fakeAPIClient) GetAccount(ctx context.Context, in *rpcpb.GetAccountRequest, opts ...grpc.CallOption) (*rpcpb.Account, error) {
+	if in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "account name is required")
+	}
+	return &rpcpb.Account{Name: in.Name}, nil
+}
+
+type testJSONRPCResponse struct {
+	JSONRPC string                 `json:"jsonrpc"`
+	ID      json.RawMessage        `json:"id"`
+	Result  map[string]interface{} `json:"result"`
+	Error   *jsonrpcError          `json:"error"`
+}
+
+func TestJSONRPC(t *testing.T) {
+	Convey("test jsonrpc", t, func() {
+		h := newJSONRPCHandler(&fakeAPIClient{}, nil, defaultRequestSize, make(chan struct{}))
+		handle := func(payload string) *testJSONRPCResponse {
+			b := h.handle(context.Background(), []byte(payload), nil)
+			So(b, ShouldNotBeNil)
+			var resp testJSONRPCResponse
+			So(json.Unmarshal(b, &resp), ShouldBeNil)
+			So(resp.JSONRPC, ShouldEqual, jsonrpcVersion)
+			return &resp
+		}
+
+		Convey("request", func() {
+			resp := handle(`{"jsonrpc":"2.0","id":1,"method":"getChainInfo"}`)
+			So(string(resp.ID), ShouldEqual, "1")
+			So(resp.Error, ShouldBeNil)
+			So(resp.Result["net_name"], ShouldEqual, "test")
+			So(resp.Result["head_block"], ShouldEqual, "10")
+
+			resp = handle(`{"jsonrpc":"2.0","id":"a","method":"getAccount","params":{"name":"alice","by_longest_chain":true}}`)
+			So(string(resp.ID), ShouldEqual, `"a"`)
+			So(resp.Result["name"], ShouldEqual, "alice")
+
+			resp = handle(`{"jsonrpc":"2.0","id":2,"method":"getAccount","params":[{"name":"bob"}]}`)
+			So(resp.Result["name"], ShouldEqual, "bob")
+		})
+
+		Convey("notification", func() {
+			So(h.handle(context.Background(), []byte(`{"jsonrpc":"2.0","method":"getChainInfo"}`), nil), ShouldBeNil)
+
+			// no subscription is started by notification
+			c := newJSONRPCConn(h, nil, context.Background())
+			So(h.handle(context.Background(), []byte(`{"jsonrpc":"2.0","method":"subscribe","params":{"topics":["NEW_HEAD_BLOCK"]}}`), c), ShouldBeNil)
+			So(h.handle(context.Background(), []byte(`[{"jsonrpc":"2.0","method":"subscribe","params":{}}]`), c), ShouldBeNil)
+			So(len(c.subs), ShouldEqual, 0)
+			So(len(c.pending), ShouldEqual, 0)
+		})
+
+		Convey("batch", func() {
+			b := h.handle(context.Background(), []byte(`[
+				{"jsonrpc":"2.0","id":1,"method":"getChainInfo"},
+				{"jsonrpc":"2.0","method":"getChainInfo"},
+				{"jsonrpc":"2.0","id":2,"method":"getAccount","params":{"name":"alice"}},
+				1
+			]`), nil)
+			var resps []*testJSONRPCResponse
+			So(json.Unmarshal(b, &resps), ShouldBeNil)
+			So(len(resps), ShouldEqual, 3)
+			So(string(resps[0].ID), ShouldEqual, "1")
+			So(resps[0].Result["net_name"], ShouldEqual, "test")
+			So(string(resps[1].ID), ShouldEqual, "2")
+			So(resps[1].Result["name"], ShouldEqual, "alice")
+			So(string(resps[2].ID), ShouldEqual, "null")
+			So(resps[2].Error.Code, ShouldEqual, jsonrpcInvalidRequest)
+
+			So(h.handle(context.Background(), []byte(`[{"jsonrpc":"2.0","method":"getChainInfo"}]`), nil), ShouldBeNil)
+		})
+
+		Convey("errors", func() {
+			for _, c := range []struct {
+				payload string
+				code    int
+			}{
+				{`{"jsonrpc":"2.0","id":1,`, jsonrpcParseError},
+				{`[{"jsonrpc":"2.0","id":1}`, jsonrpcParseError},
+				{`[]`, jsonrpcInvalidRequest},
+				{`{"jsonrpc":"1.0","id":1,"method":"getChainInfo"}`, jsonrpcInvalidRequest},
+				{`{"jsonrpc":"2.0","id":1}`, jsonrpcInvalidRequest},
+				{`{"jsonrpc":"2.0","id":1,"method":"getNothing"}`, jsonrpcMethodNotFound},
+				{`{"jsonrpc":"2.0","id":1,"method":"subscribe","params":{}}`, jsonrpcMethodNotFound},
+				{`{"jsonrpc":"2.0","id":1,"method":"getAccount","params":{"unknown":1}}`, jsonrpcInvalidParams},
+				{`{"jsonrpc":"2.0","id":1,"method":"getAccount","params":[{},{}]}`, jsonrpcInvalidParams},
+				{`{"jsonrpc":"2.0","id":1,"method":"getAccount","params":{}}`, jsonrpcServerError},
+			} {
+				resp := handle(c.payload)
+				So(resp.Error, ShouldNotBeNil)
+				So(resp.Error.Code, ShouldEqual, c.code)
+				So(resp.Result, ShouldBeNil)
+			}
+			resp := handle(`{"jsonrpc":"2.0","id":1,"method":"getAccount","params":{}}`)
+			So(resp.Error.Message, ShouldEqual, "account name is required")
+		})
+
+		Convey("http", func() {
+			post := func(method string, body string) *httptest.ResponseRecorder {
+				w := httptest.NewRecorder()
+				h.ServeHTTP(w, httptest.NewRequest(method, "/", strings.NewReader(body)))
+				return w
+			}
+			w := post(http.MethodPost, `{"jsonrpc":"2.0","id":1,"method":"getChainInfo"}`)
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(w.Header().Get("Content-Type"), ShouldEqual, "application/json")
+			So(w.Body.String(), ShouldContainSubstring, `"net_name":"test"`)
+
+			w = post(http.MethodPost, `{"jsonrpc":"2.0","method":"getChainInfo"}`)
+			So(w.Code, ShouldEqual, http.StatusNoContent)
+
+			w = post(http.MethodGet, "")
+			So(w.Code, ShouldEqual, http.StatusMethodNotAllowed)
+		})
+	})
+}
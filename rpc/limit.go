@@ -0,0 +1,282 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/metrics"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	apiKeyHeader       = "X-Api-Key"
+	apiKeyMetadata     = "x-api-key"
+	forwardedMetadata  = "x-forwarded-for"
+	forwardedHeader    = "X-Forwarded-For"
+	defaultRequestSize = 4 * 1024 * 1024
+
+	// the idle limiters are dropped when there are more clients than limiterCacheSize,
+	// or the least recently seen one if none is idle
+	limiterCacheSize = 10000
+	limiterIdleTime  = 10 * time.Minute
+)
+
+var (
+	rejectedCounter = metrics.NewCounter("iost_rpc_rejected", []string{"method", "reason"})
+)
+
+type clientLimiter struct {
+	*rate.Limiter
+	lastSeen time.Time
+}
+
+// limiter checks the api key, the method lists and the request rate of each client.
+type limiter struct {
+	rate     rate.Limit
+	burst    int
+	keyRate  rate.Limit
+	keyBurst int
+	apiKeys  map[string]bool
+	allow    map[string]bool
+	keyAllow map[string]bool
+	deny     map[string]bool
+
+	mu       sync.Mutex
+	limiters map[string]*clientLimiter
+}
+
+func newLimiter(conf *common.RPCLimitConfig) *limiter {
+	l := &limiter{
+		rate:     rate.Inf,
+		keyRate:  rate.Inf,
+		apiKeys:  make(map[string]bool),
+		allow:    make(map[string]bool),
+		keyAllow: make(map[string]bool),
+		deny:     make(map[string]bool),
+		limiters: make(map[string]*clientLimiter),
+	}
+	if conf == nil {
+		return l
+	}
+	if conf.Rate > 0 {
+		l.rate = rate.Limit(conf.Rate)
+	}
+	if conf.KeyRate > 0 {
+		l.keyRate = rate.Limit(conf.KeyRate)
+	}
+	l.burst, l.keyBurst = conf.Burst, conf.KeyBurst
+	for _, k := range conf.APIKeys {
+		l.apiKeys[k] = true
+	}
+	for _, m := range conf.AllowMethods {
+		l.allow[m] = true
+	}
+	for _, m := range conf.KeyMethods {
+		l.keyAllow[m] = true
+	}
+	for _, m := range conf.DenyMethods {
+		l.deny[m] = true
+	}
+	return l
+}
+
+// check returns the error if the request of method is rejected
+func (l *limiter) check(ctx context.Context, method string) error {
+	key, ip := clientOf(ctx)
+	if key != "" {
+		if !l.apiKeys[key] {
+			rejectedCounter.Add(1, map[string]string{"method": method, "reason": "invalid_key"})
+			return status.Error(codes.Unauthenticated, "invalid api key")
+		}
+		if err := l.checkMethod(method, l.keyAllow); err != nil {
+			return err
+		}
+		if !l.allowClient("key:"+key, l.keyRate, l.keyBurst) {
+			rejectedCounter.Add(1, map[string]string{"method": method, "reason": "rate_limit"})
+			return status.Error(codes.ResourceExhausted, "too many requests")
+		}
+		return nil
+	}
+	if err := l.checkMethod(method, l.allow); err != nil {
+		return err
+	}
+	if !l.allowClient("ip:"+ip, l.rate, l.burst) {
+		rejectedCounter.Add(1, map[string]string{"method": method, "reason": "rate_limit"})
+		return status.Error(codes.ResourceExhausted, "too many requests")
+	}
+	return nil
+}
+
+// checkMethod rejects the method if it is denied or not in the non-empty allow list
+func (l *limiter) checkMethod(method string, allow map[string]bool) error {
+	if (len(allow) > 0 && !allow[method]) || l.deny[method] {
+		rejectedCounter.Add(1, map[string]string{"method": method, "reason": "denied"})
+		return status.Errorf(codes.PermissionDenied, "method %v is not allowed", method)
+	}
+	return nil
+}
+
+func (l *limiter) allowClient(client string, r rate.Limit, burst int) bool {
+	if r == rate.Inf {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	cl, ok := l.limiters[client]
+	if !ok {
+		if len(l.limiters) >= limiterCacheSize {
+			l.evict(now)
+		}
+		if burst <= 0 {
+			burst = 1
+		}
+		cl = &clientLimiter{Limiter: rate.NewLimiter(r, burst)}
+		l.limiters[client] = cl
+	}
+	cl.lastSeen = now
+	return cl.AllowN(now, 1)
+}
+
+// evict drops the idle limiters, or the least recently seen one if none is idle
+func (l *limiter) evict(now time.Time) {
+	var oldest string
+	var oldestSeen time.Time
+	for c, cl := range l.limiters {
+		if now.Sub(cl.lastSeen) > limiterIdleTime {
+			delete(l.limiters, c)
+		} else if oldest == "" || cl.lastSeen.Before(oldestSeen) {
+			oldest, oldestSeen = c, cl.lastSeen
+		}
+	}
+	if len(l.limiters) >= limiterCacheSize {
+		delete(l.limiters, oldest)
+	}
+}
+
+// clientOf returns the api key and ip of the client. The ip forwarded by gateway or json-rpc server
+// is only trusted if the request comes from the loopback address.
+func clientOf(ctx context.Context) (key string, ip string) {
+	if p, ok := peer.FromContext(ctx); ok {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", ip
+	}
+	if keys := md.Get(apiKeyMetadata); len(keys) > 0 {
+		key = keys[0]
+	}
+	if fwd := md.Get(forwardedMetadata); len(fwd) > 0 && isLoopback(ip) {
+		addrs := strings.Split(fwd[len(fwd)-1], ",")
+		ip = strings.TrimSpace(addrs[len(addrs)-1])
+	}
+	return key, ip
+}
+
+func isLoopback(ip string) bool {
+	addr := net.ParseIP(ip)
+	return addr != nil && addr.IsLoopback()
+}
+
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+func (l *limiter) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := l.check(ctx, methodName(info.FullMethod)); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (l *limiter) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := l.check(ss.Context(), methodName(info.FullMethod)); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// gatewayHeaderMatcher passes the api key header to grpc besides the default ones
+func gatewayHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, apiKeyHeader) {
+		return apiKeyMetadata, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// limitRequestSize rejects the http requests larger than size
+func limitRequestSize(h http.Handler, size int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > int64(size) {
+			rejectedCounter.Add(1, map[string]string{"method": r.URL.Path, "reason": "too_large"})
+			http.Error(w, "request too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, int64(size))
+		h.ServeHTTP(w, r)
+	})
+}
+
+// limitHTTP rejects the http requests of method if the client is rejected by the limiter.
+// The http requests not forwarded to grpc, such as graphql, are checked by it instead of the interceptors.
+func (l *limiter) limitHTTP(h http.Handler, method string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := l.check(httpClientContext(r), method); err != nil {
+			http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// httpClientContext returns the context of r with the peer and metadata read by clientOf
+func httpClientContext(r *http.Request) context.Context {
+	ctx := r.Context()
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+	}
+	md := metadata.MD{}
+	if key := r.Header.Get(apiKeyHeader); key != "" {
+		md.Set(apiKeyMetadata, key)
+	}
+	if fwd := r.Header[forwardedHeader]; len(fwd) > 0 {
+		md.Set(forwardedMetadata, fwd...)
+	}
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// forwardClient adds the ip and api key of the http client to the metadata of the grpc calls made with ctx
+func forwardClient(ctx context.Context, r *http.Request) context.Context {
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	pairs := []string{forwardedMetadata, ip}
+	if key := r.Header.Get(apiKeyHeader); key != "" {
+		pairs = append(pairs, apiKeyMetadata, key)
+	}
+	return metadata.AppendToOutgoingContext(ctx, pairs...)
+}
+
+func requestSize(conf *common.RPCLimitConfig) int {
+	if conf == nil || conf.MaxRequestSize <= 0 {
+		return defaultRequestSize
+	}
+	return conf.MaxRequestSize
+}
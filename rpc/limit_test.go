@@ -0,0 +1,124 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func clientContext(ip string, pairs ...string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 30002},
+	})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(pairs...))
+}
+
+func TestLimiterCheck(t *testing.T) {
+	conf := &common.RPCLimitConfig{
+		Rate:         0.001,
+		Burst:        2,
+		APIKeys:      []string{"key1", "key2"},
+		KeyRate:      0.001,
+		KeyBurst:     3,
+		AllowMethods: []string{"GetChainInfo", "SendTransaction"},
+		KeyMethods:   []string{"GetChainInfo", "SendTransaction", "GetBlocksByRange"},
+		DenyMethods:  []string{"SendTransaction"},
+	}
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		code   codes.Code
+	}{
+		{"ip", clientContext("1.1.1.1"), "GetChainInfo", codes.OK},
+		{"ip not allowed", clientContext("1.1.1.1"), "GetBlocksByRange", codes.PermissionDenied},
+		{"ip denied", clientContext("1.1.1.1"), "SendTransaction", codes.PermissionDenied},
+		{"ip burst", clientContext("1.1.1.1"), "GetChainInfo", codes.OK},
+		{"ip over burst", clientContext("1.1.1.1"), "GetChainInfo", codes.ResourceExhausted},
+		{"another ip", clientContext("2.2.2.2"), "GetChainInfo", codes.OK},
+		{"forwarded ip", clientContext("127.0.0.1", forwardedMetadata, "3.3.3.3"), "GetChainInfo", codes.OK},
+		{"forwarded ip burst", clientContext("127.0.0.1", forwardedMetadata, "3.3.3.3"), "GetChainInfo", codes.OK},
+		{"forwarded ip over burst", clientContext("127.0.0.1", forwardedMetadata, "3.3.3.3"), "GetChainInfo", codes.ResourceExhausted},
+		{"untrusted forwarded ip", clientContext("1.1.1.1", forwardedMetadata, "4.4.4.4"), "GetChainInfo", codes.ResourceExhausted},
+		{"invalid key", clientContext("1.1.1.1", apiKeyMetadata, "key3"), "GetChainInfo", codes.Unauthenticated},
+		{"key", clientContext("1.1.1.1", apiKeyMetadata, "key1"), "GetChainInfo", codes.OK},
+		{"key allowed", clientContext("1.1.1.1", apiKeyMetadata, "key1"), "GetBlocksByRange", codes.OK},
+		{"key not allowed", clientContext("1.1.1.1", apiKeyMetadata, "key1"), "GetAccount", codes.PermissionDenied},
+		{"key denied", clientContext("1.1.1.1", apiKeyMetadata, "key1"), "SendTransaction", codes.PermissionDenied},
+		{"key burst", clientContext("2.2.2.2", apiKeyMetadata, "key1"), "GetChainInfo", codes.OK},
+		{"key over burst", clientContext("1.1.1.1", apiKeyMetadata, "key1"), "GetChainInfo", codes.ResourceExhausted},
+		{"another key", clientContext("1.1.1.1", apiKeyMetadata, "key2"), "GetChainInfo", codes.OK},
+	}
+	l := newLimiter(conf)
+	for _, tt := range tests {
+		err := l.check(tt.ctx, tt.method)
+		assert.Equal(t, tt.code, status.Code(err), tt.name)
+	}
+}
+
+func TestLimiterUnlimited(t *testing.T) {
+	l := newLimiter(nil)
+	for i := 0; i < 100; i++ {
+		assert.Nil(t, l.check(clientContext("1.1.1.1"), "SendTransaction"))
+	}
+	assert.Equal(t, codes.Unauthenticated, status.Code(l.check(clientContext("1.1.1.1", apiKeyMetadata, "key1"), "GetChainInfo")))
+}
+
+func TestLimiterEvict(t *testing.T) {
+	l := newLimiter(&common.RPCLimitConfig{Rate: 0.001, Burst: 1})
+	for i := 0; i < limiterCacheSize+10; i++ {
+		assert.True(t, l.allowClient(fmt.Sprintf("ip:%v", i), l.rate, l.burst))
+	}
+	assert.Equal(t, limiterCacheSize, len(l.limiters))
+	assert.NotContains(t, l.limiters, "ip:0")
+	assert.Contains(t, l.limiters, fmt.Sprintf("ip:%v", limiterCacheSize+9))
+}
+
+func TestLimitHTTP(t *testing.T) {
+	l := newLimiter(&common.RPCLimitConfig{
+		Rate:        0.001,
+		Burst:       1,
+		APIKeys:     []string{"key1"},
+		DenyMethods: []string{"GetAccount"},
+	})
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	h := l.limitHTTP(next, graphqlMethod)
+	tests := []struct {
+		name   string
+		remote string
+		header map[string]string
+		code   int
+	}{
+		{"ip", "1.1.1.1:1000", nil, http.StatusOK},
+		{"ip over burst", "1.1.1.1:1001", nil, http.StatusTooManyRequests},
+		{"forwarded ip", "127.0.0.1:1000", map[string]string{forwardedHeader: "2.2.2.2"}, http.StatusOK},
+		{"forwarded ip over burst", "127.0.0.1:1000", map[string]string{forwardedHeader: "2.2.2.2"}, http.StatusTooManyRequests},
+		{"untrusted forwarded ip", "1.1.1.1:1000", map[string]string{forwardedHeader: "3.3.3.3"}, http.StatusTooManyRequests},
+		{"invalid key", "1.1.1.1:1000", map[string]string{apiKeyHeader: "key2"}, http.StatusUnauthorized},
+		{"key", "1.1.1.1:1000", map[string]string{apiKeyHeader: "key1"}, http.StatusOK},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodPost, "/", nil)
+		r.RemoteAddr = tt.remote
+		for k, v := range tt.header {
+			r.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		assert.Equal(t, tt.code, w.Code, tt.name)
+	}
+
+	w := httptest.NewRecorder()
+	l.limitHTTP(next, "GetAccount").ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", nil))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
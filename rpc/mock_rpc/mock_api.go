@@ -34,6 +34,58 @@ func (m *MockApiServiceServer) EXPECT() *MockApiServiceServerMockRecorder {
 	return m.recorder
 }
 
+// CancelTransaction mocks base method
+func (m *MockApiServiceServer) CancelTransaction(arg0 context.Context, arg1 *pb.TransactionRequest) (*pb.SendTransactionResponse, error) {
+	ret := m.ctrl.Call(m, "CancelTransaction", arg0, arg1)
+	ret0, _ := ret[0].(*pb.SendTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTransaction indicates an expected call of CancelTransaction
+func (mr *MockApiServiceServerMockRecorder) CancelTransaction(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTransaction", reflect.TypeOf((*MockApiServiceServer)(nil).CancelTransaction), arg0, arg1)
+}
+
+// DecodeTx mocks base method
+func (m *MockApiServiceServer) DecodeTx(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.DecodeTxResponse, error) {
+	ret := m.ctrl.Call(m, "DecodeTx", arg0, arg1)
+	ret0, _ := ret[0].(*pb.DecodeTxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecodeTx indicates an expected call of DecodeTx
+func (mr *MockApiServiceServerMockRecorder) DecodeTx(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecodeTx", reflect.TypeOf((*MockApiServiceServer)(nil).DecodeTx), arg0, arg1)
+}
+
+// EncodeAction mocks base method
+func (m *MockApiServiceServer) EncodeAction(arg0 context.Context, arg1 *pb.EncodeActionRequest) (*pb.EncodeActionResponse, error) {
+	ret := m.ctrl.Call(m, "EncodeAction", arg0, arg1)
+	ret0, _ := ret[0].(*pb.EncodeActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncodeAction indicates an expected call of EncodeAction
+func (mr *MockApiServiceServerMockRecorder) EncodeAction(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncodeAction", reflect.TypeOf((*MockApiServiceServer)(nil).EncodeAction), arg0, arg1)
+}
+
+// EstimateTransaction mocks base method
+func (m *MockApiServiceServer) EstimateTransaction(arg0 context.Context, arg1 *pb.TransactionRequest) (*pb.EstimateTransactionResponse, error) {
+	ret := m.ctrl.Call(m, "EstimateTransaction", arg0, arg1)
+	ret0, _ := ret[0].(*pb.EstimateTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateTransaction indicates an expected call of EstimateTransaction
+func (mr *MockApiServiceServerMockRecorder) EstimateTransaction(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateTransaction", reflect.TypeOf((*MockApiServiceServer)(nil).EstimateTransaction), arg0, arg1)
+}
+
 // ExecTransaction mocks base method
 func (m *MockApiServiceServer) ExecTransaction(arg0 context.Context, arg1 *pb.TransactionRequest) (*pb.TxReceipt, error) {
 	ret := m.ctrl.Call(m, "ExecTransaction", arg0, arg1)
@@ -60,6 +112,32 @@ func (mr *MockApiServiceServerMockRecorder) GetAccount(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccount), arg0, arg1)
 }
 
+// GetAccountHistory mocks base method
+func (m *MockApiServiceServer) GetAccountHistory(arg0 context.Context, arg1 *pb.AccountHistoryRequest) (*pb.AccountHistoryResponse, error) {
+	ret := m.ctrl.Call(m, "GetAccountHistory", arg0, arg1)
+	ret0, _ := ret[0].(*pb.AccountHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountHistory indicates an expected call of GetAccountHistory
+func (mr *MockApiServiceServerMockRecorder) GetAccountHistory(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountHistory", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccountHistory), arg0, arg1)
+}
+
+// GetAccountUsage mocks base method
+func (m *MockApiServiceServer) GetAccountUsage(arg0 context.Context, arg1 *pb.AccountHistoryRequest) (*pb.AccountUsageResponse, error) {
+	ret := m.ctrl.Call(m, "GetAccountUsage", arg0, arg1)
+	ret0, _ := ret[0].(*pb.AccountUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountUsage indicates an expected call of GetAccountUsage
+func (mr *MockApiServiceServerMockRecorder) GetAccountUsage(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountUsage", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccountUsage), arg0, arg1)
+}
+
 // GetBatchContractStorage mocks base method
 func (m *MockApiServiceServer) GetBatchContractStorage(arg0 context.Context, arg1 *pb.GetBatchContractStorageRequest) (*pb.GetBatchContractStorageResponse, error) {
 	ret := m.ctrl.Call(m, "GetBatchContractStorage", arg0, arg1)
@@ -99,6 +177,18 @@ func (mr *MockApiServiceServerMockRecorder) GetBlockByNumber(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockByNumber", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockByNumber), arg0, arg1)
 }
 
+// GetBlocksByRange mocks base method
+func (m *MockApiServiceServer) GetBlocksByRange(arg0 *pb.GetBlocksByRangeRequest, arg1 pb.ApiService_GetBlocksByRangeServer) error {
+	ret := m.ctrl.Call(m, "GetBlocksByRange", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetBlocksByRange indicates an expected call of GetBlocksByRange
+func (mr *MockApiServiceServerMockRecorder) GetBlocksByRange(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksByRange", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlocksByRange), arg0, arg1)
+}
+
 // GetCandidateBonus mocks base method
 func (m *MockApiServiceServer) GetCandidateBonus(arg0 context.Context, arg1 *pb.GetAccountRequest) (*pb.CandidateBonus, error) {
 	ret := m.ctrl.Call(m, "GetCandidateBonus", arg0, arg1)
@@ -164,6 +254,19 @@ func (mr *MockApiServiceServerMockRecorder) GetContractStorageFields(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractStorageFields", reflect.TypeOf((*MockApiServiceServer)(nil).GetContractStorageFields), arg0, arg1)
 }
 
+// GetContractStorageProof mocks base method
+func (m *MockApiServiceServer) GetContractStorageProof(arg0 context.Context, arg1 *pb.GetContractStorageProofRequest) (*pb.GetContractStorageProofResponse, error) {
+	ret := m.ctrl.Call(m, "GetContractStorageProof", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetContractStorageProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContractStorageProof indicates an expected call of GetContractStorageProof
+func (mr *MockApiServiceServerMockRecorder) GetContractStorageProof(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractStorageProof", reflect.TypeOf((*MockApiServiceServer)(nil).GetContractStorageProof), arg0, arg1)
+}
+
 // GetGasRatio mocks base method
 func (m *MockApiServiceServer) GetGasRatio(arg0 context.Context, arg1 *pb.EmptyRequest) (*pb.GasRatioResponse, error) {
 	ret := m.ctrl.Call(m, "GetGasRatio", arg0, arg1)
@@ -190,6 +293,32 @@ func (mr *MockApiServiceServerMockRecorder) GetNodeInfo(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeInfo", reflect.TypeOf((*MockApiServiceServer)(nil).GetNodeInfo), arg0, arg1)
 }
 
+// GetPendingTxStatus mocks base method
+func (m *MockApiServiceServer) GetPendingTxStatus(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.PendingTxStatusResponse, error) {
+	ret := m.ctrl.Call(m, "GetPendingTxStatus", arg0, arg1)
+	ret0, _ := ret[0].(*pb.PendingTxStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingTxStatus indicates an expected call of GetPendingTxStatus
+func (mr *MockApiServiceServerMockRecorder) GetPendingTxStatus(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTxStatus", reflect.TypeOf((*MockApiServiceServer)(nil).GetPendingTxStatus), arg0, arg1)
+}
+
+// GetPendingTxs mocks base method
+func (m *MockApiServiceServer) GetPendingTxs(arg0 context.Context, arg1 *pb.GetPendingTxsRequest) (*pb.GetPendingTxsResponse, error) {
+	ret := m.ctrl.Call(m, "GetPendingTxs", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetPendingTxsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingTxs indicates an expected call of GetPendingTxs
+func (mr *MockApiServiceServerMockRecorder) GetPendingTxs(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTxs", reflect.TypeOf((*MockApiServiceServer)(nil).GetPendingTxs), arg0, arg1)
+}
+
 // GetProducerVoteInfo mocks base method
 func (m *MockApiServiceServer) GetProducerVoteInfo(arg0 context.Context, arg1 *pb.GetProducerVoteInfoRequest) (*pb.GetProducerVoteInfoResponse, error) {
 	ret := m.ctrl.Call(m, "GetProducerVoteInfo", arg0, arg1)
@@ -294,6 +423,19 @@ func (mr *MockApiServiceServerMockRecorder) GetTxByHash(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxByHash", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxByHash), arg0, arg1)
 }
 
+// GetTxPoolStats mocks base method
+func (m *MockApiServiceServer) GetTxPoolStats(arg0 context.Context, arg1 *pb.EmptyRequest) (*pb.TxPoolStatsResponse, error) {
+	ret := m.ctrl.Call(m, "GetTxPoolStats", arg0, arg1)
+	ret0, _ := ret[0].(*pb.TxPoolStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxPoolStats indicates an expected call of GetTxPoolStats
+func (mr *MockApiServiceServerMockRecorder) GetTxPoolStats(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxPoolStats", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxPoolStats), arg0, arg1)
+}
+
 // GetTxReceiptByTxHash mocks base method
 func (m *MockApiServiceServer) GetTxReceiptByTxHash(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.TxReceipt, error) {
 	ret := m.ctrl.Call(m, "GetTxReceiptByTxHash", arg0, arg1)
@@ -307,6 +449,58 @@ func (mr *MockApiServiceServerMockRecorder) GetTxReceiptByTxHash(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxReceiptByTxHash", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxReceiptByTxHash), arg0, arg1)
 }
 
+// GetTxReceiptsByHashes mocks base method
+func (m *MockApiServiceServer) GetTxReceiptsByHashes(arg0 context.Context, arg1 *pb.TxHashesRequest) (*pb.TxReceiptsResponse, error) {
+	ret := m.ctrl.Call(m, "GetTxReceiptsByHashes", arg0, arg1)
+	ret0, _ := ret[0].(*pb.TxReceiptsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxReceiptsByHashes indicates an expected call of GetTxReceiptsByHashes
+func (mr *MockApiServiceServerMockRecorder) GetTxReceiptsByHashes(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxReceiptsByHashes", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxReceiptsByHashes), arg0, arg1)
+}
+
+// GetTxStatus mocks base method
+func (m *MockApiServiceServer) GetTxStatus(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.TxStatusResponse, error) {
+	ret := m.ctrl.Call(m, "GetTxStatus", arg0, arg1)
+	ret0, _ := ret[0].(*pb.TxStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxStatus indicates an expected call of GetTxStatus
+func (mr *MockApiServiceServerMockRecorder) GetTxStatus(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxStatus", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxStatus), arg0, arg1)
+}
+
+// GetTxsByAccount mocks base method
+func (m *MockApiServiceServer) GetTxsByAccount(arg0 context.Context, arg1 *pb.GetTxsByAccountRequest) (*pb.GetTxsResponse, error) {
+	ret := m.ctrl.Call(m, "GetTxsByAccount", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetTxsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxsByAccount indicates an expected call of GetTxsByAccount
+func (mr *MockApiServiceServerMockRecorder) GetTxsByAccount(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxsByAccount", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxsByAccount), arg0, arg1)
+}
+
+// GetTxsByContract mocks base method
+func (m *MockApiServiceServer) GetTxsByContract(arg0 context.Context, arg1 *pb.GetTxsByContractRequest) (*pb.GetTxsResponse, error) {
+	ret := m.ctrl.Call(m, "GetTxsByContract", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetTxsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxsByContract indicates an expected call of GetTxsByContract
+func (mr *MockApiServiceServerMockRecorder) GetTxsByContract(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxsByContract", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxsByContract), arg0, arg1)
+}
+
 // GetVoterBonus mocks base method
 func (m *MockApiServiceServer) GetVoterBonus(arg0 context.Context, arg1 *pb.GetAccountRequest) (*pb.VoterBonus, error) {
 	ret := m.ctrl.Call(m, "GetVoterBonus", arg0, arg1)
@@ -111,6 +111,98 @@ func (TransactionResponse_Status) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1b773bf3e696f610, []int{8, 0}
 }
 
+// The enumeration defines event type.
+type TxEvent_Type int32
+
+const (
+	// added to transaction pool
+	TxEvent_PENDING TxEvent_Type = 0
+	// packed in a block of the longest chain
+	TxEvent_PACKED TxEvent_Type = 1
+	// the block containing it is replaced by a fork, and it returns to transaction pool
+	TxEvent_FORKED TxEvent_Type = 2
+	// removed as it is expired
+	TxEvent_EXPIRED TxEvent_Type = 3
+	// rejected as it exists in chain
+	TxEvent_DUPLICATE TxEvent_Type = 4
+	// rejected or removed as it fails verification or execution
+	TxEvent_FAILED TxEvent_Type = 5
+	// deleted from transaction pool
+	TxEvent_DELETED TxEvent_Type = 6
+	// removed as the block generation failed
+	TxEvent_DROPPED TxEvent_Type = 7
+	// removed or rejected as it is replaced by another transaction with higher gas ratio
+	TxEvent_REPLACED TxEvent_Type = 8
+)
+
+var TxEvent_Type_name = map[int32]string{
+	0: "PENDING",
+	1: "PACKED",
+	2: "FORKED",
+	3: "EXPIRED",
+	4: "DUPLICATE",
+	5: "FAILED",
+	6: "DELETED",
+	7: "DROPPED",
+	8: "REPLACED",
+}
+
+var TxEvent_Type_value = map[string]int32{
+	"PENDING":   0,
+	"PACKED":    1,
+	"FORKED":    2,
+	"EXPIRED":   3,
+	"DUPLICATE": 4,
+	"FAILED":    5,
+	"DELETED":   6,
+	"DROPPED":   7,
+	"REPLACED":  8,
+}
+
+func (x TxEvent_Type) String() string {
+	return proto.EnumName(TxEvent_Type_name, int32(x))
+}
+
+func (TxEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{9, 0}
+}
+
+// The enumeration defines transaction status.
+type TxStatusResponse_Status int32
+
+const (
+	// pending in transaction pool
+	TxStatusResponse_PENDING TxStatusResponse_Status = 0
+	// packed in a block that has not been confirmed
+	TxStatusResponse_PACKED TxStatusResponse_Status = 1
+	// packed in a block that is irreversible
+	TxStatusResponse_IRREVERSIBLE TxStatusResponse_Status = 2
+	// not in transaction pool or chain, the last event is the reason
+	TxStatusResponse_REMOVED TxStatusResponse_Status = 3
+)
+
+var TxStatusResponse_Status_name = map[int32]string{
+	0: "PENDING",
+	1: "PACKED",
+	2: "IRREVERSIBLE",
+	3: "REMOVED",
+}
+
+var TxStatusResponse_Status_value = map[string]int32{
+	"PENDING":      0,
+	"PACKED":       1,
+	"IRREVERSIBLE": 2,
+	"REMOVED":      3,
+}
+
+func (x TxStatusResponse_Status) String() string {
+	return proto.EnumName(TxStatusResponse_Status_name, int32(x))
+}
+
+func (TxStatusResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{10, 0}
+}
+
 // The enumeration defines the signature algorithm.
 type Signature_Algorithm int32
 
@@ -140,7 +232,7 @@ func (x Signature_Algorithm) String() string {
 }
 
 func (Signature_Algorithm) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{9, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{15, 0}
 }
 
 // The enumeration defines block status.
@@ -168,7 +260,39 @@ func (x BlockResponse_Status) String() string {
 }
 
 func (BlockResponse_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{12, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{18, 0}
+}
+
+// The enumeration defines the role of account in transaction.
+type GetTxsByAccountRequest_Role int32
+
+const (
+	// publisher or signer
+	GetTxsByAccountRequest_ANY GetTxsByAccountRequest_Role = 0
+	// publisher
+	GetTxsByAccountRequest_PUBLISHER GetTxsByAccountRequest_Role = 1
+	// signer
+	GetTxsByAccountRequest_SIGNER GetTxsByAccountRequest_Role = 2
+)
+
+var GetTxsByAccountRequest_Role_name = map[int32]string{
+	0: "ANY",
+	1: "PUBLISHER",
+	2: "SIGNER",
+}
+
+var GetTxsByAccountRequest_Role_value = map[string]int32{
+	"ANY":       0,
+	"PUBLISHER": 1,
+	"SIGNER":    2,
+}
+
+func (x GetTxsByAccountRequest_Role) String() string {
+	return proto.EnumName(GetTxsByAccountRequest_Role_name, int32(x))
+}
+
+func (GetTxsByAccountRequest_Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{52, 0}
 }
 
 type Event_Topic int32
@@ -178,16 +302,36 @@ const (
 	Event_CONTRACT_RECEIPT Event_Topic = 0
 	// contract event
 	Event_CONTRACT_EVENT Event_Topic = 1
+	// new head block of the longest chain
+	Event_NEW_HEAD_BLOCK Event_Topic = 2
+	// new irreversible block
+	Event_NEW_LIB_BLOCK Event_Topic = 3
+	// tx added to txpool
+	Event_PENDING_TX_ADDED Event_Topic = 4
+	// tx removed from txpool
+	Event_PENDING_TX_REMOVED Event_Topic = 5
+	// switch of the longest chain
+	Event_CHAIN_REORG Event_Topic = 6
 )
 
 var Event_Topic_name = map[int32]string{
 	0: "CONTRACT_RECEIPT",
 	1: "CONTRACT_EVENT",
+	2: "NEW_HEAD_BLOCK",
+	3: "NEW_LIB_BLOCK",
+	4: "PENDING_TX_ADDED",
+	5: "PENDING_TX_REMOVED",
+	6: "CHAIN_REORG",
 }
 
 var Event_Topic_value = map[string]int32{
-	"CONTRACT_RECEIPT": 0,
-	"CONTRACT_EVENT":   1,
+	"CONTRACT_RECEIPT":   0,
+	"CONTRACT_EVENT":     1,
+	"NEW_HEAD_BLOCK":     2,
+	"NEW_LIB_BLOCK":      3,
+	"PENDING_TX_ADDED":   4,
+	"PENDING_TX_REMOVED": 5,
+	"CHAIN_REORG":        6,
 }
 
 func (x Event_Topic) String() string {
@@ -195,7 +339,7 @@ func (x Event_Topic) String() string {
 }
 
 func (Event_Topic) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{39, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{63, 0}
 }
 
 // The message defines an empty request.
@@ -724,10 +868,12 @@ type Transaction struct {
 	// amount limit
 	AmountLimit []*AmountLimit `protobuf:"bytes,12,rep,name=amount_limit,json=amountLimit,proto3" json:"amount_limit,omitempty"`
 	// transaction receipt
-	TxReceipt            *TxReceipt `protobuf:"bytes,13,opt,name=tx_receipt,json=txReceipt,proto3" json:"tx_receipt,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	TxReceipt *TxReceipt `protobuf:"bytes,13,opt,name=tx_receipt,json=txReceipt,proto3" json:"tx_receipt,omitempty"`
+	// hash of the transaction replaced by this one
+	ReplacedTx           string   `protobuf:"bytes,14,opt,name=replaced_tx,json=replacedTx,proto3" json:"replaced_tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Transaction) Reset()         { *m = Transaction{} }
@@ -846,6 +992,13 @@ func (m *Transaction) GetTxReceipt() *TxReceipt {
 	return nil
 }
 
+func (m *Transaction) GetReplacedTx() string {
+	if m != nil {
+		return m.ReplacedTx
+	}
+	return ""
+}
+
 // The message defines transaction response.
 type TransactionResponse struct {
 	// transaction status
@@ -905,2226 +1058,4079 @@ func (m *TransactionResponse) GetBlockNumber() int64 {
 	return 0
 }
 
-// The message defines signature struct.
-type Signature struct {
-	// signature algorithm
-	Algorithm Signature_Algorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=rpcpb.Signature_Algorithm" json:"algorithm,omitempty"`
-	// signature bytes
-	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-	// public key
-	PublicKey            []byte   `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+// The message defines an event in the lifecycle of a transaction in transaction pool.
+type TxEvent struct {
+	// event type
+	Type TxEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=rpcpb.TxEvent_Type" json:"type,omitempty"`
+	// event time
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// error message of FAILED and DUPLICATE event
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Signature) Reset()         { *m = Signature{} }
-func (m *Signature) String() string { return proto.CompactTextString(m) }
-func (*Signature) ProtoMessage()    {}
-func (*Signature) Descriptor() ([]byte, []int) {
+func (m *TxEvent) Reset()         { *m = TxEvent{} }
+func (m *TxEvent) String() string { return proto.CompactTextString(m) }
+func (*TxEvent) ProtoMessage()    {}
+func (*TxEvent) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1b773bf3e696f610, []int{9}
 }
 
-func (m *Signature) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Signature.Unmarshal(m, b)
+func (m *TxEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxEvent.Unmarshal(m, b)
 }
-func (m *Signature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Signature.Marshal(b, m, deterministic)
+func (m *TxEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxEvent.Marshal(b, m, deterministic)
 }
-func (m *Signature) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Signature.Merge(m, src)
+func (m *TxEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxEvent.Merge(m, src)
 }
-func (m *Signature) XXX_Size() int {
-	return xxx_messageInfo_Signature.Size(m)
+func (m *TxEvent) XXX_Size() int {
+	return xxx_messageInfo_TxEvent.Size(m)
 }
-func (m *Signature) XXX_DiscardUnknown() {
-	xxx_messageInfo_Signature.DiscardUnknown(m)
+func (m *TxEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Signature proto.InternalMessageInfo
+var xxx_messageInfo_TxEvent proto.InternalMessageInfo
 
-func (m *Signature) GetAlgorithm() Signature_Algorithm {
+func (m *TxEvent) GetType() TxEvent_Type {
 	if m != nil {
-		return m.Algorithm
+		return m.Type
 	}
-	return Signature_UNKNOWN
+	return TxEvent_PENDING
 }
 
-func (m *Signature) GetSignature() []byte {
+func (m *TxEvent) GetTime() int64 {
 	if m != nil {
-		return m.Signature
+		return m.Time
 	}
-	return nil
+	return 0
 }
 
-func (m *Signature) GetPublicKey() []byte {
+func (m *TxEvent) GetMessage() string {
 	if m != nil {
-		return m.PublicKey
+		return m.Message
 	}
-	return nil
+	return ""
 }
 
-// The message defines the transaction request.
-type TransactionRequest struct {
-	// transaction timestamp
-	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
-	// expiration timestamp
-	Expiration int64 `protobuf:"varint,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
-	// gas price
-	GasRatio float64 `protobuf:"fixed64,3,opt,name=gas_ratio,json=gasRatio,proto3" json:"gas_ratio,omitempty"`
-	// gas limit
-	GasLimit float64 `protobuf:"fixed64,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
-	// delay nanoseconds
-	Delay int64 `protobuf:"varint,5,opt,name=delay,proto3" json:"delay,omitempty"`
-	// chain id
-	ChainId uint32 `protobuf:"varint,6,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// action list
-	Actions []*Action `protobuf:"bytes,7,rep,name=actions,proto3" json:"actions,omitempty"`
-	// amount limit
-	AmountLimit []*AmountLimit `protobuf:"bytes,8,rep,name=amount_limit,json=amountLimit,proto3" json:"amount_limit,omitempty"`
-	// signer list
-	Signers []string `protobuf:"bytes,9,rep,name=signers,proto3" json:"signers,omitempty"`
-	// signatures of signers
-	Signatures []*Signature `protobuf:"bytes,10,rep,name=signatures,proto3" json:"signatures,omitempty"`
-	// publisher
-	Publisher string `protobuf:"bytes,11,opt,name=publisher,proto3" json:"publisher,omitempty"`
-	// signatures of publisher
-	PublisherSigs        []*Signature `protobuf:"bytes,12,rep,name=publisher_sigs,json=publisherSigs,proto3" json:"publisher_sigs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+// The message defines the transaction status response.
+type TxStatusResponse struct {
+	// transaction status
+	Status TxStatusResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=rpcpb.TxStatusResponse_Status" json:"status,omitempty"`
+	// block number, -1 if it is not irreversible
+	BlockNumber int64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// the recent events in transaction pool, empty if they are dropped or the transaction is not received by transaction pool
+	Events               []*TxEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *TransactionRequest) Reset()         { *m = TransactionRequest{} }
-func (m *TransactionRequest) String() string { return proto.CompactTextString(m) }
-func (*TransactionRequest) ProtoMessage()    {}
-func (*TransactionRequest) Descriptor() ([]byte, []int) {
+func (m *TxStatusResponse) Reset()         { *m = TxStatusResponse{} }
+func (m *TxStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*TxStatusResponse) ProtoMessage()    {}
+func (*TxStatusResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1b773bf3e696f610, []int{10}
 }
 
-func (m *TransactionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TransactionRequest.Unmarshal(m, b)
+func (m *TxStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxStatusResponse.Unmarshal(m, b)
 }
-func (m *TransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TransactionRequest.Marshal(b, m, deterministic)
+func (m *TxStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxStatusResponse.Marshal(b, m, deterministic)
 }
-func (m *TransactionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransactionRequest.Merge(m, src)
+func (m *TxStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatusResponse.Merge(m, src)
 }
-func (m *TransactionRequest) XXX_Size() int {
-	return xxx_messageInfo_TransactionRequest.Size(m)
+func (m *TxStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_TxStatusResponse.Size(m)
 }
-func (m *TransactionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransactionRequest.DiscardUnknown(m)
+func (m *TxStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TransactionRequest proto.InternalMessageInfo
+var xxx_messageInfo_TxStatusResponse proto.InternalMessageInfo
 
-func (m *TransactionRequest) GetTime() int64 {
+func (m *TxStatusResponse) GetStatus() TxStatusResponse_Status {
 	if m != nil {
-		return m.Time
+		return m.Status
 	}
-	return 0
+	return TxStatusResponse_PENDING
 }
 
-func (m *TransactionRequest) GetExpiration() int64 {
+func (m *TxStatusResponse) GetBlockNumber() int64 {
 	if m != nil {
-		return m.Expiration
+		return m.BlockNumber
 	}
 	return 0
 }
 
-func (m *TransactionRequest) GetGasRatio() float64 {
+func (m *TxStatusResponse) GetEvents() []*TxEvent {
 	if m != nil {
-		return m.GasRatio
+		return m.Events
 	}
-	return 0
+	return nil
 }
 
-func (m *TransactionRequest) GetGasLimit() float64 {
+// The message defines get pending transactions request.
+type GetPendingTxsRequest struct {
+	// only the transactions published by the account if set
+	Publisher string `protobuf:"bytes,1,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	// only the transactions calling the contract if set
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// the cursor returned by the previous page, empty for the first page
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// the max count of transactions, at most 100
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPendingTxsRequest) Reset()         { *m = GetPendingTxsRequest{} }
+func (m *GetPendingTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPendingTxsRequest) ProtoMessage()    {}
+func (*GetPendingTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{11}
+}
+
+func (m *GetPendingTxsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPendingTxsRequest.Unmarshal(m, b)
+}
+func (m *GetPendingTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPendingTxsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPendingTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPendingTxsRequest.Merge(m, src)
+}
+func (m *GetPendingTxsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPendingTxsRequest.Size(m)
+}
+func (m *GetPendingTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPendingTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPendingTxsRequest proto.InternalMessageInfo
+
+func (m *GetPendingTxsRequest) GetPublisher() string {
 	if m != nil {
-		return m.GasLimit
+		return m.Publisher
 	}
-	return 0
+	return ""
 }
 
-func (m *TransactionRequest) GetDelay() int64 {
+func (m *GetPendingTxsRequest) GetContract() string {
 	if m != nil {
-		return m.Delay
+		return m.Contract
 	}
-	return 0
+	return ""
 }
 
-func (m *TransactionRequest) GetChainId() uint32 {
+func (m *GetPendingTxsRequest) GetCursor() string {
 	if m != nil {
-		return m.ChainId
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *GetPendingTxsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
 	return 0
 }
 
-func (m *TransactionRequest) GetActions() []*Action {
+// The message defines get pending transactions response.
+type GetPendingTxsResponse struct {
+	// the pending transactions from the highest gas ratio
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// the cursor of the next page, empty if there are no more transactions
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// the count of pending transactions matching the filter
+	Total                int64    `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPendingTxsResponse) Reset()         { *m = GetPendingTxsResponse{} }
+func (m *GetPendingTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPendingTxsResponse) ProtoMessage()    {}
+func (*GetPendingTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{12}
+}
+
+func (m *GetPendingTxsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPendingTxsResponse.Unmarshal(m, b)
+}
+func (m *GetPendingTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPendingTxsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPendingTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPendingTxsResponse.Merge(m, src)
+}
+func (m *GetPendingTxsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPendingTxsResponse.Size(m)
+}
+func (m *GetPendingTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPendingTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPendingTxsResponse proto.InternalMessageInfo
+
+func (m *GetPendingTxsResponse) GetTransactions() []*Transaction {
 	if m != nil {
-		return m.Actions
+		return m.Transactions
 	}
 	return nil
 }
 
-func (m *TransactionRequest) GetAmountLimit() []*AmountLimit {
+func (m *GetPendingTxsResponse) GetCursor() string {
 	if m != nil {
-		return m.AmountLimit
+		return m.Cursor
 	}
-	return nil
+	return ""
 }
 
-func (m *TransactionRequest) GetSigners() []string {
+func (m *GetPendingTxsResponse) GetTotal() int64 {
 	if m != nil {
-		return m.Signers
+		return m.Total
 	}
-	return nil
+	return 0
 }
 
-func (m *TransactionRequest) GetSignatures() []*Signature {
+// The message defines the transaction pool stats response.
+type TxPoolStatsResponse struct {
+	// the count of pending transactions
+	Size int64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	// the count of publishers of pending transactions
+	PublisherCount int64 `protobuf:"varint,2,opt,name=publisher_count,json=publisherCount,proto3" json:"publisher_count,omitempty"`
+	// the count of pending transactions by gas ratio
+	GasRatioHistogram []*TxPoolStatsResponse_GasRatioBucket `protobuf:"bytes,3,rep,name=gas_ratio_histogram,json=gasRatioHistogram,proto3" json:"gas_ratio_histogram,omitempty"`
+	// the hash of the oldest pending transaction
+	OldestTxHash string `protobuf:"bytes,4,opt,name=oldest_tx_hash,json=oldestTxHash,proto3" json:"oldest_tx_hash,omitempty"`
+	// the age of the oldest pending transaction in nanoseconds
+	OldestTxAge          int64    `protobuf:"varint,5,opt,name=oldest_tx_age,json=oldestTxAge,proto3" json:"oldest_tx_age,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxPoolStatsResponse) Reset()         { *m = TxPoolStatsResponse{} }
+func (m *TxPoolStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*TxPoolStatsResponse) ProtoMessage()    {}
+func (*TxPoolStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{13}
+}
+
+func (m *TxPoolStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxPoolStatsResponse.Unmarshal(m, b)
+}
+func (m *TxPoolStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxPoolStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *TxPoolStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxPoolStatsResponse.Merge(m, src)
+}
+func (m *TxPoolStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_TxPoolStatsResponse.Size(m)
+}
+func (m *TxPoolStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxPoolStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxPoolStatsResponse proto.InternalMessageInfo
+
+func (m *TxPoolStatsResponse) GetSize() int64 {
 	if m != nil {
-		return m.Signatures
+		return m.Size
+	}
+	return 0
+}
+
+func (m *TxPoolStatsResponse) GetPublisherCount() int64 {
+	if m != nil {
+		return m.PublisherCount
+	}
+	return 0
+}
+
+func (m *TxPoolStatsResponse) GetGasRatioHistogram() []*TxPoolStatsResponse_GasRatioBucket {
+	if m != nil {
+		return m.GasRatioHistogram
 	}
 	return nil
 }
 
-func (m *TransactionRequest) GetPublisher() string {
+func (m *TxPoolStatsResponse) GetOldestTxHash() string {
 	if m != nil {
-		return m.Publisher
+		return m.OldestTxHash
 	}
 	return ""
 }
 
-func (m *TransactionRequest) GetPublisherSigs() []*Signature {
+func (m *TxPoolStatsResponse) GetOldestTxAge() int64 {
 	if m != nil {
-		return m.PublisherSigs
+		return m.OldestTxAge
 	}
-	return nil
+	return 0
 }
 
-// The message defines the block struct.
-type Block struct {
-	// block hash
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	// block version
-	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	// parent block hash
-	ParentHash string `protobuf:"bytes,3,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
-	// transaction merkle tree root hash
-	TxMerkleHash string `protobuf:"bytes,4,opt,name=tx_merkle_hash,json=txMerkleHash,proto3" json:"tx_merkle_hash,omitempty"`
-	// transaction receipt merkle tree root hash
-	TxReceiptMerkleHash string `protobuf:"bytes,5,opt,name=tx_receipt_merkle_hash,json=txReceiptMerkleHash,proto3" json:"tx_receipt_merkle_hash,omitempty"`
-	// block number
-	Number int64 `protobuf:"varint,6,opt,name=number,proto3" json:"number,omitempty"`
-	// block producer witness
-	Witness string `protobuf:"bytes,7,opt,name=witness,proto3" json:"witness,omitempty"`
-	// block timestamp
-	Time int64 `protobuf:"varint,8,opt,name=time,proto3" json:"time,omitempty"`
-	// block gas usage
-	GasUsage float64 `protobuf:"fixed64,9,opt,name=gas_usage,json=gasUsage,proto3" json:"gas_usage,omitempty"`
-	// transaction count
-	TxCount int64 `protobuf:"varint,10,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
-	// extra information
-	Info *Block_Info `protobuf:"bytes,11,opt,name=info,proto3" json:"info,omitempty"`
-	// block transactions
-	Transactions         []*Transaction `protobuf:"bytes,12,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+// The message defines a bucket of gas ratio histogram.
+type TxPoolStatsResponse_GasRatioBucket struct {
+	// the min gas ratio of the bucket, inclusive
+	MinGasRatio float64 `protobuf:"fixed64,1,opt,name=min_gas_ratio,json=minGasRatio,proto3" json:"min_gas_ratio,omitempty"`
+	// the max gas ratio of the bucket, exclusive
+	MaxGasRatio float64 `protobuf:"fixed64,2,opt,name=max_gas_ratio,json=maxGasRatio,proto3" json:"max_gas_ratio,omitempty"`
+	// the count of transactions
+	Count                int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Block) Reset()         { *m = Block{} }
-func (m *Block) String() string { return proto.CompactTextString(m) }
-func (*Block) ProtoMessage()    {}
-func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{11}
+func (m *TxPoolStatsResponse_GasRatioBucket) Reset()         { *m = TxPoolStatsResponse_GasRatioBucket{} }
+func (m *TxPoolStatsResponse_GasRatioBucket) String() string { return proto.CompactTextString(m) }
+func (*TxPoolStatsResponse_GasRatioBucket) ProtoMessage()    {}
+func (*TxPoolStatsResponse_GasRatioBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{13, 0}
 }
 
-func (m *Block) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Block.Unmarshal(m, b)
+func (m *TxPoolStatsResponse_GasRatioBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxPoolStatsResponse_GasRatioBucket.Unmarshal(m, b)
 }
-func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Block.Marshal(b, m, deterministic)
+func (m *TxPoolStatsResponse_GasRatioBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxPoolStatsResponse_GasRatioBucket.Marshal(b, m, deterministic)
 }
-func (m *Block) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Block.Merge(m, src)
+func (m *TxPoolStatsResponse_GasRatioBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxPoolStatsResponse_GasRatioBucket.Merge(m, src)
 }
-func (m *Block) XXX_Size() int {
-	return xxx_messageInfo_Block.Size(m)
+func (m *TxPoolStatsResponse_GasRatioBucket) XXX_Size() int {
+	return xxx_messageInfo_TxPoolStatsResponse_GasRatioBucket.Size(m)
 }
-func (m *Block) XXX_DiscardUnknown() {
-	xxx_messageInfo_Block.DiscardUnknown(m)
+func (m *TxPoolStatsResponse_GasRatioBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxPoolStatsResponse_GasRatioBucket.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Block proto.InternalMessageInfo
+var xxx_messageInfo_TxPoolStatsResponse_GasRatioBucket proto.InternalMessageInfo
 
-func (m *Block) GetHash() string {
+func (m *TxPoolStatsResponse_GasRatioBucket) GetMinGasRatio() float64 {
 	if m != nil {
-		return m.Hash
+		return m.MinGasRatio
 	}
-	return ""
+	return 0
 }
 
-func (m *Block) GetVersion() int64 {
+func (m *TxPoolStatsResponse_GasRatioBucket) GetMaxGasRatio() float64 {
 	if m != nil {
-		return m.Version
+		return m.MaxGasRatio
 	}
 	return 0
 }
 
-func (m *Block) GetParentHash() string {
+func (m *TxPoolStatsResponse_GasRatioBucket) GetCount() int64 {
 	if m != nil {
-		return m.ParentHash
+		return m.Count
 	}
-	return ""
+	return 0
 }
 
-func (m *Block) GetTxMerkleHash() string {
-	if m != nil {
-		return m.TxMerkleHash
-	}
-	return ""
+// The message defines the verification status of a pending transaction.
+type PendingTxStatusResponse struct {
+	// the pending transaction
+	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// the position in the packing order, from 0
+	Rank int64 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// whether the transaction is still valid to be packed
+	Valid bool `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	// the reason if the transaction is invalid
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// nanoseconds before the transaction expires
+	ExpireIn int64 `protobuf:"varint,5,opt,name=expire_in,json=expireIn,proto3" json:"expire_in,omitempty"`
+	// the recent events in transaction pool
+	Events               []*TxEvent `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *Block) GetTxReceiptMerkleHash() string {
-	if m != nil {
-		return m.TxReceiptMerkleHash
-	}
-	return ""
+func (m *PendingTxStatusResponse) Reset()         { *m = PendingTxStatusResponse{} }
+func (m *PendingTxStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingTxStatusResponse) ProtoMessage()    {}
+func (*PendingTxStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{14}
 }
 
-func (m *Block) GetNumber() int64 {
-	if m != nil {
-		return m.Number
-	}
-	return 0
+func (m *PendingTxStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingTxStatusResponse.Unmarshal(m, b)
+}
+func (m *PendingTxStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingTxStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *PendingTxStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTxStatusResponse.Merge(m, src)
+}
+func (m *PendingTxStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_PendingTxStatusResponse.Size(m)
+}
+func (m *PendingTxStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTxStatusResponse.DiscardUnknown(m)
 }
 
-func (m *Block) GetWitness() string {
+var xxx_messageInfo_PendingTxStatusResponse proto.InternalMessageInfo
+
+func (m *PendingTxStatusResponse) GetTransaction() *Transaction {
 	if m != nil {
-		return m.Witness
+		return m.Transaction
 	}
-	return ""
+	return nil
 }
 
-func (m *Block) GetTime() int64 {
+func (m *PendingTxStatusResponse) GetRank() int64 {
 	if m != nil {
-		return m.Time
+		return m.Rank
 	}
 	return 0
 }
 
-func (m *Block) GetGasUsage() float64 {
+func (m *PendingTxStatusResponse) GetValid() bool {
 	if m != nil {
-		return m.GasUsage
+		return m.Valid
 	}
-	return 0
+	return false
 }
 
-func (m *Block) GetTxCount() int64 {
+func (m *PendingTxStatusResponse) GetError() string {
 	if m != nil {
-		return m.TxCount
+		return m.Error
 	}
-	return 0
+	return ""
 }
 
-func (m *Block) GetInfo() *Block_Info {
+func (m *PendingTxStatusResponse) GetExpireIn() int64 {
 	if m != nil {
-		return m.Info
+		return m.ExpireIn
 	}
-	return nil
+	return 0
 }
 
-func (m *Block) GetTransactions() []*Transaction {
+func (m *PendingTxStatusResponse) GetEvents() []*TxEvent {
 	if m != nil {
-		return m.Transactions
+		return m.Events
 	}
 	return nil
 }
 
-// The message defines block extra information
-type Block_Info struct {
-	// pack mode
-	Mode int32 `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
-	// transaction execution thread number
-	Thread int32 `protobuf:"varint,2,opt,name=thread,proto3" json:"thread,omitempty"`
-	// transaction index of every batch execution
-	BatchIndex           []int32  `protobuf:"varint,3,rep,packed,name=batch_index,json=batchIndex,proto3" json:"batch_index,omitempty"`
+// The message defines signature struct.
+type Signature struct {
+	// signature algorithm
+	Algorithm Signature_Algorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=rpcpb.Signature_Algorithm" json:"algorithm,omitempty"`
+	// signature bytes
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// public key
+	PublicKey            []byte   `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Block_Info) Reset()         { *m = Block_Info{} }
-func (m *Block_Info) String() string { return proto.CompactTextString(m) }
-func (*Block_Info) ProtoMessage()    {}
-func (*Block_Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{11, 0}
+func (m *Signature) Reset()         { *m = Signature{} }
+func (m *Signature) String() string { return proto.CompactTextString(m) }
+func (*Signature) ProtoMessage()    {}
+func (*Signature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{15}
 }
 
-func (m *Block_Info) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Block_Info.Unmarshal(m, b)
+func (m *Signature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Signature.Unmarshal(m, b)
 }
-func (m *Block_Info) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Block_Info.Marshal(b, m, deterministic)
+func (m *Signature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Signature.Marshal(b, m, deterministic)
 }
-func (m *Block_Info) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Block_Info.Merge(m, src)
+func (m *Signature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Signature.Merge(m, src)
 }
-func (m *Block_Info) XXX_Size() int {
-	return xxx_messageInfo_Block_Info.Size(m)
+func (m *Signature) XXX_Size() int {
+	return xxx_messageInfo_Signature.Size(m)
 }
-func (m *Block_Info) XXX_DiscardUnknown() {
-	xxx_messageInfo_Block_Info.DiscardUnknown(m)
+func (m *Signature) XXX_DiscardUnknown() {
+	xxx_messageInfo_Signature.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Block_Info proto.InternalMessageInfo
+var xxx_messageInfo_Signature proto.InternalMessageInfo
 
-func (m *Block_Info) GetMode() int32 {
+func (m *Signature) GetAlgorithm() Signature_Algorithm {
 	if m != nil {
-		return m.Mode
+		return m.Algorithm
 	}
-	return 0
+	return Signature_UNKNOWN
 }
 
-func (m *Block_Info) GetThread() int32 {
+func (m *Signature) GetSignature() []byte {
 	if m != nil {
-		return m.Thread
+		return m.Signature
 	}
-	return 0
+	return nil
 }
 
-func (m *Block_Info) GetBatchIndex() []int32 {
+func (m *Signature) GetPublicKey() []byte {
 	if m != nil {
-		return m.BatchIndex
+		return m.PublicKey
 	}
 	return nil
 }
 
-type BlockResponse struct {
-	// transaction status
-	Status BlockResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=rpcpb.BlockResponse_Status" json:"status,omitempty"`
-	// block
-	Block                *Block   `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+// The message defines the transaction request.
+type TransactionRequest struct {
+	// transaction timestamp
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// expiration timestamp
+	Expiration int64 `protobuf:"varint,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// gas price
+	GasRatio float64 `protobuf:"fixed64,3,opt,name=gas_ratio,json=gasRatio,proto3" json:"gas_ratio,omitempty"`
+	// gas limit
+	GasLimit float64 `protobuf:"fixed64,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// delay nanoseconds
+	Delay int64 `protobuf:"varint,5,opt,name=delay,proto3" json:"delay,omitempty"`
+	// chain id
+	ChainId uint32 `protobuf:"varint,6,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// action list
+	Actions []*Action `protobuf:"bytes,7,rep,name=actions,proto3" json:"actions,omitempty"`
+	// amount limit
+	AmountLimit []*AmountLimit `protobuf:"bytes,8,rep,name=amount_limit,json=amountLimit,proto3" json:"amount_limit,omitempty"`
+	// signer list
+	Signers []string `protobuf:"bytes,9,rep,name=signers,proto3" json:"signers,omitempty"`
+	// signatures of signers
+	Signatures []*Signature `protobuf:"bytes,10,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// publisher
+	Publisher string `protobuf:"bytes,11,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	// signatures of publisher
+	PublisherSigs []*Signature `protobuf:"bytes,12,rep,name=publisher_sigs,json=publisherSigs,proto3" json:"publisher_sigs,omitempty"`
+	// hash of the pending transaction to replace, the replacement should have the same publisher and higher gas ratio
+	ReplacedTx           string   `protobuf:"bytes,13,opt,name=replaced_tx,json=replacedTx,proto3" json:"replaced_tx,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BlockResponse) Reset()         { *m = BlockResponse{} }
-func (m *BlockResponse) String() string { return proto.CompactTextString(m) }
-func (*BlockResponse) ProtoMessage()    {}
-func (*BlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{12}
+func (m *TransactionRequest) Reset()         { *m = TransactionRequest{} }
+func (m *TransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*TransactionRequest) ProtoMessage()    {}
+func (*TransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{16}
 }
 
-func (m *BlockResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BlockResponse.Unmarshal(m, b)
+func (m *TransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionRequest.Unmarshal(m, b)
 }
-func (m *BlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BlockResponse.Marshal(b, m, deterministic)
+func (m *TransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionRequest.Marshal(b, m, deterministic)
 }
-func (m *BlockResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockResponse.Merge(m, src)
+func (m *TransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionRequest.Merge(m, src)
 }
-func (m *BlockResponse) XXX_Size() int {
-	return xxx_messageInfo_BlockResponse.Size(m)
+func (m *TransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_TransactionRequest.Size(m)
 }
-func (m *BlockResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockResponse.DiscardUnknown(m)
+func (m *TransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockResponse proto.InternalMessageInfo
+var xxx_messageInfo_TransactionRequest proto.InternalMessageInfo
 
-func (m *BlockResponse) GetStatus() BlockResponse_Status {
+func (m *TransactionRequest) GetTime() int64 {
 	if m != nil {
-		return m.Status
+		return m.Time
 	}
-	return BlockResponse_PENDING
+	return 0
 }
 
-func (m *BlockResponse) GetBlock() *Block {
+func (m *TransactionRequest) GetExpiration() int64 {
 	if m != nil {
-		return m.Block
+		return m.Expiration
 	}
-	return nil
+	return 0
 }
 
-// The message defines chain information response.
-type ChainInfoResponse struct {
-	// the name of network, such mainnet or testnet
-	NetName string `protobuf:"bytes,1,opt,name=net_name,json=netName,proto3" json:"net_name,omitempty"`
-	// the iost protocol version
-	ProtocolVersion string `protobuf:"bytes,2,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
-	// chain id
-	ChainId uint32 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// head block height
-	HeadBlock int64 `protobuf:"varint,4,opt,name=head_block,json=headBlock,proto3" json:"head_block,omitempty"`
-	// head block hash
-	HeadBlockHash string `protobuf:"bytes,5,opt,name=head_block_hash,json=headBlockHash,proto3" json:"head_block_hash,omitempty"`
-	// last irreversible block number
-	LibBlock int64 `protobuf:"varint,6,opt,name=lib_block,json=libBlock,proto3" json:"lib_block,omitempty"`
-	// last irreversible block hash
-	LibBlockHash string `protobuf:"bytes,7,opt,name=lib_block_hash,json=libBlockHash,proto3" json:"lib_block_hash,omitempty"`
-	// the current witness list
-	WitnessList []string `protobuf:"bytes,8,rep,name=witness_list,json=witnessList,proto3" json:"witness_list,omitempty"`
-	// the active witness list of lat irreversible block
-	LibWitnessList []string `protobuf:"bytes,9,rep,name=lib_witness_list,json=libWitnessList,proto3" json:"lib_witness_list,omitempty"`
-	// the pending witness list
-	PendingWitnessList []string `protobuf:"bytes,10,rep,name=pending_witness_list,json=pendingWitnessList,proto3" json:"pending_witness_list,omitempty"`
-	// the head block time
-	HeadBlockTime int64 `protobuf:"varint,11,opt,name=head_block_time,json=headBlockTime,proto3" json:"head_block_time,omitempty"`
-	// the last irreversible block time
-	LibBlockTime         int64    `protobuf:"varint,12,opt,name=lib_block_time,json=libBlockTime,proto3" json:"lib_block_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *ChainInfoResponse) Reset()         { *m = ChainInfoResponse{} }
-func (m *ChainInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*ChainInfoResponse) ProtoMessage()    {}
-func (*ChainInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{13}
-}
-
-func (m *ChainInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChainInfoResponse.Unmarshal(m, b)
-}
-func (m *ChainInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChainInfoResponse.Marshal(b, m, deterministic)
-}
-func (m *ChainInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChainInfoResponse.Merge(m, src)
-}
-func (m *ChainInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_ChainInfoResponse.Size(m)
-}
-func (m *ChainInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChainInfoResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ChainInfoResponse proto.InternalMessageInfo
-
-func (m *ChainInfoResponse) GetNetName() string {
-	if m != nil {
-		return m.NetName
-	}
-	return ""
-}
-
-func (m *ChainInfoResponse) GetProtocolVersion() string {
-	if m != nil {
-		return m.ProtocolVersion
-	}
-	return ""
-}
-
-func (m *ChainInfoResponse) GetChainId() uint32 {
+func (m *TransactionRequest) GetGasRatio() float64 {
 	if m != nil {
-		return m.ChainId
+		return m.GasRatio
 	}
 	return 0
 }
 
-func (m *ChainInfoResponse) GetHeadBlock() int64 {
+func (m *TransactionRequest) GetGasLimit() float64 {
 	if m != nil {
-		return m.HeadBlock
+		return m.GasLimit
 	}
 	return 0
 }
 
-func (m *ChainInfoResponse) GetHeadBlockHash() string {
+func (m *TransactionRequest) GetDelay() int64 {
 	if m != nil {
-		return m.HeadBlockHash
+		return m.Delay
 	}
-	return ""
+	return 0
 }
 
-func (m *ChainInfoResponse) GetLibBlock() int64 {
+func (m *TransactionRequest) GetChainId() uint32 {
 	if m != nil {
-		return m.LibBlock
+		return m.ChainId
 	}
 	return 0
 }
 
-func (m *ChainInfoResponse) GetLibBlockHash() string {
+func (m *TransactionRequest) GetActions() []*Action {
 	if m != nil {
-		return m.LibBlockHash
+		return m.Actions
 	}
-	return ""
+	return nil
 }
 
-func (m *ChainInfoResponse) GetWitnessList() []string {
+func (m *TransactionRequest) GetAmountLimit() []*AmountLimit {
 	if m != nil {
-		return m.WitnessList
+		return m.AmountLimit
 	}
 	return nil
 }
 
-func (m *ChainInfoResponse) GetLibWitnessList() []string {
+func (m *TransactionRequest) GetSigners() []string {
 	if m != nil {
-		return m.LibWitnessList
+		return m.Signers
 	}
 	return nil
 }
 
-func (m *ChainInfoResponse) GetPendingWitnessList() []string {
+func (m *TransactionRequest) GetSignatures() []*Signature {
 	if m != nil {
-		return m.PendingWitnessList
+		return m.Signatures
 	}
 	return nil
 }
 
-func (m *ChainInfoResponse) GetHeadBlockTime() int64 {
+func (m *TransactionRequest) GetPublisher() string {
 	if m != nil {
-		return m.HeadBlockTime
+		return m.Publisher
 	}
-	return 0
+	return ""
 }
 
-func (m *ChainInfoResponse) GetLibBlockTime() int64 {
+func (m *TransactionRequest) GetPublisherSigs() []*Signature {
 	if m != nil {
-		return m.LibBlockTime
+		return m.PublisherSigs
 	}
-	return 0
-}
-
-// The request message containing the tx's hash.
-type TxHashRequest struct {
-	// tx hash
-	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *TxHashRequest) Reset()         { *m = TxHashRequest{} }
-func (m *TxHashRequest) String() string { return proto.CompactTextString(m) }
-func (*TxHashRequest) ProtoMessage()    {}
-func (*TxHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{14}
-}
-
-func (m *TxHashRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TxHashRequest.Unmarshal(m, b)
-}
-func (m *TxHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TxHashRequest.Marshal(b, m, deterministic)
-}
-func (m *TxHashRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxHashRequest.Merge(m, src)
-}
-func (m *TxHashRequest) XXX_Size() int {
-	return xxx_messageInfo_TxHashRequest.Size(m)
-}
-func (m *TxHashRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxHashRequest.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_TxHashRequest proto.InternalMessageInfo
-
-func (m *TxHashRequest) GetHash() string {
+func (m *TransactionRequest) GetReplacedTx() string {
 	if m != nil {
-		return m.Hash
+		return m.ReplacedTx
 	}
 	return ""
 }
 
-// The request message containing the block's hash.
-type GetBlockByHashRequest struct {
+// The message defines the block struct.
+type Block struct {
 	// block hash
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	// complete means whether including the full transactions and transaction receipts
-	Complete             bool     `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// block version
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// parent block hash
+	ParentHash string `protobuf:"bytes,3,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	// transaction merkle tree root hash
+	TxMerkleHash string `protobuf:"bytes,4,opt,name=tx_merkle_hash,json=txMerkleHash,proto3" json:"tx_merkle_hash,omitempty"`
+	// transaction receipt merkle tree root hash
+	TxReceiptMerkleHash string `protobuf:"bytes,5,opt,name=tx_receipt_merkle_hash,json=txReceiptMerkleHash,proto3" json:"tx_receipt_merkle_hash,omitempty"`
+	// block number
+	Number int64 `protobuf:"varint,6,opt,name=number,proto3" json:"number,omitempty"`
+	// block producer witness
+	Witness string `protobuf:"bytes,7,opt,name=witness,proto3" json:"witness,omitempty"`
+	// block timestamp
+	Time int64 `protobuf:"varint,8,opt,name=time,proto3" json:"time,omitempty"`
+	// block gas usage
+	GasUsage float64 `protobuf:"fixed64,9,opt,name=gas_usage,json=gasUsage,proto3" json:"gas_usage,omitempty"`
+	// transaction count
+	TxCount int64 `protobuf:"varint,10,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// extra information
+	Info *Block_Info `protobuf:"bytes,11,opt,name=info,proto3" json:"info,omitempty"`
+	// block transactions
+	Transactions         []*Transaction `protobuf:"bytes,12,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *GetBlockByHashRequest) Reset()         { *m = GetBlockByHashRequest{} }
-func (m *GetBlockByHashRequest) String() string { return proto.CompactTextString(m) }
-func (*GetBlockByHashRequest) ProtoMessage()    {}
-func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{15}
+func (m *Block) Reset()         { *m = Block{} }
+func (m *Block) String() string { return proto.CompactTextString(m) }
+func (*Block) ProtoMessage()    {}
+func (*Block) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{17}
 }
 
-func (m *GetBlockByHashRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetBlockByHashRequest.Unmarshal(m, b)
+func (m *Block) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Block.Unmarshal(m, b)
 }
-func (m *GetBlockByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetBlockByHashRequest.Marshal(b, m, deterministic)
+func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Block.Marshal(b, m, deterministic)
 }
-func (m *GetBlockByHashRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBlockByHashRequest.Merge(m, src)
+func (m *Block) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Block.Merge(m, src)
 }
-func (m *GetBlockByHashRequest) XXX_Size() int {
-	return xxx_messageInfo_GetBlockByHashRequest.Size(m)
+func (m *Block) XXX_Size() int {
+	return xxx_messageInfo_Block.Size(m)
 }
-func (m *GetBlockByHashRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBlockByHashRequest.DiscardUnknown(m)
+func (m *Block) XXX_DiscardUnknown() {
+	xxx_messageInfo_Block.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBlockByHashRequest proto.InternalMessageInfo
+var xxx_messageInfo_Block proto.InternalMessageInfo
 
-func (m *GetBlockByHashRequest) GetHash() string {
+func (m *Block) GetHash() string {
 	if m != nil {
 		return m.Hash
 	}
 	return ""
 }
 
-func (m *GetBlockByHashRequest) GetComplete() bool {
+func (m *Block) GetVersion() int64 {
 	if m != nil {
-		return m.Complete
+		return m.Version
 	}
-	return false
+	return 0
 }
 
-// The request message containing the block's number.
-type GetBlockByNumberRequest struct {
-	// block number
-	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
-	// complete means whether including the full transactions and transaction receipts
-	Complete             bool     `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Block) GetParentHash() string {
+	if m != nil {
+		return m.ParentHash
+	}
+	return ""
 }
 
-func (m *GetBlockByNumberRequest) Reset()         { *m = GetBlockByNumberRequest{} }
-func (m *GetBlockByNumberRequest) String() string { return proto.CompactTextString(m) }
-func (*GetBlockByNumberRequest) ProtoMessage()    {}
-func (*GetBlockByNumberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{16}
+func (m *Block) GetTxMerkleHash() string {
+	if m != nil {
+		return m.TxMerkleHash
+	}
+	return ""
 }
 
-func (m *GetBlockByNumberRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetBlockByNumberRequest.Unmarshal(m, b)
-}
-func (m *GetBlockByNumberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetBlockByNumberRequest.Marshal(b, m, deterministic)
-}
-func (m *GetBlockByNumberRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBlockByNumberRequest.Merge(m, src)
-}
-func (m *GetBlockByNumberRequest) XXX_Size() int {
-	return xxx_messageInfo_GetBlockByNumberRequest.Size(m)
-}
-func (m *GetBlockByNumberRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBlockByNumberRequest.DiscardUnknown(m)
+func (m *Block) GetTxReceiptMerkleHash() string {
+	if m != nil {
+		return m.TxReceiptMerkleHash
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetBlockByNumberRequest proto.InternalMessageInfo
-
-func (m *GetBlockByNumberRequest) GetNumber() int64 {
+func (m *Block) GetNumber() int64 {
 	if m != nil {
 		return m.Number
 	}
 	return 0
 }
 
-func (m *GetBlockByNumberRequest) GetComplete() bool {
+func (m *Block) GetWitness() string {
 	if m != nil {
-		return m.Complete
+		return m.Witness
 	}
-	return false
+	return ""
 }
 
-// The message defines the account's frozen balance.
-type FrozenBalance struct {
-	// balance amount
-	Amount float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	// free time
-	Time                 int64    `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+func (m *Block) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *Block) GetGasUsage() float64 {
+	if m != nil {
+		return m.GasUsage
+	}
+	return 0
+}
+
+func (m *Block) GetTxCount() int64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *Block) GetInfo() *Block_Info {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *Block) GetTransactions() []*Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+// The message defines block extra information
+type Block_Info struct {
+	// pack mode
+	Mode int32 `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// transaction execution thread number
+	Thread int32 `protobuf:"varint,2,opt,name=thread,proto3" json:"thread,omitempty"`
+	// transaction index of every batch execution
+	BatchIndex           []int32  `protobuf:"varint,3,rep,packed,name=batch_index,json=batchIndex,proto3" json:"batch_index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FrozenBalance) Reset()         { *m = FrozenBalance{} }
-func (m *FrozenBalance) String() string { return proto.CompactTextString(m) }
-func (*FrozenBalance) ProtoMessage()    {}
-func (*FrozenBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{17}
+func (m *Block_Info) Reset()         { *m = Block_Info{} }
+func (m *Block_Info) String() string { return proto.CompactTextString(m) }
+func (*Block_Info) ProtoMessage()    {}
+func (*Block_Info) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{17, 0}
 }
 
-func (m *FrozenBalance) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FrozenBalance.Unmarshal(m, b)
+func (m *Block_Info) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Block_Info.Unmarshal(m, b)
 }
-func (m *FrozenBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FrozenBalance.Marshal(b, m, deterministic)
+func (m *Block_Info) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Block_Info.Marshal(b, m, deterministic)
 }
-func (m *FrozenBalance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FrozenBalance.Merge(m, src)
+func (m *Block_Info) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Block_Info.Merge(m, src)
 }
-func (m *FrozenBalance) XXX_Size() int {
-	return xxx_messageInfo_FrozenBalance.Size(m)
+func (m *Block_Info) XXX_Size() int {
+	return xxx_messageInfo_Block_Info.Size(m)
 }
-func (m *FrozenBalance) XXX_DiscardUnknown() {
-	xxx_messageInfo_FrozenBalance.DiscardUnknown(m)
+func (m *Block_Info) XXX_DiscardUnknown() {
+	xxx_messageInfo_Block_Info.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FrozenBalance proto.InternalMessageInfo
+var xxx_messageInfo_Block_Info proto.InternalMessageInfo
 
-func (m *FrozenBalance) GetAmount() float64 {
+func (m *Block_Info) GetMode() int32 {
 	if m != nil {
-		return m.Amount
+		return m.Mode
 	}
 	return 0
 }
 
-func (m *FrozenBalance) GetTime() int64 {
+func (m *Block_Info) GetThread() int32 {
 	if m != nil {
-		return m.Time
+		return m.Thread
 	}
 	return 0
 }
 
-// The message defines the account's vote info.
-type VoteInfo struct {
-	// option name
-	Option string `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
-	// votes
-	Votes float64 `protobuf:"fixed64,2,opt,name=votes,proto3" json:"votes,omitempty"`
-	// cleared votes
-	ClearedVotes         float64  `protobuf:"fixed64,3,opt,name=cleared_votes,json=clearedVotes,proto3" json:"cleared_votes,omitempty"`
+func (m *Block_Info) GetBatchIndex() []int32 {
+	if m != nil {
+		return m.BatchIndex
+	}
+	return nil
+}
+
+type BlockResponse struct {
+	// transaction status
+	Status BlockResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=rpcpb.BlockResponse_Status" json:"status,omitempty"`
+	// block
+	Block                *Block   `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
-func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
-func (*VoteInfo) ProtoMessage()    {}
-func (*VoteInfo) Descriptor() ([]byte, []int) {
+func (m *BlockResponse) Reset()         { *m = BlockResponse{} }
+func (m *BlockResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockResponse) ProtoMessage()    {}
+func (*BlockResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1b773bf3e696f610, []int{18}
 }
 
-func (m *VoteInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VoteInfo.Unmarshal(m, b)
+func (m *BlockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockResponse.Unmarshal(m, b)
 }
-func (m *VoteInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VoteInfo.Marshal(b, m, deterministic)
+func (m *BlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockResponse.Marshal(b, m, deterministic)
 }
-func (m *VoteInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VoteInfo.Merge(m, src)
+func (m *BlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockResponse.Merge(m, src)
 }
-func (m *VoteInfo) XXX_Size() int {
-	return xxx_messageInfo_VoteInfo.Size(m)
+func (m *BlockResponse) XXX_Size() int {
+	return xxx_messageInfo_BlockResponse.Size(m)
 }
-func (m *VoteInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_VoteInfo.DiscardUnknown(m)
+func (m *BlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockResponse proto.InternalMessageInfo
+
+func (m *BlockResponse) GetStatus() BlockResponse_Status {
+	if m != nil {
+		return m.Status
+	}
+	return BlockResponse_PENDING
+}
+
+func (m *BlockResponse) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+// The message defines chain information response.
+type ChainInfoResponse struct {
+	// the name of network, such mainnet or testnet
+	NetName string `protobuf:"bytes,1,opt,name=net_name,json=netName,proto3" json:"net_name,omitempty"`
+	// the iost protocol version
+	ProtocolVersion string `protobuf:"bytes,2,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// chain id
+	ChainId uint32 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// head block height
+	HeadBlock int64 `protobuf:"varint,4,opt,name=head_block,json=headBlock,proto3" json:"head_block,omitempty"`
+	// head block hash
+	HeadBlockHash string `protobuf:"bytes,5,opt,name=head_block_hash,json=headBlockHash,proto3" json:"head_block_hash,omitempty"`
+	// last irreversible block number
+	LibBlock int64 `protobuf:"varint,6,opt,name=lib_block,json=libBlock,proto3" json:"lib_block,omitempty"`
+	// last irreversible block hash
+	LibBlockHash string `protobuf:"bytes,7,opt,name=lib_block_hash,json=libBlockHash,proto3" json:"lib_block_hash,omitempty"`
+	// the current witness list
+	WitnessList []string `protobuf:"bytes,8,rep,name=witness_list,json=witnessList,proto3" json:"witness_list,omitempty"`
+	// the active witness list of lat irreversible block
+	LibWitnessList []string `protobuf:"bytes,9,rep,name=lib_witness_list,json=libWitnessList,proto3" json:"lib_witness_list,omitempty"`
+	// the pending witness list
+	PendingWitnessList []string `protobuf:"bytes,10,rep,name=pending_witness_list,json=pendingWitnessList,proto3" json:"pending_witness_list,omitempty"`
+	// the head block time
+	HeadBlockTime int64 `protobuf:"varint,11,opt,name=head_block_time,json=headBlockTime,proto3" json:"head_block_time,omitempty"`
+	// the last irreversible block time
+	LibBlockTime int64 `protobuf:"varint,12,opt,name=lib_block_time,json=libBlockTime,proto3" json:"lib_block_time,omitempty"`
+	// the merkle root of state at last irreversible block, empty if the state trie of the node is disabled
+	LibStateRoot         string   `protobuf:"bytes,13,opt,name=lib_state_root,json=libStateRoot,proto3" json:"lib_state_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainInfoResponse) Reset()         { *m = ChainInfoResponse{} }
+func (m *ChainInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*ChainInfoResponse) ProtoMessage()    {}
+func (*ChainInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{19}
+}
+
+func (m *ChainInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainInfoResponse.Unmarshal(m, b)
+}
+func (m *ChainInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *ChainInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainInfoResponse.Merge(m, src)
+}
+func (m *ChainInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_ChainInfoResponse.Size(m)
+}
+func (m *ChainInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainInfoResponse proto.InternalMessageInfo
+
+func (m *ChainInfoResponse) GetNetName() string {
+	if m != nil {
+		return m.NetName
+	}
+	return ""
+}
+
+func (m *ChainInfoResponse) GetProtocolVersion() string {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return ""
+}
+
+func (m *ChainInfoResponse) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *ChainInfoResponse) GetHeadBlock() int64 {
+	if m != nil {
+		return m.HeadBlock
+	}
+	return 0
+}
+
+func (m *ChainInfoResponse) GetHeadBlockHash() string {
+	if m != nil {
+		return m.HeadBlockHash
+	}
+	return ""
+}
+
+func (m *ChainInfoResponse) GetLibBlock() int64 {
+	if m != nil {
+		return m.LibBlock
+	}
+	return 0
+}
+
+func (m *ChainInfoResponse) GetLibBlockHash() string {
+	if m != nil {
+		return m.LibBlockHash
+	}
+	return ""
+}
+
+func (m *ChainInfoResponse) GetWitnessList() []string {
+	if m != nil {
+		return m.WitnessList
+	}
+	return nil
+}
+
+func (m *ChainInfoResponse) GetLibWitnessList() []string {
+	if m != nil {
+		return m.LibWitnessList
+	}
+	return nil
+}
+
+func (m *ChainInfoResponse) GetPendingWitnessList() []string {
+	if m != nil {
+		return m.PendingWitnessList
+	}
+	return nil
+}
+
+func (m *ChainInfoResponse) GetHeadBlockTime() int64 {
+	if m != nil {
+		return m.HeadBlockTime
+	}
+	return 0
+}
+
+func (m *ChainInfoResponse) GetLibBlockTime() int64 {
+	if m != nil {
+		return m.LibBlockTime
+	}
+	return 0
+}
+
+func (m *ChainInfoResponse) GetLibStateRoot() string {
+	if m != nil {
+		return m.LibStateRoot
+	}
+	return ""
+}
+
+// The request message containing the tx's hash.
+type TxHashRequest struct {
+	// tx hash
+	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxHashRequest) Reset()         { *m = TxHashRequest{} }
+func (m *TxHashRequest) String() string { return proto.CompactTextString(m) }
+func (*TxHashRequest) ProtoMessage()    {}
+func (*TxHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{20}
+}
+
+func (m *TxHashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxHashRequest.Unmarshal(m, b)
+}
+func (m *TxHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxHashRequest.Marshal(b, m, deterministic)
+}
+func (m *TxHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxHashRequest.Merge(m, src)
+}
+func (m *TxHashRequest) XXX_Size() int {
+	return xxx_messageInfo_TxHashRequest.Size(m)
+}
+func (m *TxHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxHashRequest proto.InternalMessageInfo
+
+func (m *TxHashRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// The request message containing the block's hash.
+type GetBlockByHashRequest struct {
+	// block hash
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// complete means whether including the full transactions and transaction receipts
+	Complete             bool     `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockByHashRequest) Reset()         { *m = GetBlockByHashRequest{} }
+func (m *GetBlockByHashRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockByHashRequest) ProtoMessage()    {}
+func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{21}
+}
+
+func (m *GetBlockByHashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockByHashRequest.Unmarshal(m, b)
+}
+func (m *GetBlockByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockByHashRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBlockByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockByHashRequest.Merge(m, src)
+}
+func (m *GetBlockByHashRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockByHashRequest.Size(m)
+}
+func (m *GetBlockByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockByHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockByHashRequest proto.InternalMessageInfo
+
+func (m *GetBlockByHashRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *GetBlockByHashRequest) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+// The request message containing the block's number.
+type GetBlockByNumberRequest struct {
+	// block number
+	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// complete means whether including the full transactions and transaction receipts
+	Complete             bool     `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockByNumberRequest) Reset()         { *m = GetBlockByNumberRequest{} }
+func (m *GetBlockByNumberRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockByNumberRequest) ProtoMessage()    {}
+func (*GetBlockByNumberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{22}
+}
+
+func (m *GetBlockByNumberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockByNumberRequest.Unmarshal(m, b)
+}
+func (m *GetBlockByNumberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockByNumberRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBlockByNumberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockByNumberRequest.Merge(m, src)
+}
+func (m *GetBlockByNumberRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockByNumberRequest.Size(m)
+}
+func (m *GetBlockByNumberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockByNumberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockByNumberRequest proto.InternalMessageInfo
+
+func (m *GetBlockByNumberRequest) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *GetBlockByNumberRequest) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+// The message defines get blocks by range request.
+type GetBlocksByRangeRequest struct {
+	// number of the first block
+	StartNumber int64 `protobuf:"varint,1,opt,name=start_number,json=startNumber,proto3" json:"start_number,omitempty"`
+	// number after the last block, the range must not exceed the max batch size of the server
+	EndNumber int64 `protobuf:"varint,2,opt,name=end_number,json=endNumber,proto3" json:"end_number,omitempty"`
+	// complete means whether including the full transactions and transaction receipts
+	Complete             bool     `protobuf:"varint,3,opt,name=complete,proto3" json:"complete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlocksByRangeRequest) Reset()         { *m = GetBlocksByRangeRequest{} }
+func (m *GetBlocksByRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlocksByRangeRequest) ProtoMessage()    {}
+func (*GetBlocksByRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{23}
+}
+
+func (m *GetBlocksByRangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlocksByRangeRequest.Unmarshal(m, b)
+}
+func (m *GetBlocksByRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlocksByRangeRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBlocksByRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlocksByRangeRequest.Merge(m, src)
+}
+func (m *GetBlocksByRangeRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlocksByRangeRequest.Size(m)
+}
+func (m *GetBlocksByRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlocksByRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlocksByRangeRequest proto.InternalMessageInfo
+
+func (m *GetBlocksByRangeRequest) GetStartNumber() int64 {
+	if m != nil {
+		return m.StartNumber
+	}
+	return 0
+}
+
+func (m *GetBlocksByRangeRequest) GetEndNumber() int64 {
+	if m != nil {
+		return m.EndNumber
+	}
+	return 0
+}
+
+func (m *GetBlocksByRangeRequest) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+// The message defines the tx hashes request.
+type TxHashesRequest struct {
+	// tx hashes, whose count must not exceed the max batch size of the server
+	Hashes               []string `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxHashesRequest) Reset()         { *m = TxHashesRequest{} }
+func (m *TxHashesRequest) String() string { return proto.CompactTextString(m) }
+func (*TxHashesRequest) ProtoMessage()    {}
+func (*TxHashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{24}
+}
+
+func (m *TxHashesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxHashesRequest.Unmarshal(m, b)
+}
+func (m *TxHashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxHashesRequest.Marshal(b, m, deterministic)
+}
+func (m *TxHashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxHashesRequest.Merge(m, src)
+}
+func (m *TxHashesRequest) XXX_Size() int {
+	return xxx_messageInfo_TxHashesRequest.Size(m)
+}
+func (m *TxHashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxHashesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxHashesRequest proto.InternalMessageInfo
+
+func (m *TxHashesRequest) GetHashes() []string {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+// The message defines the tx receipts response.
+type TxReceiptsResponse struct {
+	// receipts of the found txs, in the order of the request
+	Receipts []*TxReceipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	// hashes of the txs not found
+	NotFound             []string `protobuf:"bytes,2,rep,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxReceiptsResponse) Reset()         { *m = TxReceiptsResponse{} }
+func (m *TxReceiptsResponse) String() string { return proto.CompactTextString(m) }
+func (*TxReceiptsResponse) ProtoMessage()    {}
+func (*TxReceiptsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{25}
+}
+
+func (m *TxReceiptsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxReceiptsResponse.Unmarshal(m, b)
+}
+func (m *TxReceiptsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxReceiptsResponse.Marshal(b, m, deterministic)
+}
+func (m *TxReceiptsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxReceiptsResponse.Merge(m, src)
+}
+func (m *TxReceiptsResponse) XXX_Size() int {
+	return xxx_messageInfo_TxReceiptsResponse.Size(m)
+}
+func (m *TxReceiptsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxReceiptsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxReceiptsResponse proto.InternalMessageInfo
+
+func (m *TxReceiptsResponse) GetReceipts() []*TxReceipt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+func (m *TxReceiptsResponse) GetNotFound() []string {
+	if m != nil {
+		return m.NotFound
+	}
+	return nil
+}
+
+// The message defines the account history request.
+type AccountHistoryRequest struct {
+	// account name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the number of the first block
+	StartNumber int64 `protobuf:"varint,2,opt,name=start_number,json=startNumber,proto3" json:"start_number,omitempty"`
+	// the number after the last block, at most 1000 blocks are read in a request
+	EndNumber            int64    `protobuf:"varint,3,opt,name=end_number,json=endNumber,proto3" json:"end_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountHistoryRequest) Reset()         { *m = AccountHistoryRequest{} }
+func (m *AccountHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountHistoryRequest) ProtoMessage()    {}
+func (*AccountHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{26}
+}
+
+func (m *AccountHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountHistoryRequest.Unmarshal(m, b)
+}
+func (m *AccountHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *AccountHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountHistoryRequest.Merge(m, src)
+}
+func (m *AccountHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountHistoryRequest.Size(m)
+}
+func (m *AccountHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountHistoryRequest proto.InternalMessageInfo
+
+func (m *AccountHistoryRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AccountHistoryRequest) GetStartNumber() int64 {
+	if m != nil {
+		return m.StartNumber
+	}
+	return 0
+}
+
+func (m *AccountHistoryRequest) GetEndNumber() int64 {
+	if m != nil {
+		return m.EndNumber
+	}
+	return 0
+}
+
+// The message defines the resource usage and balance changes of an account in a block.
+type AccountBlockHistory struct {
+	// block number
+	BlockNumber int64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block time
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// the count of transactions published by the account
+	TxCount int32 `protobuf:"varint,3,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// gas paid by the account
+	GasUsage float64 `protobuf:"fixed64,4,opt,name=gas_usage,json=gasUsage,proto3" json:"gas_usage,omitempty"`
+	// ram used by the account, negative if released
+	RamUsage int64 `protobuf:"varint,5,opt,name=ram_usage,json=ramUsage,proto3" json:"ram_usage,omitempty"`
+	// ram bought by or lent to the account
+	RamBought int64 `protobuf:"varint,6,opt,name=ram_bought,json=ramBought,proto3" json:"ram_bought,omitempty"`
+	// balance changes of the account, token -> amount
+	TokenChanges         map[string]float64 `protobuf:"bytes,7,rep,name=token_changes,json=tokenChanges,proto3" json:"token_changes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AccountBlockHistory) Reset()         { *m = AccountBlockHistory{} }
+func (m *AccountBlockHistory) String() string { return proto.CompactTextString(m) }
+func (*AccountBlockHistory) ProtoMessage()    {}
+func (*AccountBlockHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{27}
+}
+
+func (m *AccountBlockHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountBlockHistory.Unmarshal(m, b)
+}
+func (m *AccountBlockHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountBlockHistory.Marshal(b, m, deterministic)
+}
+func (m *AccountBlockHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountBlockHistory.Merge(m, src)
+}
+func (m *AccountBlockHistory) XXX_Size() int {
+	return xxx_messageInfo_AccountBlockHistory.Size(m)
+}
+func (m *AccountBlockHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountBlockHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountBlockHistory proto.InternalMessageInfo
+
+func (m *AccountBlockHistory) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *AccountBlockHistory) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *AccountBlockHistory) GetTxCount() int32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *AccountBlockHistory) GetGasUsage() float64 {
+	if m != nil {
+		return m.GasUsage
+	}
+	return 0
+}
+
+func (m *AccountBlockHistory) GetRamUsage() int64 {
+	if m != nil {
+		return m.RamUsage
+	}
+	return 0
+}
+
+func (m *AccountBlockHistory) GetRamBought() int64 {
+	if m != nil {
+		return m.RamBought
+	}
+	return 0
+}
+
+func (m *AccountBlockHistory) GetTokenChanges() map[string]float64 {
+	if m != nil {
+		return m.TokenChanges
+	}
+	return nil
+}
+
+// The message defines the account history response.
+type AccountHistoryResponse struct {
+	// the blocks in which the account used resources or changed balance
+	Blocks []*AccountBlockHistory `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	// the number after the last block read, the start_number of the next request
+	NextNumber           int64    `protobuf:"varint,2,opt,name=next_number,json=nextNumber,proto3" json:"next_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountHistoryResponse) Reset()         { *m = AccountHistoryResponse{} }
+func (m *AccountHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountHistoryResponse) ProtoMessage()    {}
+func (*AccountHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{28}
+}
+
+func (m *AccountHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountHistoryResponse.Unmarshal(m, b)
+}
+func (m *AccountHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *AccountHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountHistoryResponse.Merge(m, src)
+}
+func (m *AccountHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_AccountHistoryResponse.Size(m)
+}
+func (m *AccountHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountHistoryResponse proto.InternalMessageInfo
+
+func (m *AccountHistoryResponse) GetBlocks() []*AccountBlockHistory {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func (m *AccountHistoryResponse) GetNextNumber() int64 {
+	if m != nil {
+		return m.NextNumber
+	}
+	return 0
+}
+
+// The message defines the account usage response.
+type AccountUsageResponse struct {
+	// the number after the last block read, the start_number of the next request
+	NextNumber int64 `protobuf:"varint,1,opt,name=next_number,json=nextNumber,proto3" json:"next_number,omitempty"`
+	// the time of the first block read
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// the time of the last block read
+	EndTime int64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// the count of blocks in which the account used resources or changed balance
+	BlockCount int32 `protobuf:"varint,4,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	// the count of transactions published by the account
+	TxCount int32 `protobuf:"varint,5,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// total gas paid by the account
+	GasUsage float64 `protobuf:"fixed64,6,opt,name=gas_usage,json=gasUsage,proto3" json:"gas_usage,omitempty"`
+	// the max gas paid by the account in a block
+	MaxBlockGasUsage float64 `protobuf:"fixed64,7,opt,name=max_block_gas_usage,json=maxBlockGasUsage,proto3" json:"max_block_gas_usage,omitempty"`
+	// total ram used by the account
+	RamUsage int64 `protobuf:"varint,8,opt,name=ram_usage,json=ramUsage,proto3" json:"ram_usage,omitempty"`
+	// total ram bought by or lent to the account
+	RamBought int64 `protobuf:"varint,9,opt,name=ram_bought,json=ramBought,proto3" json:"ram_bought,omitempty"`
+	// total balance changes of the account, token -> amount
+	TokenChanges         map[string]float64 `protobuf:"bytes,10,rep,name=token_changes,json=tokenChanges,proto3" json:"token_changes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AccountUsageResponse) Reset()         { *m = AccountUsageResponse{} }
+func (m *AccountUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountUsageResponse) ProtoMessage()    {}
+func (*AccountUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{29}
+}
+
+func (m *AccountUsageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountUsageResponse.Unmarshal(m, b)
+}
+func (m *AccountUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountUsageResponse.Marshal(b, m, deterministic)
+}
+func (m *AccountUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountUsageResponse.Merge(m, src)
+}
+func (m *AccountUsageResponse) XXX_Size() int {
+	return xxx_messageInfo_AccountUsageResponse.Size(m)
+}
+func (m *AccountUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountUsageResponse proto.InternalMessageInfo
+
+func (m *AccountUsageResponse) GetNextNumber() int64 {
+	if m != nil {
+		return m.NextNumber
+	}
+	return 0
+}
+
+func (m *AccountUsageResponse) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *AccountUsageResponse) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *AccountUsageResponse) GetBlockCount() int32 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+func (m *AccountUsageResponse) GetTxCount() int32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *AccountUsageResponse) GetGasUsage() float64 {
+	if m != nil {
+		return m.GasUsage
+	}
+	return 0
+}
+
+func (m *AccountUsageResponse) GetMaxBlockGasUsage() float64 {
+	if m != nil {
+		return m.MaxBlockGasUsage
+	}
+	return 0
+}
+
+func (m *AccountUsageResponse) GetRamUsage() int64 {
+	if m != nil {
+		return m.RamUsage
+	}
+	return 0
+}
+
+func (m *AccountUsageResponse) GetRamBought() int64 {
+	if m != nil {
+		return m.RamBought
+	}
+	return 0
+}
+
+func (m *AccountUsageResponse) GetTokenChanges() map[string]float64 {
+	if m != nil {
+		return m.TokenChanges
+	}
+	return nil
+}
+
+// The message defines the account's frozen balance.
+type FrozenBalance struct {
+	// balance amount
+	Amount float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// free time
+	Time                 int64    `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FrozenBalance) Reset()         { *m = FrozenBalance{} }
+func (m *FrozenBalance) String() string { return proto.CompactTextString(m) }
+func (*FrozenBalance) ProtoMessage()    {}
+func (*FrozenBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{30}
+}
+
+func (m *FrozenBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FrozenBalance.Unmarshal(m, b)
+}
+func (m *FrozenBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FrozenBalance.Marshal(b, m, deterministic)
+}
+func (m *FrozenBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenBalance.Merge(m, src)
+}
+func (m *FrozenBalance) XXX_Size() int {
+	return xxx_messageInfo_FrozenBalance.Size(m)
+}
+func (m *FrozenBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenBalance proto.InternalMessageInfo
+
+func (m *FrozenBalance) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *FrozenBalance) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// The message defines the account's vote info.
+type VoteInfo struct {
+	// option name
+	Option string `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
+	// votes
+	Votes float64 `protobuf:"fixed64,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	// cleared votes
+	ClearedVotes         float64  `protobuf:"fixed64,3,opt,name=cleared_votes,json=clearedVotes,proto3" json:"cleared_votes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
+func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
+func (*VoteInfo) ProtoMessage()    {}
+func (*VoteInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{31}
+}
+
+func (m *VoteInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteInfo.Unmarshal(m, b)
+}
+func (m *VoteInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteInfo.Marshal(b, m, deterministic)
+}
+func (m *VoteInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteInfo.Merge(m, src)
+}
+func (m *VoteInfo) XXX_Size() int {
+	return xxx_messageInfo_VoteInfo.Size(m)
+}
+func (m *VoteInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteInfo proto.InternalMessageInfo
+
+func (m *VoteInfo) GetOption() string {
+	if m != nil {
+		return m.Option
+	}
+	return ""
+}
+
+func (m *VoteInfo) GetVotes() float64 {
+	if m != nil {
+		return m.Votes
+	}
+	return 0
+}
+
+func (m *VoteInfo) GetClearedVotes() float64 {
+	if m != nil {
+		return m.ClearedVotes
+	}
+	return 0
+}
+
+type GetProducerVoteInfoRequest struct {
+	// account name
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// get data by longest chain's head block or last irreversible block
+	ByLongestChain       bool     `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProducerVoteInfoRequest) Reset()         { *m = GetProducerVoteInfoRequest{} }
+func (m *GetProducerVoteInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetProducerVoteInfoRequest) ProtoMessage()    {}
+func (*GetProducerVoteInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{32}
+}
+
+func (m *GetProducerVoteInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProducerVoteInfoRequest.Unmarshal(m, b)
+}
+func (m *GetProducerVoteInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProducerVoteInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetProducerVoteInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProducerVoteInfoRequest.Merge(m, src)
+}
+func (m *GetProducerVoteInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetProducerVoteInfoRequest.Size(m)
+}
+func (m *GetProducerVoteInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProducerVoteInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProducerVoteInfoRequest proto.InternalMessageInfo
+
+func (m *GetProducerVoteInfoRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *GetProducerVoteInfoRequest) GetByLongestChain() bool {
+	if m != nil {
+		return m.ByLongestChain
+	}
+	return false
+}
+
+type GetProducerVoteInfoResponse struct {
+	// producer's public key
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// producer's location
+	Loc string `protobuf:"bytes,2,opt,name=loc,proto3" json:"loc,omitempty"`
+	// producer's url
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// producer's net id
+	NetId string `protobuf:"bytes,4,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+	// is producer
+	IsProducer bool `protobuf:"varint,5,opt,name=is_producer,json=isProducer,proto3" json:"is_producer,omitempty"`
+	// is approved
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// is online
+	Online bool `protobuf:"varint,7,opt,name=online,proto3" json:"online,omitempty"`
+	// votes
+	Votes                float64  `protobuf:"fixed64,8,opt,name=votes,proto3" json:"votes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProducerVoteInfoResponse) Reset()         { *m = GetProducerVoteInfoResponse{} }
+func (m *GetProducerVoteInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetProducerVoteInfoResponse) ProtoMessage()    {}
+func (*GetProducerVoteInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{33}
+}
+
+func (m *GetProducerVoteInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProducerVoteInfoResponse.Unmarshal(m, b)
+}
+func (m *GetProducerVoteInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProducerVoteInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetProducerVoteInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProducerVoteInfoResponse.Merge(m, src)
+}
+func (m *GetProducerVoteInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetProducerVoteInfoResponse.Size(m)
+}
+func (m *GetProducerVoteInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProducerVoteInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProducerVoteInfoResponse proto.InternalMessageInfo
+
+func (m *GetProducerVoteInfoResponse) GetPubkey() string {
+	if m != nil {
+		return m.Pubkey
+	}
+	return ""
+}
+
+func (m *GetProducerVoteInfoResponse) GetLoc() string {
+	if m != nil {
+		return m.Loc
+	}
+	return ""
+}
+
+func (m *GetProducerVoteInfoResponse) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *GetProducerVoteInfoResponse) GetNetId() string {
+	if m != nil {
+		return m.NetId
+	}
+	return ""
+}
+
+func (m *GetProducerVoteInfoResponse) GetIsProducer() bool {
+	if m != nil {
+		return m.IsProducer
+	}
+	return false
+}
+
+func (m *GetProducerVoteInfoResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GetProducerVoteInfoResponse) GetOnline() bool {
+	if m != nil {
+		return m.Online
+	}
+	return false
+}
+
+func (m *GetProducerVoteInfoResponse) GetVotes() float64 {
+	if m != nil {
+		return m.Votes
+	}
+	return 0
+}
+
+type GasRatioResponse struct {
+	// lowest gas ratio in head block
+	LowestGasRatio float64 `protobuf:"fixed64,1,opt,name=lowest_gas_ratio,json=lowestGasRatio,proto3" json:"lowest_gas_ratio,omitempty"`
+	// median gas ratio in head block
+	MedianGasRatio       float64  `protobuf:"fixed64,2,opt,name=median_gas_ratio,json=medianGasRatio,proto3" json:"median_gas_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GasRatioResponse) Reset()         { *m = GasRatioResponse{} }
+func (m *GasRatioResponse) String() string { return proto.CompactTextString(m) }
+func (*GasRatioResponse) ProtoMessage()    {}
+func (*GasRatioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{34}
+}
+
+func (m *GasRatioResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GasRatioResponse.Unmarshal(m, b)
+}
+func (m *GasRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GasRatioResponse.Marshal(b, m, deterministic)
+}
+func (m *GasRatioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasRatioResponse.Merge(m, src)
+}
+func (m *GasRatioResponse) XXX_Size() int {
+	return xxx_messageInfo_GasRatioResponse.Size(m)
+}
+func (m *GasRatioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasRatioResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasRatioResponse proto.InternalMessageInfo
+
+func (m *GasRatioResponse) GetLowestGasRatio() float64 {
+	if m != nil {
+		return m.LowestGasRatio
+	}
+	return 0
+}
+
+func (m *GasRatioResponse) GetMedianGasRatio() float64 {
+	if m != nil {
+		return m.MedianGasRatio
+	}
+	return 0
+}
+
+// The message defines account struct.
+type Account struct {
+	// account name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// account balance
+	Balance float64 `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// gas information
+	GasInfo *Account_GasInfo `protobuf:"bytes,3,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
+	// ram information
+	RamInfo *Account_RAMInfo `protobuf:"bytes,4,opt,name=ram_info,json=ramInfo,proto3" json:"ram_info,omitempty"`
+	// account permission
+	Permissions map[string]*Account_Permission `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// account groups
+	Groups map[string]*Account_Group `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// frozen balance information
+	FrozenBalances []*FrozenBalance `protobuf:"bytes,7,rep,name=frozen_balances,json=frozenBalances,proto3" json:"frozen_balances,omitempty"`
+	// vote information
+	VoteInfos            []*VoteInfo `protobuf:"bytes,8,rep,name=vote_infos,json=voteInfos,proto3" json:"vote_infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Account) Reset()         { *m = Account{} }
+func (m *Account) String() string { return proto.CompactTextString(m) }
+func (*Account) ProtoMessage()    {}
+func (*Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{35}
+}
+
+func (m *Account) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account.Unmarshal(m, b)
+}
+func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account.Marshal(b, m, deterministic)
+}
+func (m *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(m, src)
+}
+func (m *Account) XXX_Size() int {
+	return xxx_messageInfo_Account.Size(m)
+}
+func (m *Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account proto.InternalMessageInfo
+
+func (m *Account) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Account) GetBalance() float64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *Account) GetGasInfo() *Account_GasInfo {
+	if m != nil {
+		return m.GasInfo
+	}
+	return nil
+}
+
+func (m *Account) GetRamInfo() *Account_RAMInfo {
+	if m != nil {
+		return m.RamInfo
+	}
+	return nil
+}
+
+func (m *Account) GetPermissions() map[string]*Account_Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *Account) GetGroups() map[string]*Account_Group {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *Account) GetFrozenBalances() []*FrozenBalance {
+	if m != nil {
+		return m.FrozenBalances
+	}
+	return nil
+}
+
+func (m *Account) GetVoteInfos() []*VoteInfo {
+	if m != nil {
+		return m.VoteInfos
+	}
+	return nil
+}
+
+// The message defines account pledged coin information.
+type Account_PledgeInfo struct {
+	// the account who pledges
+	Pledger string `protobuf:"bytes,1,opt,name=pledger,proto3" json:"pledger,omitempty"`
+	// pledged amount
+	Amount               float64  `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Account_PledgeInfo) Reset()         { *m = Account_PledgeInfo{} }
+func (m *Account_PledgeInfo) String() string { return proto.CompactTextString(m) }
+func (*Account_PledgeInfo) ProtoMessage()    {}
+func (*Account_PledgeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{35, 0}
+}
+
+func (m *Account_PledgeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_PledgeInfo.Unmarshal(m, b)
+}
+func (m *Account_PledgeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_PledgeInfo.Marshal(b, m, deterministic)
+}
+func (m *Account_PledgeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_PledgeInfo.Merge(m, src)
+}
+func (m *Account_PledgeInfo) XXX_Size() int {
+	return xxx_messageInfo_Account_PledgeInfo.Size(m)
+}
+func (m *Account_PledgeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_PledgeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account_PledgeInfo proto.InternalMessageInfo
+
+func (m *Account_PledgeInfo) GetPledger() string {
+	if m != nil {
+		return m.Pledger
+	}
+	return ""
+}
+
+func (m *Account_PledgeInfo) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// The message defines account gas information.
+type Account_GasInfo struct {
+	// current total gas amount
+	CurrentTotal    float64 `protobuf:"fixed64,1,opt,name=current_total,json=currentTotal,proto3" json:"current_total,omitempty"`
+	TransferableGas float64 `protobuf:"fixed64,2,opt,name=transferable_gas,json=transferableGas,proto3" json:"transferable_gas,omitempty"`
+	PledgeGas       float64 `protobuf:"fixed64,3,opt,name=pledge_gas,json=pledgeGas,proto3" json:"pledge_gas,omitempty"`
+	// gas increase speed
+	IncreaseSpeed float64 `protobuf:"fixed64,4,opt,name=increase_speed,json=increaseSpeed,proto3" json:"increase_speed,omitempty"`
+	// gas limit
+	Limit float64 `protobuf:"fixed64,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// pledge information
+	PledgedInfo          []*Account_PledgeInfo `protobuf:"bytes,6,rep,name=pledged_info,json=pledgedInfo,proto3" json:"pledged_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Account_GasInfo) Reset()         { *m = Account_GasInfo{} }
+func (m *Account_GasInfo) String() string { return proto.CompactTextString(m) }
+func (*Account_GasInfo) ProtoMessage()    {}
+func (*Account_GasInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{35, 1}
+}
+
+func (m *Account_GasInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_GasInfo.Unmarshal(m, b)
+}
+func (m *Account_GasInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_GasInfo.Marshal(b, m, deterministic)
+}
+func (m *Account_GasInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_GasInfo.Merge(m, src)
+}
+func (m *Account_GasInfo) XXX_Size() int {
+	return xxx_messageInfo_Account_GasInfo.Size(m)
+}
+func (m *Account_GasInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_GasInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account_GasInfo proto.InternalMessageInfo
+
+func (m *Account_GasInfo) GetCurrentTotal() float64 {
+	if m != nil {
+		return m.CurrentTotal
+	}
+	return 0
+}
+
+func (m *Account_GasInfo) GetTransferableGas() float64 {
+	if m != nil {
+		return m.TransferableGas
+	}
+	return 0
+}
+
+func (m *Account_GasInfo) GetPledgeGas() float64 {
+	if m != nil {
+		return m.PledgeGas
+	}
+	return 0
+}
+
+func (m *Account_GasInfo) GetIncreaseSpeed() float64 {
+	if m != nil {
+		return m.IncreaseSpeed
+	}
+	return 0
+}
+
+func (m *Account_GasInfo) GetLimit() float64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *Account_GasInfo) GetPledgedInfo() []*Account_PledgeInfo {
+	if m != nil {
+		return m.PledgedInfo
+	}
+	return nil
+}
+
+// The message defines account ram information.
+type Account_RAMInfo struct {
+	// available ram bytes
+	Available int64 `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	// used ram bytes
+	Used int64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	// total ram bytes
+	Total                int64    `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Account_RAMInfo) Reset()         { *m = Account_RAMInfo{} }
+func (m *Account_RAMInfo) String() string { return proto.CompactTextString(m) }
+func (*Account_RAMInfo) ProtoMessage()    {}
+func (*Account_RAMInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{35, 2}
+}
+
+func (m *Account_RAMInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_RAMInfo.Unmarshal(m, b)
+}
+func (m *Account_RAMInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_RAMInfo.Marshal(b, m, deterministic)
+}
+func (m *Account_RAMInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_RAMInfo.Merge(m, src)
+}
+func (m *Account_RAMInfo) XXX_Size() int {
+	return xxx_messageInfo_Account_RAMInfo.Size(m)
+}
+func (m *Account_RAMInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_RAMInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account_RAMInfo proto.InternalMessageInfo
+
+func (m *Account_RAMInfo) GetAvailable() int64 {
+	if m != nil {
+		return m.Available
+	}
+	return 0
+}
+
+func (m *Account_RAMInfo) GetUsed() int64 {
+	if m != nil {
+		return m.Used
+	}
+	return 0
+}
+
+func (m *Account_RAMInfo) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+// The message defines permission item.
+type Account_Item struct {
+	// permission name or key pair id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// whether it's a key pair
+	IsKeyPair bool `protobuf:"varint,2,opt,name=is_key_pair,json=isKeyPair,proto3" json:"is_key_pair,omitempty"`
+	// permission weight
+	Weight int64 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// permission
+	Permission           string   `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Account_Item) Reset()         { *m = Account_Item{} }
+func (m *Account_Item) String() string { return proto.CompactTextString(m) }
+func (*Account_Item) ProtoMessage()    {}
+func (*Account_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{35, 3}
+}
+
+func (m *Account_Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_Item.Unmarshal(m, b)
+}
+func (m *Account_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_Item.Marshal(b, m, deterministic)
+}
+func (m *Account_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_Item.Merge(m, src)
+}
+func (m *Account_Item) XXX_Size() int {
+	return xxx_messageInfo_Account_Item.Size(m)
+}
+func (m *Account_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account_Item proto.InternalMessageInfo
+
+func (m *Account_Item) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Account_Item) GetIsKeyPair() bool {
+	if m != nil {
+		return m.IsKeyPair
+	}
+	return false
+}
+
+func (m *Account_Item) GetWeight() int64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *Account_Item) GetPermission() string {
+	if m != nil {
+		return m.Permission
+	}
+	return ""
+}
+
+// The message defines a permission group.
+type Account_Group struct {
+	// group name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// permission items
+	Items                []*Account_Item `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Account_Group) Reset()         { *m = Account_Group{} }
+func (m *Account_Group) String() string { return proto.CompactTextString(m) }
+func (*Account_Group) ProtoMessage()    {}
+func (*Account_Group) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{35, 4}
+}
+
+func (m *Account_Group) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_Group.Unmarshal(m, b)
+}
+func (m *Account_Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_Group.Marshal(b, m, deterministic)
+}
+func (m *Account_Group) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_Group.Merge(m, src)
+}
+func (m *Account_Group) XXX_Size() int {
+	return xxx_messageInfo_Account_Group.Size(m)
+}
+func (m *Account_Group) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_Group.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account_Group proto.InternalMessageInfo
+
+func (m *Account_Group) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Account_Group) GetItems() []*Account_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// The message defines the permission struct.
+type Account_Permission struct {
+	// permission name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// permission groups
+	GroupNames []string `protobuf:"bytes,2,rep,name=group_names,json=groupNames,proto3" json:"group_names,omitempty"`
+	// permission items
+	Items []*Account_Item `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	// permission threshold
+	Threshold            int64    `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Account_Permission) Reset()         { *m = Account_Permission{} }
+func (m *Account_Permission) String() string { return proto.CompactTextString(m) }
+func (*Account_Permission) ProtoMessage()    {}
+func (*Account_Permission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{35, 5}
+}
+
+func (m *Account_Permission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_Permission.Unmarshal(m, b)
+}
+func (m *Account_Permission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_Permission.Marshal(b, m, deterministic)
+}
+func (m *Account_Permission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_Permission.Merge(m, src)
+}
+func (m *Account_Permission) XXX_Size() int {
+	return xxx_messageInfo_Account_Permission.Size(m)
+}
+func (m *Account_Permission) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_Permission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account_Permission proto.InternalMessageInfo
+
+func (m *Account_Permission) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Account_Permission) GetGroupNames() []string {
+	if m != nil {
+		return m.GroupNames
+	}
+	return nil
+}
+
+func (m *Account_Permission) GetItems() []*Account_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *Account_Permission) GetThreshold() int64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+// The message defines the get account request.
+type GetAccountRequest struct {
+	// account name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// get account by longest chain's head block or last irreversible block
+	ByLongestChain bool `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get account at the given block if has_block_number is set, which overrides by_longest_chain. irreversible blocks need archive mode of the node
+	BlockNumber int64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// whether block_number is set
+	HasBlockNumber       bool     `protobuf:"varint,4,opt,name=has_block_number,json=hasBlockNumber,proto3" json:"has_block_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAccountRequest) Reset()         { *m = GetAccountRequest{} }
+func (m *GetAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountRequest) ProtoMessage()    {}
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{36}
+}
+
+func (m *GetAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountRequest.Unmarshal(m, b)
+}
+func (m *GetAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountRequest.Merge(m, src)
+}
+func (m *GetAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountRequest.Size(m)
+}
+func (m *GetAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountRequest proto.InternalMessageInfo
+
+func (m *GetAccountRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetAccountRequest) GetByLongestChain() bool {
+	if m != nil {
+		return m.ByLongestChain
+	}
+	return false
+}
+
+func (m *GetAccountRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *GetAccountRequest) GetHasBlockNumber() bool {
+	if m != nil {
+		return m.HasBlockNumber
+	}
+	return false
+}
+
+// The message defines the contract struct.
+type Contract struct {
+	// contract id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// contract code
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// contract language
+	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	// contract version
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// contract abis
+	Abis                 []*Contract_ABI `protobuf:"bytes,5,rep,name=abis,proto3" json:"abis,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Contract) Reset()         { *m = Contract{} }
+func (m *Contract) String() string { return proto.CompactTextString(m) }
+func (*Contract) ProtoMessage()    {}
+func (*Contract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{37}
+}
+
+func (m *Contract) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Contract.Unmarshal(m, b)
+}
+func (m *Contract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Contract.Marshal(b, m, deterministic)
+}
+func (m *Contract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Contract.Merge(m, src)
+}
+func (m *Contract) XXX_Size() int {
+	return xxx_messageInfo_Contract.Size(m)
+}
+func (m *Contract) XXX_DiscardUnknown() {
+	xxx_messageInfo_Contract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Contract proto.InternalMessageInfo
+
+func (m *Contract) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-var xxx_messageInfo_VoteInfo proto.InternalMessageInfo
+func (m *Contract) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
 
-func (m *VoteInfo) GetOption() string {
+func (m *Contract) GetLanguage() string {
 	if m != nil {
-		return m.Option
+		return m.Language
 	}
 	return ""
 }
 
-func (m *VoteInfo) GetVotes() float64 {
+func (m *Contract) GetVersion() string {
 	if m != nil {
-		return m.Votes
+		return m.Version
 	}
-	return 0
+	return ""
 }
 
-func (m *VoteInfo) GetClearedVotes() float64 {
+func (m *Contract) GetAbis() []*Contract_ABI {
 	if m != nil {
-		return m.ClearedVotes
+		return m.Abis
 	}
-	return 0
+	return nil
 }
 
-type GetProducerVoteInfoRequest struct {
-	// account name
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// get data by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// The message defines the ABI struct.
+type Contract_ABI struct {
+	// abi name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// abi arguments
+	Args []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	// abi amount limt
+	AmountLimit          []*AmountLimit `protobuf:"bytes,3,rep,name=amount_limit,json=amountLimit,proto3" json:"amount_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *GetProducerVoteInfoRequest) Reset()         { *m = GetProducerVoteInfoRequest{} }
-func (m *GetProducerVoteInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetProducerVoteInfoRequest) ProtoMessage()    {}
-func (*GetProducerVoteInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{19}
+func (m *Contract_ABI) Reset()         { *m = Contract_ABI{} }
+func (m *Contract_ABI) String() string { return proto.CompactTextString(m) }
+func (*Contract_ABI) ProtoMessage()    {}
+func (*Contract_ABI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{37, 0}
 }
 
-func (m *GetProducerVoteInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetProducerVoteInfoRequest.Unmarshal(m, b)
+func (m *Contract_ABI) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Contract_ABI.Unmarshal(m, b)
 }
-func (m *GetProducerVoteInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetProducerVoteInfoRequest.Marshal(b, m, deterministic)
+func (m *Contract_ABI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Contract_ABI.Marshal(b, m, deterministic)
 }
-func (m *GetProducerVoteInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetProducerVoteInfoRequest.Merge(m, src)
+func (m *Contract_ABI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Contract_ABI.Merge(m, src)
 }
-func (m *GetProducerVoteInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_GetProducerVoteInfoRequest.Size(m)
+func (m *Contract_ABI) XXX_Size() int {
+	return xxx_messageInfo_Contract_ABI.Size(m)
 }
-func (m *GetProducerVoteInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetProducerVoteInfoRequest.DiscardUnknown(m)
+func (m *Contract_ABI) XXX_DiscardUnknown() {
+	xxx_messageInfo_Contract_ABI.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetProducerVoteInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_Contract_ABI proto.InternalMessageInfo
 
-func (m *GetProducerVoteInfoRequest) GetAccount() string {
+func (m *Contract_ABI) GetName() string {
 	if m != nil {
-		return m.Account
+		return m.Name
 	}
 	return ""
 }
 
-func (m *GetProducerVoteInfoRequest) GetByLongestChain() bool {
+func (m *Contract_ABI) GetArgs() []string {
 	if m != nil {
-		return m.ByLongestChain
+		return m.Args
 	}
-	return false
+	return nil
 }
 
-type GetProducerVoteInfoResponse struct {
-	// producer's public key
-	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	// producer's location
-	Loc string `protobuf:"bytes,2,opt,name=loc,proto3" json:"loc,omitempty"`
-	// producer's url
-	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	// producer's net id
-	NetId string `protobuf:"bytes,4,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
-	// is producer
-	IsProducer bool `protobuf:"varint,5,opt,name=is_producer,json=isProducer,proto3" json:"is_producer,omitempty"`
-	// is approved
-	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	// is online
-	Online bool `protobuf:"varint,7,opt,name=online,proto3" json:"online,omitempty"`
-	// votes
-	Votes                float64  `protobuf:"fixed64,8,opt,name=votes,proto3" json:"votes,omitempty"`
+func (m *Contract_ABI) GetAmountLimit() []*AmountLimit {
+	if m != nil {
+		return m.AmountLimit
+	}
+	return nil
+}
+
+// The message defines encode action request.
+type EncodeActionRequest struct {
+	// contract id
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// action name
+	ActionName string `protobuf:"bytes,2,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
+	// arguments in the order of abi args, a string argument is used as it is, the others are in json
+	Args []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	// get the abi by longest chain's head block or last irreversible block
+	ByLongestChain       bool     `protobuf:"varint,4,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetProducerVoteInfoResponse) Reset()         { *m = GetProducerVoteInfoResponse{} }
-func (m *GetProducerVoteInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetProducerVoteInfoResponse) ProtoMessage()    {}
-func (*GetProducerVoteInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{20}
+func (m *EncodeActionRequest) Reset()         { *m = EncodeActionRequest{} }
+func (m *EncodeActionRequest) String() string { return proto.CompactTextString(m) }
+func (*EncodeActionRequest) ProtoMessage()    {}
+func (*EncodeActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{38}
 }
 
-func (m *GetProducerVoteInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetProducerVoteInfoResponse.Unmarshal(m, b)
+func (m *EncodeActionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EncodeActionRequest.Unmarshal(m, b)
 }
-func (m *GetProducerVoteInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetProducerVoteInfoResponse.Marshal(b, m, deterministic)
+func (m *EncodeActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EncodeActionRequest.Marshal(b, m, deterministic)
 }
-func (m *GetProducerVoteInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetProducerVoteInfoResponse.Merge(m, src)
+func (m *EncodeActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncodeActionRequest.Merge(m, src)
 }
-func (m *GetProducerVoteInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_GetProducerVoteInfoResponse.Size(m)
+func (m *EncodeActionRequest) XXX_Size() int {
+	return xxx_messageInfo_EncodeActionRequest.Size(m)
 }
-func (m *GetProducerVoteInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetProducerVoteInfoResponse.DiscardUnknown(m)
+func (m *EncodeActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncodeActionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetProducerVoteInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_EncodeActionRequest proto.InternalMessageInfo
 
-func (m *GetProducerVoteInfoResponse) GetPubkey() string {
+func (m *EncodeActionRequest) GetContract() string {
 	if m != nil {
-		return m.Pubkey
+		return m.Contract
 	}
 	return ""
 }
 
-func (m *GetProducerVoteInfoResponse) GetLoc() string {
+func (m *EncodeActionRequest) GetActionName() string {
 	if m != nil {
-		return m.Loc
+		return m.ActionName
 	}
 	return ""
 }
 
-func (m *GetProducerVoteInfoResponse) GetUrl() string {
+func (m *EncodeActionRequest) GetArgs() []string {
 	if m != nil {
-		return m.Url
+		return m.Args
 	}
-	return ""
+	return nil
 }
 
-func (m *GetProducerVoteInfoResponse) GetNetId() string {
+func (m *EncodeActionRequest) GetByLongestChain() bool {
 	if m != nil {
-		return m.NetId
+		return m.ByLongestChain
 	}
-	return ""
+	return false
 }
 
-func (m *GetProducerVoteInfoResponse) GetIsProducer() bool {
-	if m != nil {
-		return m.IsProducer
-	}
-	return false
+// The message defines the error of an argument.
+type ArgError struct {
+	// index of the argument
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// abi type of the argument
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// error message
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetProducerVoteInfoResponse) GetStatus() string {
+func (m *ArgError) Reset()         { *m = ArgError{} }
+func (m *ArgError) String() string { return proto.CompactTextString(m) }
+func (*ArgError) ProtoMessage()    {}
+func (*ArgError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{39}
+}
+
+func (m *ArgError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArgError.Unmarshal(m, b)
+}
+func (m *ArgError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArgError.Marshal(b, m, deterministic)
+}
+func (m *ArgError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArgError.Merge(m, src)
+}
+func (m *ArgError) XXX_Size() int {
+	return xxx_messageInfo_ArgError.Size(m)
+}
+func (m *ArgError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArgError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArgError proto.InternalMessageInfo
+
+func (m *ArgError) GetIndex() int32 {
 	if m != nil {
-		return m.Status
+		return m.Index
 	}
-	return ""
+	return 0
 }
 
-func (m *GetProducerVoteInfoResponse) GetOnline() bool {
+func (m *ArgError) GetType() string {
 	if m != nil {
-		return m.Online
+		return m.Type
 	}
-	return false
+	return ""
 }
 
-func (m *GetProducerVoteInfoResponse) GetVotes() float64 {
+func (m *ArgError) GetError() string {
 	if m != nil {
-		return m.Votes
+		return m.Error
 	}
-	return 0
+	return ""
 }
 
-type GasRatioResponse struct {
-	// lowest gas ratio in head block
-	LowestGasRatio float64 `protobuf:"fixed64,1,opt,name=lowest_gas_ratio,json=lowestGasRatio,proto3" json:"lowest_gas_ratio,omitempty"`
-	// median gas ratio in head block
-	MedianGasRatio       float64  `protobuf:"fixed64,2,opt,name=median_gas_ratio,json=medianGasRatio,proto3" json:"median_gas_ratio,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// The message defines encode action response.
+type EncodeActionResponse struct {
+	// the action with encoded data, not set if any argument is invalid
+	Action *Action `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// abi types of the arguments
+	ArgTypes []string `protobuf:"bytes,2,rep,name=arg_types,json=argTypes,proto3" json:"arg_types,omitempty"`
+	// errors of the invalid arguments
+	Errors               []*ArgError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *GasRatioResponse) Reset()         { *m = GasRatioResponse{} }
-func (m *GasRatioResponse) String() string { return proto.CompactTextString(m) }
-func (*GasRatioResponse) ProtoMessage()    {}
-func (*GasRatioResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{21}
+func (m *EncodeActionResponse) Reset()         { *m = EncodeActionResponse{} }
+func (m *EncodeActionResponse) String() string { return proto.CompactTextString(m) }
+func (*EncodeActionResponse) ProtoMessage()    {}
+func (*EncodeActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{40}
 }
 
-func (m *GasRatioResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GasRatioResponse.Unmarshal(m, b)
+func (m *EncodeActionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EncodeActionResponse.Unmarshal(m, b)
 }
-func (m *GasRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GasRatioResponse.Marshal(b, m, deterministic)
+func (m *EncodeActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EncodeActionResponse.Marshal(b, m, deterministic)
 }
-func (m *GasRatioResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GasRatioResponse.Merge(m, src)
+func (m *EncodeActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncodeActionResponse.Merge(m, src)
 }
-func (m *GasRatioResponse) XXX_Size() int {
-	return xxx_messageInfo_GasRatioResponse.Size(m)
+func (m *EncodeActionResponse) XXX_Size() int {
+	return xxx_messageInfo_EncodeActionResponse.Size(m)
 }
-func (m *GasRatioResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GasRatioResponse.DiscardUnknown(m)
+func (m *EncodeActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncodeActionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GasRatioResponse proto.InternalMessageInfo
+var xxx_messageInfo_EncodeActionResponse proto.InternalMessageInfo
 
-func (m *GasRatioResponse) GetLowestGasRatio() float64 {
+func (m *EncodeActionResponse) GetAction() *Action {
 	if m != nil {
-		return m.LowestGasRatio
+		return m.Action
 	}
-	return 0
+	return nil
 }
 
-func (m *GasRatioResponse) GetMedianGasRatio() float64 {
+func (m *EncodeActionResponse) GetArgTypes() []string {
 	if m != nil {
-		return m.MedianGasRatio
+		return m.ArgTypes
 	}
-	return 0
+	return nil
 }
 
-// The message defines account struct.
-type Account struct {
-	// account name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// account balance
-	Balance float64 `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
-	// gas information
-	GasInfo *Account_GasInfo `protobuf:"bytes,3,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
-	// ram information
-	RamInfo *Account_RAMInfo `protobuf:"bytes,4,opt,name=ram_info,json=ramInfo,proto3" json:"ram_info,omitempty"`
-	// account permission
-	Permissions map[string]*Account_Permission `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// account groups
-	Groups map[string]*Account_Group `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// frozen balance information
-	FrozenBalances []*FrozenBalance `protobuf:"bytes,7,rep,name=frozen_balances,json=frozenBalances,proto3" json:"frozen_balances,omitempty"`
-	// vote information
-	VoteInfos            []*VoteInfo `protobuf:"bytes,8,rep,name=vote_infos,json=voteInfos,proto3" json:"vote_infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *EncodeActionResponse) GetErrors() []*ArgError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
 }
 
-func (m *Account) Reset()         { *m = Account{} }
-func (m *Account) String() string { return proto.CompactTextString(m) }
-func (*Account) ProtoMessage()    {}
-func (*Account) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{22}
+// The message defines an action or receipt decoded against the abi.
+type DecodedCall struct {
+	// contract id
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// action name
+	ActionName string `protobuf:"bytes,2,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
+	// abi types of the arguments
+	ArgTypes []string `protobuf:"bytes,3,rep,name=arg_types,json=argTypes,proto3" json:"arg_types,omitempty"`
+	// arguments rendered in the same way as EncodeActionRequest.args
+	Args []string `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	// errors of the arguments unmatched to the abi
+	ArgErrors []*ArgError `protobuf:"bytes,5,rep,name=arg_errors,json=argErrors,proto3" json:"arg_errors,omitempty"`
+	// action data or receipt content
+	Raw string `protobuf:"bytes,6,opt,name=raw,proto3" json:"raw,omitempty"`
+	// set if it can not be decoded against the abi, such as a receipt in free text
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// return values of the action, a string is rendered as it is, the others are in json
+	Returns              []string `protobuf:"bytes,8,rep,name=returns,proto3" json:"returns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecodedCall) Reset()         { *m = DecodedCall{} }
+func (m *DecodedCall) String() string { return proto.CompactTextString(m) }
+func (*DecodedCall) ProtoMessage()    {}
+func (*DecodedCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{41}
 }
 
-func (m *Account) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account.Unmarshal(m, b)
+func (m *DecodedCall) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecodedCall.Unmarshal(m, b)
 }
-func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account.Marshal(b, m, deterministic)
+func (m *DecodedCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecodedCall.Marshal(b, m, deterministic)
 }
-func (m *Account) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account.Merge(m, src)
+func (m *DecodedCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedCall.Merge(m, src)
 }
-func (m *Account) XXX_Size() int {
-	return xxx_messageInfo_Account.Size(m)
+func (m *DecodedCall) XXX_Size() int {
+	return xxx_messageInfo_DecodedCall.Size(m)
 }
-func (m *Account) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account.DiscardUnknown(m)
+func (m *DecodedCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedCall.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account proto.InternalMessageInfo
+var xxx_messageInfo_DecodedCall proto.InternalMessageInfo
 
-func (m *Account) GetName() string {
+func (m *DecodedCall) GetContract() string {
 	if m != nil {
-		return m.Name
+		return m.Contract
 	}
 	return ""
 }
 
-func (m *Account) GetBalance() float64 {
+func (m *DecodedCall) GetActionName() string {
 	if m != nil {
-		return m.Balance
+		return m.ActionName
 	}
-	return 0
+	return ""
 }
 
-func (m *Account) GetGasInfo() *Account_GasInfo {
+func (m *DecodedCall) GetArgTypes() []string {
 	if m != nil {
-		return m.GasInfo
+		return m.ArgTypes
 	}
 	return nil
 }
 
-func (m *Account) GetRamInfo() *Account_RAMInfo {
+func (m *DecodedCall) GetArgs() []string {
 	if m != nil {
-		return m.RamInfo
+		return m.Args
 	}
 	return nil
 }
 
-func (m *Account) GetPermissions() map[string]*Account_Permission {
+func (m *DecodedCall) GetArgErrors() []*ArgError {
 	if m != nil {
-		return m.Permissions
+		return m.ArgErrors
 	}
 	return nil
 }
 
-func (m *Account) GetGroups() map[string]*Account_Group {
+func (m *DecodedCall) GetRaw() string {
 	if m != nil {
-		return m.Groups
+		return m.Raw
 	}
-	return nil
+	return ""
 }
 
-func (m *Account) GetFrozenBalances() []*FrozenBalance {
+func (m *DecodedCall) GetError() string {
 	if m != nil {
-		return m.FrozenBalances
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-func (m *Account) GetVoteInfos() []*VoteInfo {
+func (m *DecodedCall) GetReturns() []string {
 	if m != nil {
-		return m.VoteInfos
+		return m.Returns
 	}
 	return nil
 }
 
-// The message defines account pledged coin information.
-type Account_PledgeInfo struct {
-	// the account who pledges
-	Pledger string `protobuf:"bytes,1,opt,name=pledger,proto3" json:"pledger,omitempty"`
-	// pledged amount
-	Amount               float64  `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+// The message defines decode transaction response.
+type DecodeTxResponse struct {
+	// transaction hash
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// the actions of the transaction with their returns
+	Actions []*DecodedCall `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+	// the receipts of the transaction, empty if it is not packed
+	Receipts []*DecodedCall `protobuf:"bytes,3,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	// status code of the transaction
+	StatusCode TxReceipt_StatusCode `protobuf:"varint,4,opt,name=status_code,json=statusCode,proto3,enum=rpcpb.TxReceipt_StatusCode" json:"status_code,omitempty"`
+	// message of the status
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account_PledgeInfo) Reset()         { *m = Account_PledgeInfo{} }
-func (m *Account_PledgeInfo) String() string { return proto.CompactTextString(m) }
-func (*Account_PledgeInfo) ProtoMessage()    {}
-func (*Account_PledgeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{22, 0}
+func (m *DecodeTxResponse) Reset()         { *m = DecodeTxResponse{} }
+func (m *DecodeTxResponse) String() string { return proto.CompactTextString(m) }
+func (*DecodeTxResponse) ProtoMessage()    {}
+func (*DecodeTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{42}
 }
 
-func (m *Account_PledgeInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_PledgeInfo.Unmarshal(m, b)
+func (m *DecodeTxResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecodeTxResponse.Unmarshal(m, b)
 }
-func (m *Account_PledgeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_PledgeInfo.Marshal(b, m, deterministic)
+func (m *DecodeTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecodeTxResponse.Marshal(b, m, deterministic)
 }
-func (m *Account_PledgeInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_PledgeInfo.Merge(m, src)
+func (m *DecodeTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeTxResponse.Merge(m, src)
 }
-func (m *Account_PledgeInfo) XXX_Size() int {
-	return xxx_messageInfo_Account_PledgeInfo.Size(m)
+func (m *DecodeTxResponse) XXX_Size() int {
+	return xxx_messageInfo_DecodeTxResponse.Size(m)
 }
-func (m *Account_PledgeInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_PledgeInfo.DiscardUnknown(m)
+func (m *DecodeTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeTxResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account_PledgeInfo proto.InternalMessageInfo
+var xxx_messageInfo_DecodeTxResponse proto.InternalMessageInfo
 
-func (m *Account_PledgeInfo) GetPledger() string {
+func (m *DecodeTxResponse) GetTxHash() string {
 	if m != nil {
-		return m.Pledger
+		return m.TxHash
 	}
 	return ""
 }
 
-func (m *Account_PledgeInfo) GetAmount() float64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
-// The message defines account gas information.
-type Account_GasInfo struct {
-	// current total gas amount
-	CurrentTotal    float64 `protobuf:"fixed64,1,opt,name=current_total,json=currentTotal,proto3" json:"current_total,omitempty"`
-	TransferableGas float64 `protobuf:"fixed64,2,opt,name=transferable_gas,json=transferableGas,proto3" json:"transferable_gas,omitempty"`
-	PledgeGas       float64 `protobuf:"fixed64,3,opt,name=pledge_gas,json=pledgeGas,proto3" json:"pledge_gas,omitempty"`
-	// gas increase speed
-	IncreaseSpeed float64 `protobuf:"fixed64,4,opt,name=increase_speed,json=increaseSpeed,proto3" json:"increase_speed,omitempty"`
-	// gas limit
-	Limit float64 `protobuf:"fixed64,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	// pledge information
-	PledgedInfo          []*Account_PledgeInfo `protobuf:"bytes,6,rep,name=pledged_info,json=pledgedInfo,proto3" json:"pledged_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
-}
-
-func (m *Account_GasInfo) Reset()         { *m = Account_GasInfo{} }
-func (m *Account_GasInfo) String() string { return proto.CompactTextString(m) }
-func (*Account_GasInfo) ProtoMessage()    {}
-func (*Account_GasInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{22, 1}
-}
-
-func (m *Account_GasInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_GasInfo.Unmarshal(m, b)
-}
-func (m *Account_GasInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_GasInfo.Marshal(b, m, deterministic)
-}
-func (m *Account_GasInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_GasInfo.Merge(m, src)
-}
-func (m *Account_GasInfo) XXX_Size() int {
-	return xxx_messageInfo_Account_GasInfo.Size(m)
-}
-func (m *Account_GasInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_GasInfo.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Account_GasInfo proto.InternalMessageInfo
-
-func (m *Account_GasInfo) GetCurrentTotal() float64 {
-	if m != nil {
-		return m.CurrentTotal
-	}
-	return 0
-}
-
-func (m *Account_GasInfo) GetTransferableGas() float64 {
-	if m != nil {
-		return m.TransferableGas
-	}
-	return 0
-}
-
-func (m *Account_GasInfo) GetPledgeGas() float64 {
+func (m *DecodeTxResponse) GetActions() []*DecodedCall {
 	if m != nil {
-		return m.PledgeGas
+		return m.Actions
 	}
-	return 0
+	return nil
 }
 
-func (m *Account_GasInfo) GetIncreaseSpeed() float64 {
+func (m *DecodeTxResponse) GetReceipts() []*DecodedCall {
 	if m != nil {
-		return m.IncreaseSpeed
+		return m.Receipts
 	}
-	return 0
+	return nil
 }
 
-func (m *Account_GasInfo) GetLimit() float64 {
+func (m *DecodeTxResponse) GetStatusCode() TxReceipt_StatusCode {
 	if m != nil {
-		return m.Limit
+		return m.StatusCode
 	}
-	return 0
+	return TxReceipt_SUCCESS
 }
 
-func (m *Account_GasInfo) GetPledgedInfo() []*Account_PledgeInfo {
+func (m *DecodeTxResponse) GetMessage() string {
 	if m != nil {
-		return m.PledgedInfo
+		return m.Message
 	}
-	return nil
+	return ""
 }
 
-// The message defines account ram information.
-type Account_RAMInfo struct {
-	// available ram bytes
-	Available int64 `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
-	// used ram bytes
-	Used int64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
-	// total ram bytes
-	Total                int64    `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+// The message defines get contract request.
+type GetContractRequest struct {
+	// contract id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// get data by longest chain's head block or last irreversible block
+	ByLongestChain       bool     `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account_RAMInfo) Reset()         { *m = Account_RAMInfo{} }
-func (m *Account_RAMInfo) String() string { return proto.CompactTextString(m) }
-func (*Account_RAMInfo) ProtoMessage()    {}
-func (*Account_RAMInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{22, 2}
+func (m *GetContractRequest) Reset()         { *m = GetContractRequest{} }
+func (m *GetContractRequest) String() string { return proto.CompactTextString(m) }
+func (*GetContractRequest) ProtoMessage()    {}
+func (*GetContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{43}
 }
 
-func (m *Account_RAMInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_RAMInfo.Unmarshal(m, b)
+func (m *GetContractRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractRequest.Unmarshal(m, b)
 }
-func (m *Account_RAMInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_RAMInfo.Marshal(b, m, deterministic)
+func (m *GetContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractRequest.Marshal(b, m, deterministic)
 }
-func (m *Account_RAMInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_RAMInfo.Merge(m, src)
+func (m *GetContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractRequest.Merge(m, src)
 }
-func (m *Account_RAMInfo) XXX_Size() int {
-	return xxx_messageInfo_Account_RAMInfo.Size(m)
+func (m *GetContractRequest) XXX_Size() int {
+	return xxx_messageInfo_GetContractRequest.Size(m)
 }
-func (m *Account_RAMInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_RAMInfo.DiscardUnknown(m)
+func (m *GetContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account_RAMInfo proto.InternalMessageInfo
-
-func (m *Account_RAMInfo) GetAvailable() int64 {
-	if m != nil {
-		return m.Available
-	}
-	return 0
-}
+var xxx_messageInfo_GetContractRequest proto.InternalMessageInfo
 
-func (m *Account_RAMInfo) GetUsed() int64 {
+func (m *GetContractRequest) GetId() string {
 	if m != nil {
-		return m.Used
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *Account_RAMInfo) GetTotal() int64 {
+func (m *GetContractRequest) GetByLongestChain() bool {
 	if m != nil {
-		return m.Total
+		return m.ByLongestChain
 	}
-	return 0
+	return false
 }
 
-// The message defines permission item.
-type Account_Item struct {
-	// permission name or key pair id
+// The message defines get contract storage request.
+type GetContractStorageRequest struct {
+	// contract id
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// whether it's a key pair
-	IsKeyPair bool `protobuf:"varint,2,opt,name=is_key_pair,json=isKeyPair,proto3" json:"is_key_pair,omitempty"`
-	// permission weight
-	Weight int64 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
-	// permission
-	Permission           string   `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+	// the key in the StateDB
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// get the value from StateDB, field is needed if StateDB[key] is a map.(we get StateDB[key][field] in this case)
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// get data by longest chain's head block or last irreversible block
+	ByLongestChain bool `protobuf:"varint,4,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at the given block if has_block_number is set, which overrides by_longest_chain. irreversible blocks need archive mode of the node
+	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// whether block_number is set
+	HasBlockNumber       bool     `protobuf:"varint,6,opt,name=has_block_number,json=hasBlockNumber,proto3" json:"has_block_number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account_Item) Reset()         { *m = Account_Item{} }
-func (m *Account_Item) String() string { return proto.CompactTextString(m) }
-func (*Account_Item) ProtoMessage()    {}
-func (*Account_Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{22, 3}
+func (m *GetContractStorageRequest) Reset()         { *m = GetContractStorageRequest{} }
+func (m *GetContractStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*GetContractStorageRequest) ProtoMessage()    {}
+func (*GetContractStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{44}
 }
 
-func (m *Account_Item) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_Item.Unmarshal(m, b)
-}
-func (m *Account_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_Item.Marshal(b, m, deterministic)
+func (m *GetContractStorageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractStorageRequest.Unmarshal(m, b)
 }
-func (m *Account_Item) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_Item.Merge(m, src)
+func (m *GetContractStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractStorageRequest.Marshal(b, m, deterministic)
 }
-func (m *Account_Item) XXX_Size() int {
-	return xxx_messageInfo_Account_Item.Size(m)
+func (m *GetContractStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractStorageRequest.Merge(m, src)
 }
-func (m *Account_Item) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_Item.DiscardUnknown(m)
+func (m *GetContractStorageRequest) XXX_Size() int {
+	return xxx_messageInfo_GetContractStorageRequest.Size(m)
+}
+func (m *GetContractStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractStorageRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account_Item proto.InternalMessageInfo
+var xxx_messageInfo_GetContractStorageRequest proto.InternalMessageInfo
 
-func (m *Account_Item) GetId() string {
+func (m *GetContractStorageRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *Account_Item) GetIsKeyPair() bool {
+func (m *GetContractStorageRequest) GetKey() string {
 	if m != nil {
-		return m.IsKeyPair
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetContractStorageRequest) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *GetContractStorageRequest) GetByLongestChain() bool {
+	if m != nil {
+		return m.ByLongestChain
 	}
 	return false
 }
 
-func (m *Account_Item) GetWeight() int64 {
+func (m *GetContractStorageRequest) GetBlockNumber() int64 {
 	if m != nil {
-		return m.Weight
+		return m.BlockNumber
 	}
 	return 0
 }
 
-func (m *Account_Item) GetPermission() string {
+func (m *GetContractStorageRequest) GetHasBlockNumber() bool {
 	if m != nil {
-		return m.Permission
+		return m.HasBlockNumber
 	}
-	return ""
+	return false
 }
 
-// The message defines a permission group.
-type Account_Group struct {
-	// group name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// permission items
-	Items                []*Account_Item `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+// The message defines get contract storage response.
+type GetContractStorageResponse struct {
+	// the json string data
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// block hash
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block number
+	BlockNumber          int64    `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account_Group) Reset()         { *m = Account_Group{} }
-func (m *Account_Group) String() string { return proto.CompactTextString(m) }
-func (*Account_Group) ProtoMessage()    {}
-func (*Account_Group) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{22, 4}
+func (m *GetContractStorageResponse) Reset()         { *m = GetContractStorageResponse{} }
+func (m *GetContractStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*GetContractStorageResponse) ProtoMessage()    {}
+func (*GetContractStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{45}
 }
 
-func (m *Account_Group) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_Group.Unmarshal(m, b)
+func (m *GetContractStorageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractStorageResponse.Unmarshal(m, b)
 }
-func (m *Account_Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_Group.Marshal(b, m, deterministic)
+func (m *GetContractStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractStorageResponse.Marshal(b, m, deterministic)
 }
-func (m *Account_Group) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_Group.Merge(m, src)
+func (m *GetContractStorageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractStorageResponse.Merge(m, src)
 }
-func (m *Account_Group) XXX_Size() int {
-	return xxx_messageInfo_Account_Group.Size(m)
+func (m *GetContractStorageResponse) XXX_Size() int {
+	return xxx_messageInfo_GetContractStorageResponse.Size(m)
 }
-func (m *Account_Group) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_Group.DiscardUnknown(m)
+func (m *GetContractStorageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractStorageResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account_Group proto.InternalMessageInfo
+var xxx_messageInfo_GetContractStorageResponse proto.InternalMessageInfo
 
-func (m *Account_Group) GetName() string {
+func (m *GetContractStorageResponse) GetData() string {
 	if m != nil {
-		return m.Name
+		return m.Data
 	}
 	return ""
 }
 
-func (m *Account_Group) GetItems() []*Account_Item {
+func (m *GetContractStorageResponse) GetBlockHash() string {
 	if m != nil {
-		return m.Items
+		return m.BlockHash
 	}
-	return nil
+	return ""
 }
 
-// The message defines the permission struct.
-type Account_Permission struct {
-	// permission name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// permission groups
-	GroupNames []string `protobuf:"bytes,2,rep,name=group_names,json=groupNames,proto3" json:"group_names,omitempty"`
-	// permission items
-	Items []*Account_Item `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
-	// permission threshold
-	Threshold            int64    `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+func (m *GetContractStorageResponse) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+// The message defines get batch contract storage request.
+type GetBatchContractStorageRequest struct {
+	// contract id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the batch query
+	KeyFields []*GetBatchContractStorageRequest_KeyField `protobuf:"bytes,2,rep,name=key_fields,json=keyFields,proto3" json:"key_fields,omitempty"`
+	// get data by longest chain's head block or last irreversible block
+	ByLongestChain       bool     `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account_Permission) Reset()         { *m = Account_Permission{} }
-func (m *Account_Permission) String() string { return proto.CompactTextString(m) }
-func (*Account_Permission) ProtoMessage()    {}
-func (*Account_Permission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{22, 5}
+func (m *GetBatchContractStorageRequest) Reset()         { *m = GetBatchContractStorageRequest{} }
+func (m *GetBatchContractStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBatchContractStorageRequest) ProtoMessage()    {}
+func (*GetBatchContractStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{46}
 }
 
-func (m *Account_Permission) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_Permission.Unmarshal(m, b)
+func (m *GetBatchContractStorageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBatchContractStorageRequest.Unmarshal(m, b)
 }
-func (m *Account_Permission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_Permission.Marshal(b, m, deterministic)
+func (m *GetBatchContractStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBatchContractStorageRequest.Marshal(b, m, deterministic)
 }
-func (m *Account_Permission) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_Permission.Merge(m, src)
+func (m *GetBatchContractStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBatchContractStorageRequest.Merge(m, src)
 }
-func (m *Account_Permission) XXX_Size() int {
-	return xxx_messageInfo_Account_Permission.Size(m)
+func (m *GetBatchContractStorageRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBatchContractStorageRequest.Size(m)
 }
-func (m *Account_Permission) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_Permission.DiscardUnknown(m)
+func (m *GetBatchContractStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBatchContractStorageRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account_Permission proto.InternalMessageInfo
+var xxx_messageInfo_GetBatchContractStorageRequest proto.InternalMessageInfo
 
-func (m *Account_Permission) GetName() string {
+func (m *GetBatchContractStorageRequest) GetId() string {
 	if m != nil {
-		return m.Name
+		return m.Id
 	}
 	return ""
 }
 
-func (m *Account_Permission) GetGroupNames() []string {
-	if m != nil {
-		return m.GroupNames
-	}
-	return nil
-}
-
-func (m *Account_Permission) GetItems() []*Account_Item {
+func (m *GetBatchContractStorageRequest) GetKeyFields() []*GetBatchContractStorageRequest_KeyField {
 	if m != nil {
-		return m.Items
+		return m.KeyFields
 	}
 	return nil
 }
 
-func (m *Account_Permission) GetThreshold() int64 {
+func (m *GetBatchContractStorageRequest) GetByLongestChain() bool {
 	if m != nil {
-		return m.Threshold
+		return m.ByLongestChain
 	}
-	return 0
+	return false
 }
 
-// The message defines the get account request.
-type GetAccountRequest struct {
-	// account name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// get account by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+// The message defines GetContractStorage request params.
+type GetBatchContractStorageRequest_KeyField struct {
+	// the key in StateDB
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// get the value from StateDB, fields is needed if StateDB[key] is a map.(we get StateDB[key][field] in this case)
+	Field                string   `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetAccountRequest) Reset()         { *m = GetAccountRequest{} }
-func (m *GetAccountRequest) String() string { return proto.CompactTextString(m) }
-func (*GetAccountRequest) ProtoMessage()    {}
-func (*GetAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{23}
+func (m *GetBatchContractStorageRequest_KeyField) Reset() {
+	*m = GetBatchContractStorageRequest_KeyField{}
+}
+func (m *GetBatchContractStorageRequest_KeyField) String() string { return proto.CompactTextString(m) }
+func (*GetBatchContractStorageRequest_KeyField) ProtoMessage()    {}
+func (*GetBatchContractStorageRequest_KeyField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{46, 0}
 }
 
-func (m *GetAccountRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetAccountRequest.Unmarshal(m, b)
+func (m *GetBatchContractStorageRequest_KeyField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBatchContractStorageRequest_KeyField.Unmarshal(m, b)
 }
-func (m *GetAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetAccountRequest.Marshal(b, m, deterministic)
+func (m *GetBatchContractStorageRequest_KeyField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBatchContractStorageRequest_KeyField.Marshal(b, m, deterministic)
 }
-func (m *GetAccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAccountRequest.Merge(m, src)
+func (m *GetBatchContractStorageRequest_KeyField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBatchContractStorageRequest_KeyField.Merge(m, src)
 }
-func (m *GetAccountRequest) XXX_Size() int {
-	return xxx_messageInfo_GetAccountRequest.Size(m)
+func (m *GetBatchContractStorageRequest_KeyField) XXX_Size() int {
+	return xxx_messageInfo_GetBatchContractStorageRequest_KeyField.Size(m)
 }
-func (m *GetAccountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAccountRequest.DiscardUnknown(m)
+func (m *GetBatchContractStorageRequest_KeyField) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBatchContractStorageRequest_KeyField.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetAccountRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetBatchContractStorageRequest_KeyField proto.InternalMessageInfo
 
-func (m *GetAccountRequest) GetName() string {
+func (m *GetBatchContractStorageRequest_KeyField) GetKey() string {
 	if m != nil {
-		return m.Name
+		return m.Key
 	}
 	return ""
 }
 
-func (m *GetAccountRequest) GetByLongestChain() bool {
+func (m *GetBatchContractStorageRequest_KeyField) GetField() string {
 	if m != nil {
-		return m.ByLongestChain
+		return m.Field
 	}
-	return false
+	return ""
 }
 
-// The message defines the contract struct.
-type Contract struct {
-	// contract id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// contract code
-	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	// contract language
-	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
-	// contract version
-	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
-	// contract abis
-	Abis                 []*Contract_ABI `protobuf:"bytes,5,rep,name=abis,proto3" json:"abis,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+// The message defines get batch contract storage response.
+type GetBatchContractStorageResponse struct {
+	// the repeated json string data
+	Datas []string `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+	// block hash
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block number
+	BlockNumber          int64    `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Contract) Reset()         { *m = Contract{} }
-func (m *Contract) String() string { return proto.CompactTextString(m) }
-func (*Contract) ProtoMessage()    {}
-func (*Contract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{24}
+func (m *GetBatchContractStorageResponse) Reset()         { *m = GetBatchContractStorageResponse{} }
+func (m *GetBatchContractStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBatchContractStorageResponse) ProtoMessage()    {}
+func (*GetBatchContractStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{47}
 }
 
-func (m *Contract) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Contract.Unmarshal(m, b)
-}
-func (m *Contract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Contract.Marshal(b, m, deterministic)
+func (m *GetBatchContractStorageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBatchContractStorageResponse.Unmarshal(m, b)
 }
-func (m *Contract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Contract.Merge(m, src)
+func (m *GetBatchContractStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBatchContractStorageResponse.Marshal(b, m, deterministic)
 }
-func (m *Contract) XXX_Size() int {
-	return xxx_messageInfo_Contract.Size(m)
+func (m *GetBatchContractStorageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBatchContractStorageResponse.Merge(m, src)
 }
-func (m *Contract) XXX_DiscardUnknown() {
-	xxx_messageInfo_Contract.DiscardUnknown(m)
+func (m *GetBatchContractStorageResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBatchContractStorageResponse.Size(m)
 }
-
-var xxx_messageInfo_Contract proto.InternalMessageInfo
-
-func (m *Contract) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
+func (m *GetBatchContractStorageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBatchContractStorageResponse.DiscardUnknown(m)
 }
 
-func (m *Contract) GetCode() string {
-	if m != nil {
-		return m.Code
-	}
-	return ""
-}
+var xxx_messageInfo_GetBatchContractStorageResponse proto.InternalMessageInfo
 
-func (m *Contract) GetLanguage() string {
+func (m *GetBatchContractStorageResponse) GetDatas() []string {
 	if m != nil {
-		return m.Language
+		return m.Datas
 	}
-	return ""
+	return nil
 }
 
-func (m *Contract) GetVersion() string {
+func (m *GetBatchContractStorageResponse) GetBlockHash() string {
 	if m != nil {
-		return m.Version
+		return m.BlockHash
 	}
 	return ""
 }
 
-func (m *Contract) GetAbis() []*Contract_ABI {
+func (m *GetBatchContractStorageResponse) GetBlockNumber() int64 {
 	if m != nil {
-		return m.Abis
+		return m.BlockNumber
 	}
-	return nil
+	return 0
 }
 
-// The message defines the ABI struct.
-type Contract_ABI struct {
-	// abi name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// abi arguments
-	Args []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
-	// abi amount limt
-	AmountLimit          []*AmountLimit `protobuf:"bytes,3,rep,name=amount_limit,json=amountLimit,proto3" json:"amount_limit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+// The message defines get contract storage request.
+type GetContractStorageFieldsRequest struct {
+	// contract id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// get the fields from StateDB
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// get data by longest chain's head block or last irreversible block
+	ByLongestChain       bool     `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Contract_ABI) Reset()         { *m = Contract_ABI{} }
-func (m *Contract_ABI) String() string { return proto.CompactTextString(m) }
-func (*Contract_ABI) ProtoMessage()    {}
-func (*Contract_ABI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{24, 0}
+func (m *GetContractStorageFieldsRequest) Reset()         { *m = GetContractStorageFieldsRequest{} }
+func (m *GetContractStorageFieldsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetContractStorageFieldsRequest) ProtoMessage()    {}
+func (*GetContractStorageFieldsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{48}
 }
 
-func (m *Contract_ABI) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Contract_ABI.Unmarshal(m, b)
+func (m *GetContractStorageFieldsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractStorageFieldsRequest.Unmarshal(m, b)
 }
-func (m *Contract_ABI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Contract_ABI.Marshal(b, m, deterministic)
+func (m *GetContractStorageFieldsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractStorageFieldsRequest.Marshal(b, m, deterministic)
 }
-func (m *Contract_ABI) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Contract_ABI.Merge(m, src)
+func (m *GetContractStorageFieldsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractStorageFieldsRequest.Merge(m, src)
 }
-func (m *Contract_ABI) XXX_Size() int {
-	return xxx_messageInfo_Contract_ABI.Size(m)
+func (m *GetContractStorageFieldsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetContractStorageFieldsRequest.Size(m)
 }
-func (m *Contract_ABI) XXX_DiscardUnknown() {
-	xxx_messageInfo_Contract_ABI.DiscardUnknown(m)
+func (m *GetContractStorageFieldsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractStorageFieldsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Contract_ABI proto.InternalMessageInfo
+var xxx_messageInfo_GetContractStorageFieldsRequest proto.InternalMessageInfo
 
-func (m *Contract_ABI) GetName() string {
+func (m *GetContractStorageFieldsRequest) GetId() string {
 	if m != nil {
-		return m.Name
+		return m.Id
 	}
 	return ""
 }
 
-func (m *Contract_ABI) GetArgs() []string {
+func (m *GetContractStorageFieldsRequest) GetKey() string {
 	if m != nil {
-		return m.Args
+		return m.Key
 	}
-	return nil
+	return ""
 }
 
-func (m *Contract_ABI) GetAmountLimit() []*AmountLimit {
+func (m *GetContractStorageFieldsRequest) GetByLongestChain() bool {
 	if m != nil {
-		return m.AmountLimit
+		return m.ByLongestChain
 	}
-	return nil
+	return false
 }
 
-// The message defines get contract request.
-type GetContractRequest struct {
-	// contract id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// get data by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+// The message defines get contract storage response.
+type GetContractStorageFieldsResponse struct {
+	// the fields.
+	Fields []string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	// block hash
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block number
+	BlockNumber          int64    `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetContractRequest) Reset()         { *m = GetContractRequest{} }
-func (m *GetContractRequest) String() string { return proto.CompactTextString(m) }
-func (*GetContractRequest) ProtoMessage()    {}
-func (*GetContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{25}
+func (m *GetContractStorageFieldsResponse) Reset()         { *m = GetContractStorageFieldsResponse{} }
+func (m *GetContractStorageFieldsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetContractStorageFieldsResponse) ProtoMessage()    {}
+func (*GetContractStorageFieldsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{49}
 }
 
-func (m *GetContractRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetContractRequest.Unmarshal(m, b)
+func (m *GetContractStorageFieldsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractStorageFieldsResponse.Unmarshal(m, b)
 }
-func (m *GetContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetContractRequest.Marshal(b, m, deterministic)
+func (m *GetContractStorageFieldsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractStorageFieldsResponse.Marshal(b, m, deterministic)
 }
-func (m *GetContractRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetContractRequest.Merge(m, src)
+func (m *GetContractStorageFieldsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractStorageFieldsResponse.Merge(m, src)
 }
-func (m *GetContractRequest) XXX_Size() int {
-	return xxx_messageInfo_GetContractRequest.Size(m)
+func (m *GetContractStorageFieldsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetContractStorageFieldsResponse.Size(m)
 }
-func (m *GetContractRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetContractRequest.DiscardUnknown(m)
+func (m *GetContractStorageFieldsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractStorageFieldsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetContractRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetContractStorageFieldsResponse proto.InternalMessageInfo
 
-func (m *GetContractRequest) GetId() string {
+func (m *GetContractStorageFieldsResponse) GetFields() []string {
 	if m != nil {
-		return m.Id
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *GetContractStorageFieldsResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
 	}
 	return ""
 }
 
-func (m *GetContractRequest) GetByLongestChain() bool {
+func (m *GetContractStorageFieldsResponse) GetBlockNumber() int64 {
 	if m != nil {
-		return m.ByLongestChain
+		return m.BlockNumber
 	}
-	return false
+	return 0
 }
 
-// The message defines get contract storage request.
-type GetContractStorageRequest struct {
+// The message defines get contract storage proof request.
+type GetContractStorageProofRequest struct {
 	// contract id
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// the key in the StateDB
 	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	// get the value from StateDB, field is needed if StateDB[key] is a map.(we get StateDB[key][field] in this case)
-	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
-	// get data by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,4,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// the field of StateDB[key] if it's a map
+	Field                string   `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetContractStorageRequest) Reset()         { *m = GetContractStorageRequest{} }
-func (m *GetContractStorageRequest) String() string { return proto.CompactTextString(m) }
-func (*GetContractStorageRequest) ProtoMessage()    {}
-func (*GetContractStorageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{26}
+func (m *GetContractStorageProofRequest) Reset()         { *m = GetContractStorageProofRequest{} }
+func (m *GetContractStorageProofRequest) String() string { return proto.CompactTextString(m) }
+func (*GetContractStorageProofRequest) ProtoMessage()    {}
+func (*GetContractStorageProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{50}
 }
 
-func (m *GetContractStorageRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetContractStorageRequest.Unmarshal(m, b)
+func (m *GetContractStorageProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractStorageProofRequest.Unmarshal(m, b)
 }
-func (m *GetContractStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetContractStorageRequest.Marshal(b, m, deterministic)
+func (m *GetContractStorageProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractStorageProofRequest.Marshal(b, m, deterministic)
 }
-func (m *GetContractStorageRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetContractStorageRequest.Merge(m, src)
+func (m *GetContractStorageProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractStorageProofRequest.Merge(m, src)
 }
-func (m *GetContractStorageRequest) XXX_Size() int {
-	return xxx_messageInfo_GetContractStorageRequest.Size(m)
+func (m *GetContractStorageProofRequest) XXX_Size() int {
+	return xxx_messageInfo_GetContractStorageProofRequest.Size(m)
 }
-func (m *GetContractStorageRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetContractStorageRequest.DiscardUnknown(m)
+func (m *GetContractStorageProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractStorageProofRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetContractStorageRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetContractStorageProofRequest proto.InternalMessageInfo
 
-func (m *GetContractStorageRequest) GetId() string {
+func (m *GetContractStorageProofRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *GetContractStorageRequest) GetKey() string {
+func (m *GetContractStorageProofRequest) GetKey() string {
 	if m != nil {
 		return m.Key
 	}
 	return ""
 }
 
-func (m *GetContractStorageRequest) GetField() string {
+func (m *GetContractStorageProofRequest) GetField() string {
 	if m != nil {
 		return m.Field
 	}
 	return ""
 }
 
-func (m *GetContractStorageRequest) GetByLongestChain() bool {
-	if m != nil {
-		return m.ByLongestChain
-	}
-	return false
-}
-
-// The message defines get contract storage response.
-type GetContractStorageResponse struct {
-	// the json string data
+// The message defines get contract storage proof response.
+type GetContractStorageProofResponse struct {
+	// the json string data, empty if the key doesn't exist
 	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// block hash
-	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	// block number
-	BlockNumber          int64    `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// the raw value in the StateDB, which is the value of the leaf in proof
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// whether the key exists
+	Exist bool `protobuf:"varint,3,opt,name=exist,proto3" json:"exist,omitempty"`
+	// the key of state trie, which is "state/" followed by the key in the StateDB
+	TrieKey string `protobuf:"bytes,4,opt,name=trie_key,json=trieKey,proto3" json:"trie_key,omitempty"`
+	// the encoded trie nodes from the root to the key in base58
+	Proof []string `protobuf:"bytes,5,rep,name=proof,proto3" json:"proof,omitempty"`
+	// the merkle root of state
+	StateRoot string `protobuf:"bytes,6,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// the block hash of the state root
+	BlockHash string `protobuf:"bytes,7,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// the block number of the state root
+	BlockNumber          int64    `protobuf:"varint,8,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetContractStorageResponse) Reset()         { *m = GetContractStorageResponse{} }
-func (m *GetContractStorageResponse) String() string { return proto.CompactTextString(m) }
-func (*GetContractStorageResponse) ProtoMessage()    {}
-func (*GetContractStorageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{27}
+func (m *GetContractStorageProofResponse) Reset()         { *m = GetContractStorageProofResponse{} }
+func (m *GetContractStorageProofResponse) String() string { return proto.CompactTextString(m) }
+func (*GetContractStorageProofResponse) ProtoMessage()    {}
+func (*GetContractStorageProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{51}
 }
 
-func (m *GetContractStorageResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetContractStorageResponse.Unmarshal(m, b)
+func (m *GetContractStorageProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractStorageProofResponse.Unmarshal(m, b)
 }
-func (m *GetContractStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetContractStorageResponse.Marshal(b, m, deterministic)
+func (m *GetContractStorageProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractStorageProofResponse.Marshal(b, m, deterministic)
 }
-func (m *GetContractStorageResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetContractStorageResponse.Merge(m, src)
+func (m *GetContractStorageProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractStorageProofResponse.Merge(m, src)
 }
-func (m *GetContractStorageResponse) XXX_Size() int {
-	return xxx_messageInfo_GetContractStorageResponse.Size(m)
+func (m *GetContractStorageProofResponse) XXX_Size() int {
+	return xxx_messageInfo_GetContractStorageProofResponse.Size(m)
+}
+func (m *GetContractStorageProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractStorageProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetContractStorageProofResponse proto.InternalMessageInfo
+
+func (m *GetContractStorageProofResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *GetContractStorageProofResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *GetContractStorageProofResponse) GetExist() bool {
+	if m != nil {
+		return m.Exist
+	}
+	return false
 }
-func (m *GetContractStorageResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetContractStorageResponse.DiscardUnknown(m)
+
+func (m *GetContractStorageProofResponse) GetTrieKey() string {
+	if m != nil {
+		return m.TrieKey
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetContractStorageResponse proto.InternalMessageInfo
+func (m *GetContractStorageProofResponse) GetProof() []string {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
 
-func (m *GetContractStorageResponse) GetData() string {
+func (m *GetContractStorageProofResponse) GetStateRoot() string {
 	if m != nil {
-		return m.Data
+		return m.StateRoot
 	}
 	return ""
 }
 
-func (m *GetContractStorageResponse) GetBlockHash() string {
+func (m *GetContractStorageProofResponse) GetBlockHash() string {
 	if m != nil {
 		return m.BlockHash
 	}
 	return ""
 }
 
-func (m *GetContractStorageResponse) GetBlockNumber() int64 {
+func (m *GetContractStorageProofResponse) GetBlockNumber() int64 {
 	if m != nil {
 		return m.BlockNumber
 	}
 	return 0
 }
 
-// The message defines get batch contract storage request.
-type GetBatchContractStorageRequest struct {
-	// contract id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// the batch query
-	KeyFields []*GetBatchContractStorageRequest_KeyField `protobuf:"bytes,2,rep,name=key_fields,json=keyFields,proto3" json:"key_fields,omitempty"`
-	// get data by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+// The message defines get transactions by account request.
+type GetTxsByAccountRequest struct {
+	// account name
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// the role of account
+	Role GetTxsByAccountRequest_Role `protobuf:"varint,2,opt,name=role,proto3,enum=rpcpb.GetTxsByAccountRequest_Role" json:"role,omitempty"`
+	// the cursor returned by the previous page, empty for the first page
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// the max count of transactions, at most 100
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// from the latest transactions to the oldest
+	Reverse              bool     `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetBatchContractStorageRequest) Reset()         { *m = GetBatchContractStorageRequest{} }
-func (m *GetBatchContractStorageRequest) String() string { return proto.CompactTextString(m) }
-func (*GetBatchContractStorageRequest) ProtoMessage()    {}
-func (*GetBatchContractStorageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{28}
+func (m *GetTxsByAccountRequest) Reset()         { *m = GetTxsByAccountRequest{} }
+func (m *GetTxsByAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTxsByAccountRequest) ProtoMessage()    {}
+func (*GetTxsByAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{52}
 }
 
-func (m *GetBatchContractStorageRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetBatchContractStorageRequest.Unmarshal(m, b)
+func (m *GetTxsByAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTxsByAccountRequest.Unmarshal(m, b)
 }
-func (m *GetBatchContractStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetBatchContractStorageRequest.Marshal(b, m, deterministic)
+func (m *GetTxsByAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTxsByAccountRequest.Marshal(b, m, deterministic)
 }
-func (m *GetBatchContractStorageRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBatchContractStorageRequest.Merge(m, src)
+func (m *GetTxsByAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxsByAccountRequest.Merge(m, src)
 }
-func (m *GetBatchContractStorageRequest) XXX_Size() int {
-	return xxx_messageInfo_GetBatchContractStorageRequest.Size(m)
+func (m *GetTxsByAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTxsByAccountRequest.Size(m)
 }
-func (m *GetBatchContractStorageRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBatchContractStorageRequest.DiscardUnknown(m)
+func (m *GetTxsByAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxsByAccountRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBatchContractStorageRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetTxsByAccountRequest proto.InternalMessageInfo
 
-func (m *GetBatchContractStorageRequest) GetId() string {
+func (m *GetTxsByAccountRequest) GetAccount() string {
 	if m != nil {
-		return m.Id
+		return m.Account
 	}
 	return ""
 }
 
-func (m *GetBatchContractStorageRequest) GetKeyFields() []*GetBatchContractStorageRequest_KeyField {
+func (m *GetTxsByAccountRequest) GetRole() GetTxsByAccountRequest_Role {
 	if m != nil {
-		return m.KeyFields
+		return m.Role
 	}
-	return nil
+	return GetTxsByAccountRequest_ANY
 }
 
-func (m *GetBatchContractStorageRequest) GetByLongestChain() bool {
+func (m *GetTxsByAccountRequest) GetCursor() string {
 	if m != nil {
-		return m.ByLongestChain
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *GetTxsByAccountRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetTxsByAccountRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
 	}
 	return false
 }
 
-// The message defines GetContractStorage request params.
-type GetBatchContractStorageRequest_KeyField struct {
-	// the key in StateDB
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// get the value from StateDB, fields is needed if StateDB[key] is a map.(we get StateDB[key][field] in this case)
-	Field                string   `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+// The message defines get transactions by contract request.
+type GetTxsByContractRequest struct {
+	// contract id
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// action name, empty for all the actions of contract
+	ActionName string `protobuf:"bytes,2,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
+	// the cursor returned by the previous page, empty for the first page
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// the max count of transactions, at most 100
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// from the latest transactions to the oldest
+	Reverse              bool     `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetBatchContractStorageRequest_KeyField) Reset() {
-	*m = GetBatchContractStorageRequest_KeyField{}
-}
-func (m *GetBatchContractStorageRequest_KeyField) String() string { return proto.CompactTextString(m) }
-func (*GetBatchContractStorageRequest_KeyField) ProtoMessage()    {}
-func (*GetBatchContractStorageRequest_KeyField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{28, 0}
+func (m *GetTxsByContractRequest) Reset()         { *m = GetTxsByContractRequest{} }
+func (m *GetTxsByContractRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTxsByContractRequest) ProtoMessage()    {}
+func (*GetTxsByContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{53}
 }
 
-func (m *GetBatchContractStorageRequest_KeyField) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetBatchContractStorageRequest_KeyField.Unmarshal(m, b)
+func (m *GetTxsByContractRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTxsByContractRequest.Unmarshal(m, b)
 }
-func (m *GetBatchContractStorageRequest_KeyField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetBatchContractStorageRequest_KeyField.Marshal(b, m, deterministic)
+func (m *GetTxsByContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTxsByContractRequest.Marshal(b, m, deterministic)
 }
-func (m *GetBatchContractStorageRequest_KeyField) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBatchContractStorageRequest_KeyField.Merge(m, src)
+func (m *GetTxsByContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxsByContractRequest.Merge(m, src)
 }
-func (m *GetBatchContractStorageRequest_KeyField) XXX_Size() int {
-	return xxx_messageInfo_GetBatchContractStorageRequest_KeyField.Size(m)
+func (m *GetTxsByContractRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTxsByContractRequest.Size(m)
 }
-func (m *GetBatchContractStorageRequest_KeyField) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBatchContractStorageRequest_KeyField.DiscardUnknown(m)
+func (m *GetTxsByContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxsByContractRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBatchContractStorageRequest_KeyField proto.InternalMessageInfo
+var xxx_messageInfo_GetTxsByContractRequest proto.InternalMessageInfo
 
-func (m *GetBatchContractStorageRequest_KeyField) GetKey() string {
+func (m *GetTxsByContractRequest) GetContract() string {
 	if m != nil {
-		return m.Key
+		return m.Contract
 	}
 	return ""
 }
 
-func (m *GetBatchContractStorageRequest_KeyField) GetField() string {
+func (m *GetTxsByContractRequest) GetActionName() string {
 	if m != nil {
-		return m.Field
+		return m.ActionName
 	}
 	return ""
 }
 
-// The message defines get batch contract storage response.
-type GetBatchContractStorageResponse struct {
-	// the repeated json string data
-	Datas []string `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
-	// block hash
-	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	// block number
-	BlockNumber          int64    `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+func (m *GetTxsByContractRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *GetTxsByContractRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetTxsByContractRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+// The message defines get transactions response.
+type GetTxsResponse struct {
+	// the irreversible transactions with their receipts
+	Transactions []*TransactionResponse `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// the cursor of the next page, empty if there are no more transactions
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// the number of the first block whose transactions are indexed
+	IndexFrom            int64    `protobuf:"varint,3,opt,name=index_from,json=indexFrom,proto3" json:"index_from,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetBatchContractStorageResponse) Reset()         { *m = GetBatchContractStorageResponse{} }
-func (m *GetBatchContractStorageResponse) String() string { return proto.CompactTextString(m) }
-func (*GetBatchContractStorageResponse) ProtoMessage()    {}
-func (*GetBatchContractStorageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{29}
+func (m *GetTxsResponse) Reset()         { *m = GetTxsResponse{} }
+func (m *GetTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTxsResponse) ProtoMessage()    {}
+func (*GetTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{54}
 }
 
-func (m *GetBatchContractStorageResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetBatchContractStorageResponse.Unmarshal(m, b)
+func (m *GetTxsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTxsResponse.Unmarshal(m, b)
 }
-func (m *GetBatchContractStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetBatchContractStorageResponse.Marshal(b, m, deterministic)
+func (m *GetTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTxsResponse.Marshal(b, m, deterministic)
 }
-func (m *GetBatchContractStorageResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBatchContractStorageResponse.Merge(m, src)
+func (m *GetTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxsResponse.Merge(m, src)
 }
-func (m *GetBatchContractStorageResponse) XXX_Size() int {
-	return xxx_messageInfo_GetBatchContractStorageResponse.Size(m)
+func (m *GetTxsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTxsResponse.Size(m)
 }
-func (m *GetBatchContractStorageResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBatchContractStorageResponse.DiscardUnknown(m)
+func (m *GetTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBatchContractStorageResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetTxsResponse proto.InternalMessageInfo
 
-func (m *GetBatchContractStorageResponse) GetDatas() []string {
+func (m *GetTxsResponse) GetTransactions() []*TransactionResponse {
 	if m != nil {
-		return m.Datas
+		return m.Transactions
 	}
 	return nil
 }
 
-func (m *GetBatchContractStorageResponse) GetBlockHash() string {
+func (m *GetTxsResponse) GetCursor() string {
 	if m != nil {
-		return m.BlockHash
+		return m.Cursor
 	}
 	return ""
 }
 
-func (m *GetBatchContractStorageResponse) GetBlockNumber() int64 {
+func (m *GetTxsResponse) GetIndexFrom() int64 {
 	if m != nil {
-		return m.BlockNumber
+		return m.IndexFrom
 	}
 	return 0
 }
 
-// The message defines get contract storage request.
-type GetContractStorageFieldsRequest struct {
-	// contract id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// get the fields from StateDB
-	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	// get data by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// The message defines send transaction response.
+type SendTransactionResponse struct {
+	// the final transaction hash
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// the tx_receipt of execution in advance
+	PreTxReceipt         *TxReceipt `protobuf:"bytes,2,opt,name=pre_tx_receipt,json=preTxReceipt,proto3" json:"pre_tx_receipt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *GetContractStorageFieldsRequest) Reset()         { *m = GetContractStorageFieldsRequest{} }
-func (m *GetContractStorageFieldsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetContractStorageFieldsRequest) ProtoMessage()    {}
-func (*GetContractStorageFieldsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{30}
+func (m *SendTransactionResponse) Reset()         { *m = SendTransactionResponse{} }
+func (m *SendTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*SendTransactionResponse) ProtoMessage()    {}
+func (*SendTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{55}
 }
 
-func (m *GetContractStorageFieldsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetContractStorageFieldsRequest.Unmarshal(m, b)
+func (m *SendTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendTransactionResponse.Unmarshal(m, b)
 }
-func (m *GetContractStorageFieldsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetContractStorageFieldsRequest.Marshal(b, m, deterministic)
+func (m *SendTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendTransactionResponse.Marshal(b, m, deterministic)
 }
-func (m *GetContractStorageFieldsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetContractStorageFieldsRequest.Merge(m, src)
+func (m *SendTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendTransactionResponse.Merge(m, src)
 }
-func (m *GetContractStorageFieldsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetContractStorageFieldsRequest.Size(m)
+func (m *SendTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_SendTransactionResponse.Size(m)
 }
-func (m *GetContractStorageFieldsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetContractStorageFieldsRequest.DiscardUnknown(m)
+func (m *SendTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendTransactionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetContractStorageFieldsRequest proto.InternalMessageInfo
-
-func (m *GetContractStorageFieldsRequest) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
-}
+var xxx_messageInfo_SendTransactionResponse proto.InternalMessageInfo
 
-func (m *GetContractStorageFieldsRequest) GetKey() string {
+func (m *SendTransactionResponse) GetHash() string {
 	if m != nil {
-		return m.Key
+		return m.Hash
 	}
 	return ""
 }
 
-func (m *GetContractStorageFieldsRequest) GetByLongestChain() bool {
+func (m *SendTransactionResponse) GetPreTxReceipt() *TxReceipt {
 	if m != nil {
-		return m.ByLongestChain
+		return m.PreTxReceipt
 	}
-	return false
+	return nil
 }
 
-// The message defines get contract storage response.
-type GetContractStorageFieldsResponse struct {
-	// the fields.
-	Fields []string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
-	// block hash
-	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	// block number
-	BlockNumber          int64    `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+// The message defines the estimate transaction response.
+type EstimateTransactionResponse struct {
+	// the tx_receipt of execution on the head block, with the gas limit of all the gas of publisher
+	TxReceipt *TxReceipt `protobuf:"bytes,1,opt,name=tx_receipt,json=txReceipt,proto3" json:"tx_receipt,omitempty"`
+	// gas used by the execution
+	GasUsage float64 `protobuf:"fixed64,2,opt,name=gas_usage,json=gasUsage,proto3" json:"gas_usage,omitempty"`
+	// suggested gas limit, the gas usage with a margin for the state changes before the tx is packed
+	GasLimit float64 `protobuf:"fixed64,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// suggested gas ratio, the median gas ratio of the recent blocks
+	GasRatio float64 `protobuf:"fixed64,4,opt,name=gas_ratio,json=gasRatio,proto3" json:"gas_ratio,omitempty"`
+	// ram usage of each payer, negative if the ram is released
+	RamUsage map[string]int64 `protobuf:"bytes,5,rep,name=ram_usage,json=ramUsage,proto3" json:"ram_usage,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// gas of publisher at the head block
+	PublisherGas float64 `protobuf:"fixed64,6,opt,name=publisher_gas,json=publisherGas,proto3" json:"publisher_gas,omitempty"`
+	// available ram of publisher at the head block
+	PublisherRam int64 `protobuf:"varint,7,opt,name=publisher_ram,json=publisherRam,proto3" json:"publisher_ram,omitempty"`
+	// whether publisher has enough gas for the suggested gas limit
+	GasEnough bool `protobuf:"varint,8,opt,name=gas_enough,json=gasEnough,proto3" json:"gas_enough,omitempty"`
+	// whether publisher has enough ram for its ram usage
+	RamEnough            bool     `protobuf:"varint,9,opt,name=ram_enough,json=ramEnough,proto3" json:"ram_enough,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetContractStorageFieldsResponse) Reset()         { *m = GetContractStorageFieldsResponse{} }
-func (m *GetContractStorageFieldsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetContractStorageFieldsResponse) ProtoMessage()    {}
-func (*GetContractStorageFieldsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{31}
+func (m *EstimateTransactionResponse) Reset()         { *m = EstimateTransactionResponse{} }
+func (m *EstimateTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateTransactionResponse) ProtoMessage()    {}
+func (*EstimateTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{56}
 }
 
-func (m *GetContractStorageFieldsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetContractStorageFieldsResponse.Unmarshal(m, b)
+func (m *EstimateTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateTransactionResponse.Unmarshal(m, b)
 }
-func (m *GetContractStorageFieldsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetContractStorageFieldsResponse.Marshal(b, m, deterministic)
+func (m *EstimateTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateTransactionResponse.Marshal(b, m, deterministic)
 }
-func (m *GetContractStorageFieldsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetContractStorageFieldsResponse.Merge(m, src)
+func (m *EstimateTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateTransactionResponse.Merge(m, src)
 }
-func (m *GetContractStorageFieldsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetContractStorageFieldsResponse.Size(m)
+func (m *EstimateTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_EstimateTransactionResponse.Size(m)
 }
-func (m *GetContractStorageFieldsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetContractStorageFieldsResponse.DiscardUnknown(m)
+func (m *EstimateTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateTransactionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetContractStorageFieldsResponse proto.InternalMessageInfo
+var xxx_messageInfo_EstimateTransactionResponse proto.InternalMessageInfo
+
+func (m *EstimateTransactionResponse) GetTxReceipt() *TxReceipt {
+	if m != nil {
+		return m.TxReceipt
+	}
+	return nil
+}
+
+func (m *EstimateTransactionResponse) GetGasUsage() float64 {
+	if m != nil {
+		return m.GasUsage
+	}
+	return 0
+}
+
+func (m *EstimateTransactionResponse) GetGasLimit() float64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *EstimateTransactionResponse) GetGasRatio() float64 {
+	if m != nil {
+		return m.GasRatio
+	}
+	return 0
+}
 
-func (m *GetContractStorageFieldsResponse) GetFields() []string {
+func (m *EstimateTransactionResponse) GetRamUsage() map[string]int64 {
 	if m != nil {
-		return m.Fields
+		return m.RamUsage
 	}
 	return nil
 }
 
-func (m *GetContractStorageFieldsResponse) GetBlockHash() string {
+func (m *EstimateTransactionResponse) GetPublisherGas() float64 {
 	if m != nil {
-		return m.BlockHash
+		return m.PublisherGas
 	}
-	return ""
+	return 0
 }
 
-func (m *GetContractStorageFieldsResponse) GetBlockNumber() int64 {
+func (m *EstimateTransactionResponse) GetPublisherRam() int64 {
 	if m != nil {
-		return m.BlockNumber
+		return m.PublisherRam
 	}
 	return 0
 }
 
-// The message defines send transaction response.
-type SendTransactionResponse struct {
-	// the final transaction hash
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	// the tx_receipt of execution in advance
-	PreTxReceipt         *TxReceipt `protobuf:"bytes,2,opt,name=pre_tx_receipt,json=preTxReceipt,proto3" json:"pre_tx_receipt,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
-}
-
-func (m *SendTransactionResponse) Reset()         { *m = SendTransactionResponse{} }
-func (m *SendTransactionResponse) String() string { return proto.CompactTextString(m) }
-func (*SendTransactionResponse) ProtoMessage()    {}
-func (*SendTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{32}
-}
-
-func (m *SendTransactionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SendTransactionResponse.Unmarshal(m, b)
-}
-func (m *SendTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SendTransactionResponse.Marshal(b, m, deterministic)
-}
-func (m *SendTransactionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SendTransactionResponse.Merge(m, src)
-}
-func (m *SendTransactionResponse) XXX_Size() int {
-	return xxx_messageInfo_SendTransactionResponse.Size(m)
-}
-func (m *SendTransactionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SendTransactionResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_SendTransactionResponse proto.InternalMessageInfo
-
-func (m *SendTransactionResponse) GetHash() string {
+func (m *EstimateTransactionResponse) GetGasEnough() bool {
 	if m != nil {
-		return m.Hash
+		return m.GasEnough
 	}
-	return ""
+	return false
 }
 
-func (m *SendTransactionResponse) GetPreTxReceipt() *TxReceipt {
+func (m *EstimateTransactionResponse) GetRamEnough() bool {
 	if m != nil {
-		return m.PreTxReceipt
+		return m.RamEnough
 	}
-	return nil
+	return false
 }
 
 // The message defines get token balance response.
@@ -3142,7 +5148,7 @@ func (m *GetTokenBalanceResponse) Reset()         { *m = GetTokenBalanceResponse
 func (m *GetTokenBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTokenBalanceResponse) ProtoMessage()    {}
 func (*GetTokenBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{33}
+	return fileDescriptor_1b773bf3e696f610, []int{57}
 }
 
 func (m *GetTokenBalanceResponse) XXX_Unmarshal(b []byte) error {
@@ -3184,7 +5190,11 @@ type GetTokenBalanceRequest struct {
 	// the token name
 	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	// get data by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	ByLongestChain bool `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at the given block if has_block_number is set, which overrides by_longest_chain. irreversible blocks need archive mode of the node
+	BlockNumber int64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// whether block_number is set
+	HasBlockNumber       bool     `protobuf:"varint,5,opt,name=has_block_number,json=hasBlockNumber,proto3" json:"has_block_number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3194,7 +5204,7 @@ func (m *GetTokenBalanceRequest) Reset()         { *m = GetTokenBalanceRequest{}
 func (m *GetTokenBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTokenBalanceRequest) ProtoMessage()    {}
 func (*GetTokenBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{34}
+	return fileDescriptor_1b773bf3e696f610, []int{58}
 }
 
 func (m *GetTokenBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -3236,6 +5246,20 @@ func (m *GetTokenBalanceRequest) GetByLongestChain() bool {
 	return false
 }
 
+func (m *GetTokenBalanceRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *GetTokenBalanceRequest) GetHasBlockNumber() bool {
+	if m != nil {
+		return m.HasBlockNumber
+	}
+	return false
+}
+
 // The message defines get token721 balance response.
 type GetToken721BalanceResponse struct {
 	// token balance
@@ -3251,7 +5275,7 @@ func (m *GetToken721BalanceResponse) Reset()         { *m = GetToken721BalanceRe
 func (m *GetToken721BalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*GetToken721BalanceResponse) ProtoMessage()    {}
 func (*GetToken721BalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{35}
+	return fileDescriptor_1b773bf3e696f610, []int{59}
 }
 
 func (m *GetToken721BalanceResponse) XXX_Unmarshal(b []byte) error {
@@ -3303,7 +5327,7 @@ func (m *GetToken721InfoRequest) Reset()         { *m = GetToken721InfoRequest{}
 func (m *GetToken721InfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetToken721InfoRequest) ProtoMessage()    {}
 func (*GetToken721InfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{36}
+	return fileDescriptor_1b773bf3e696f610, []int{60}
 }
 
 func (m *GetToken721InfoRequest) XXX_Unmarshal(b []byte) error {
@@ -3358,7 +5382,7 @@ func (m *GetToken721MetadataResponse) Reset()         { *m = GetToken721Metadata
 func (m *GetToken721MetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*GetToken721MetadataResponse) ProtoMessage()    {}
 func (*GetToken721MetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{37}
+	return fileDescriptor_1b773bf3e696f610, []int{61}
 }
 
 func (m *GetToken721MetadataResponse) XXX_Unmarshal(b []byte) error {
@@ -3399,7 +5423,7 @@ func (m *GetToken721OwnerResponse) Reset()         { *m = GetToken721OwnerRespon
 func (m *GetToken721OwnerResponse) String() string { return proto.CompactTextString(m) }
 func (*GetToken721OwnerResponse) ProtoMessage()    {}
 func (*GetToken721OwnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{38}
+	return fileDescriptor_1b773bf3e696f610, []int{62}
 }
 
 func (m *GetToken721OwnerResponse) XXX_Unmarshal(b []byte) error {
@@ -3434,17 +5458,19 @@ type Event struct {
 	// event data
 	Data string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	// event time
-	Time                 int64    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	// position of the event in the irreversible blocks, only for the NEW_LIB_BLOCK and CONTRACT_RECEIPT events
+	Cursor               *EventCursor `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{39}
+	return fileDescriptor_1b773bf3e696f610, []int{63}
 }
 
 func (m *Event) XXX_Unmarshal(b []byte) error {
@@ -3486,20 +5512,89 @@ func (m *Event) GetTime() int64 {
 	return 0
 }
 
+func (m *Event) GetCursor() *EventCursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+// The message defines the position of event in the irreversible blocks.
+type EventCursor struct {
+	// block number
+	BlockNumber int64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// index of tx in the block, -1 for the block event
+	TxIndex int32 `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// index of receipt in the tx, -1 for the block event
+	ReceiptIndex         int32    `protobuf:"varint,3,opt,name=receipt_index,json=receiptIndex,proto3" json:"receipt_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventCursor) Reset()         { *m = EventCursor{} }
+func (m *EventCursor) String() string { return proto.CompactTextString(m) }
+func (*EventCursor) ProtoMessage()    {}
+func (*EventCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{64}
+}
+
+func (m *EventCursor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventCursor.Unmarshal(m, b)
+}
+func (m *EventCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventCursor.Marshal(b, m, deterministic)
+}
+func (m *EventCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCursor.Merge(m, src)
+}
+func (m *EventCursor) XXX_Size() int {
+	return xxx_messageInfo_EventCursor.Size(m)
+}
+func (m *EventCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCursor proto.InternalMessageInfo
+
+func (m *EventCursor) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *EventCursor) GetTxIndex() int32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *EventCursor) GetReceiptIndex() int32 {
+	if m != nil {
+		return m.ReceiptIndex
+	}
+	return 0
+}
+
 // The message defines subscribe request.
 type SubscribeRequest struct {
-	Topics               []Event_Topic            `protobuf:"varint,1,rep,packed,name=topics,proto3,enum=rpcpb.Event_Topic" json:"topics,omitempty"`
-	Filter               *SubscribeRequest_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	Topics []Event_Topic            `protobuf:"varint,1,rep,packed,name=topics,proto3,enum=rpcpb.Event_Topic" json:"topics,omitempty"`
+	Filter *SubscribeRequest_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// replay the NEW_LIB_BLOCK and CONTRACT_RECEIPT events after the cursor from the irreversible blocks,
+	// then follow the new irreversible blocks without dropping any event
+	FromCursor           *EventCursor `protobuf:"bytes,3,opt,name=from_cursor,json=fromCursor,proto3" json:"from_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
 func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeRequest) ProtoMessage()    {}
 func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{40}
+	return fileDescriptor_1b773bf3e696f610, []int{65}
 }
 
 func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
@@ -3534,9 +5629,20 @@ func (m *SubscribeRequest) GetFilter() *SubscribeRequest_Filter {
 	return nil
 }
 
+func (m *SubscribeRequest) GetFromCursor() *EventCursor {
+	if m != nil {
+		return m.FromCursor
+	}
+	return nil
+}
+
 type SubscribeRequest_Filter struct {
 	// contract id
-	ContractId           string   `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// account of the tx publisher or signers
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// action name
+	ActionName           string   `protobuf:"bytes,3,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3546,7 +5652,7 @@ func (m *SubscribeRequest_Filter) Reset()         { *m = SubscribeRequest_Filter
 func (m *SubscribeRequest_Filter) String() string { return proto.CompactTextString(m) }
 func (*SubscribeRequest_Filter) ProtoMessage()    {}
 func (*SubscribeRequest_Filter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{40, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{65, 0}
 }
 
 func (m *SubscribeRequest_Filter) XXX_Unmarshal(b []byte) error {
@@ -3574,6 +5680,20 @@ func (m *SubscribeRequest_Filter) GetContractId() string {
 	return ""
 }
 
+func (m *SubscribeRequest_Filter) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *SubscribeRequest_Filter) GetActionName() string {
+	if m != nil {
+		return m.ActionName
+	}
+	return ""
+}
+
 // The message defines subscribe response.
 type SubscribeResponse struct {
 	Event                *Event   `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
@@ -3586,7 +5706,7 @@ func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
 func (m *SubscribeResponse) String() string { return proto.CompactTextString(m) }
 func (*SubscribeResponse) ProtoMessage()    {}
 func (*SubscribeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{41}
+	return fileDescriptor_1b773bf3e696f610, []int{66}
 }
 
 func (m *SubscribeResponse) XXX_Unmarshal(b []byte) error {
@@ -3629,7 +5749,7 @@ func (m *VoterBonus) Reset()         { *m = VoterBonus{} }
 func (m *VoterBonus) String() string { return proto.CompactTextString(m) }
 func (*VoterBonus) ProtoMessage()    {}
 func (*VoterBonus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{42}
+	return fileDescriptor_1b773bf3e696f610, []int{67}
 }
 
 func (m *VoterBonus) XXX_Unmarshal(b []byte) error {
@@ -3677,7 +5797,7 @@ func (m *CandidateBonus) Reset()         { *m = CandidateBonus{} }
 func (m *CandidateBonus) String() string { return proto.CompactTextString(m) }
 func (*CandidateBonus) ProtoMessage()    {}
 func (*CandidateBonus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{43}
+	return fileDescriptor_1b773bf3e696f610, []int{68}
 }
 
 func (m *CandidateBonus) XXX_Unmarshal(b []byte) error {
@@ -3720,7 +5840,7 @@ func (m *GetTokenInfoRequest) Reset()         { *m = GetTokenInfoRequest{} }
 func (m *GetTokenInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTokenInfoRequest) ProtoMessage()    {}
 func (*GetTokenInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{44}
+	return fileDescriptor_1b773bf3e696f610, []int{69}
 }
 
 func (m *GetTokenInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -3780,7 +5900,7 @@ func (m *TokenInfo) Reset()         { *m = TokenInfo{} }
 func (m *TokenInfo) String() string { return proto.CompactTextString(m) }
 func (*TokenInfo) ProtoMessage()    {}
 func (*TokenInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{45}
+	return fileDescriptor_1b773bf3e696f610, []int{70}
 }
 
 func (m *TokenInfo) XXX_Unmarshal(b []byte) error {
@@ -3853,8 +5973,11 @@ func (m *TokenInfo) GetCanTransfer() bool {
 func init() {
 	proto.RegisterEnum("rpcpb.TxReceipt_StatusCode", TxReceipt_StatusCode_name, TxReceipt_StatusCode_value)
 	proto.RegisterEnum("rpcpb.TransactionResponse_Status", TransactionResponse_Status_name, TransactionResponse_Status_value)
+	proto.RegisterEnum("rpcpb.TxEvent_Type", TxEvent_Type_name, TxEvent_Type_value)
+	proto.RegisterEnum("rpcpb.TxStatusResponse_Status", TxStatusResponse_Status_name, TxStatusResponse_Status_value)
 	proto.RegisterEnum("rpcpb.Signature_Algorithm", Signature_Algorithm_name, Signature_Algorithm_value)
 	proto.RegisterEnum("rpcpb.BlockResponse_Status", BlockResponse_Status_name, BlockResponse_Status_value)
+	proto.RegisterEnum("rpcpb.GetTxsByAccountRequest_Role", GetTxsByAccountRequest_Role_name, GetTxsByAccountRequest_Role_value)
 	proto.RegisterEnum("rpcpb.Event_Topic", Event_Topic_name, Event_Topic_value)
 	proto.RegisterType((*EmptyRequest)(nil), "rpcpb.EmptyRequest")
 	proto.RegisterType((*NetworkInfo)(nil), "rpcpb.NetworkInfo")
@@ -3867,6 +5990,13 @@ func init() {
 	proto.RegisterType((*TxReceipt_Receipt)(nil), "rpcpb.TxReceipt.Receipt")
 	proto.RegisterType((*Transaction)(nil), "rpcpb.Transaction")
 	proto.RegisterType((*TransactionResponse)(nil), "rpcpb.TransactionResponse")
+	proto.RegisterType((*TxEvent)(nil), "rpcpb.TxEvent")
+	proto.RegisterType((*TxStatusResponse)(nil), "rpcpb.TxStatusResponse")
+	proto.RegisterType((*GetPendingTxsRequest)(nil), "rpcpb.GetPendingTxsRequest")
+	proto.RegisterType((*GetPendingTxsResponse)(nil), "rpcpb.GetPendingTxsResponse")
+	proto.RegisterType((*TxPoolStatsResponse)(nil), "rpcpb.TxPoolStatsResponse")
+	proto.RegisterType((*TxPoolStatsResponse_GasRatioBucket)(nil), "rpcpb.TxPoolStatsResponse.GasRatioBucket")
+	proto.RegisterType((*PendingTxStatusResponse)(nil), "rpcpb.PendingTxStatusResponse")
 	proto.RegisterType((*Signature)(nil), "rpcpb.Signature")
 	proto.RegisterType((*TransactionRequest)(nil), "rpcpb.TransactionRequest")
 	proto.RegisterType((*Block)(nil), "rpcpb.Block")
@@ -3876,6 +6006,15 @@ func init() {
 	proto.RegisterType((*TxHashRequest)(nil), "rpcpb.TxHashRequest")
 	proto.RegisterType((*GetBlockByHashRequest)(nil), "rpcpb.GetBlockByHashRequest")
 	proto.RegisterType((*GetBlockByNumberRequest)(nil), "rpcpb.GetBlockByNumberRequest")
+	proto.RegisterType((*GetBlocksByRangeRequest)(nil), "rpcpb.GetBlocksByRangeRequest")
+	proto.RegisterType((*TxHashesRequest)(nil), "rpcpb.TxHashesRequest")
+	proto.RegisterType((*TxReceiptsResponse)(nil), "rpcpb.TxReceiptsResponse")
+	proto.RegisterType((*AccountHistoryRequest)(nil), "rpcpb.AccountHistoryRequest")
+	proto.RegisterType((*AccountBlockHistory)(nil), "rpcpb.AccountBlockHistory")
+	proto.RegisterMapType((map[string]float64)(nil), "rpcpb.AccountBlockHistory.TokenChangesEntry")
+	proto.RegisterType((*AccountHistoryResponse)(nil), "rpcpb.AccountHistoryResponse")
+	proto.RegisterType((*AccountUsageResponse)(nil), "rpcpb.AccountUsageResponse")
+	proto.RegisterMapType((map[string]float64)(nil), "rpcpb.AccountUsageResponse.TokenChangesEntry")
 	proto.RegisterType((*FrozenBalance)(nil), "rpcpb.FrozenBalance")
 	proto.RegisterType((*VoteInfo)(nil), "rpcpb.VoteInfo")
 	proto.RegisterType((*GetProducerVoteInfoRequest)(nil), "rpcpb.GetProducerVoteInfoRequest")
@@ -3893,6 +6032,11 @@ func init() {
 	proto.RegisterType((*GetAccountRequest)(nil), "rpcpb.GetAccountRequest")
 	proto.RegisterType((*Contract)(nil), "rpcpb.Contract")
 	proto.RegisterType((*Contract_ABI)(nil), "rpcpb.Contract.ABI")
+	proto.RegisterType((*EncodeActionRequest)(nil), "rpcpb.EncodeActionRequest")
+	proto.RegisterType((*ArgError)(nil), "rpcpb.ArgError")
+	proto.RegisterType((*EncodeActionResponse)(nil), "rpcpb.EncodeActionResponse")
+	proto.RegisterType((*DecodedCall)(nil), "rpcpb.DecodedCall")
+	proto.RegisterType((*DecodeTxResponse)(nil), "rpcpb.DecodeTxResponse")
 	proto.RegisterType((*GetContractRequest)(nil), "rpcpb.GetContractRequest")
 	proto.RegisterType((*GetContractStorageRequest)(nil), "rpcpb.GetContractStorageRequest")
 	proto.RegisterType((*GetContractStorageResponse)(nil), "rpcpb.GetContractStorageResponse")
@@ -3901,7 +6045,14 @@ func init() {
 	proto.RegisterType((*GetBatchContractStorageResponse)(nil), "rpcpb.GetBatchContractStorageResponse")
 	proto.RegisterType((*GetContractStorageFieldsRequest)(nil), "rpcpb.GetContractStorageFieldsRequest")
 	proto.RegisterType((*GetContractStorageFieldsResponse)(nil), "rpcpb.GetContractStorageFieldsResponse")
+	proto.RegisterType((*GetContractStorageProofRequest)(nil), "rpcpb.GetContractStorageProofRequest")
+	proto.RegisterType((*GetContractStorageProofResponse)(nil), "rpcpb.GetContractStorageProofResponse")
+	proto.RegisterType((*GetTxsByAccountRequest)(nil), "rpcpb.GetTxsByAccountRequest")
+	proto.RegisterType((*GetTxsByContractRequest)(nil), "rpcpb.GetTxsByContractRequest")
+	proto.RegisterType((*GetTxsResponse)(nil), "rpcpb.GetTxsResponse")
 	proto.RegisterType((*SendTransactionResponse)(nil), "rpcpb.SendTransactionResponse")
+	proto.RegisterType((*EstimateTransactionResponse)(nil), "rpcpb.EstimateTransactionResponse")
+	proto.RegisterMapType((map[string]int64)(nil), "rpcpb.EstimateTransactionResponse.RamUsageEntry")
 	proto.RegisterType((*GetTokenBalanceResponse)(nil), "rpcpb.GetTokenBalanceResponse")
 	proto.RegisterType((*GetTokenBalanceRequest)(nil), "rpcpb.GetTokenBalanceRequest")
 	proto.RegisterType((*GetToken721BalanceResponse)(nil), "rpcpb.GetToken721BalanceResponse")
@@ -3909,6 +6060,7 @@ func init() {
 	proto.RegisterType((*GetToken721MetadataResponse)(nil), "rpcpb.GetToken721MetadataResponse")
 	proto.RegisterType((*GetToken721OwnerResponse)(nil), "rpcpb.GetToken721OwnerResponse")
 	proto.RegisterType((*Event)(nil), "rpcpb.Event")
+	proto.RegisterType((*EventCursor)(nil), "rpcpb.EventCursor")
 	proto.RegisterType((*SubscribeRequest)(nil), "rpcpb.SubscribeRequest")
 	proto.RegisterType((*SubscribeRequest_Filter)(nil), "rpcpb.SubscribeRequest.Filter")
 	proto.RegisterType((*SubscribeResponse)(nil), "rpcpb.SubscribeResponse")
@@ -3922,253 +6074,369 @@ func init() {
 func init() { proto.RegisterFile("rpc/pb/rpc.proto", fileDescriptor_1b773bf3e696f610) }
 
 var fileDescriptor_1b773bf3e696f610 = []byte{
-	// 3925 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x3a, 0x5d, 0x73, 0xdb, 0x48,
-	0x72, 0x0b, 0x52, 0xfc, 0x40, 0x93, 0xa2, 0xe8, 0xb1, 0x6c, 0xd3, 0xf4, 0xfa, 0x0b, 0xbb, 0xeb,
-	0xaf, 0x6c, 0x24, 0x5b, 0x5e, 0xaf, 0xd7, 0xde, 0xbd, 0xe4, 0x28, 0x99, 0xd6, 0xa9, 0x6c, 0x53,
-	0x5a, 0x90, 0x5e, 0xe7, 0xaa, 0x92, 0xc2, 0x81, 0xc4, 0x88, 0x42, 0x19, 0x04, 0x18, 0x00, 0xb4,
-	0xc5, 0x73, 0xfc, 0x92, 0xc7, 0x54, 0x52, 0xa9, 0xab, 0x4b, 0x55, 0xf2, 0x90, 0x97, 0xbc, 0xde,
-	0x0f, 0x48, 0xf2, 0x1f, 0xf2, 0x96, 0x3c, 0xe4, 0xe9, 0x72, 0x0f, 0xc9, 0x3f, 0xb8, 0xe7, 0x54,
-	0x5d, 0x4d, 0xcf, 0x0c, 0x30, 0x04, 0x49, 0x49, 0x57, 0x75, 0x4f, 0x62, 0xf7, 0xf4, 0x74, 0xf7,
-	0x4c, 0x7f, 0x4c, 0x77, 0x43, 0x50, 0x0f, 0xc7, 0x83, 0xcd, 0x71, 0x7f, 0x33, 0x1c, 0x0f, 0x36,
-	0xc6, 0x61, 0x10, 0x07, 0xa4, 0x10, 0x8e, 0x07, 0xe3, 0x7e, 0xf3, 0xd3, 0x61, 0x10, 0x0c, 0x3d,
-	0xba, 0x69, 0x8f, 0xdd, 0x4d, 0xdb, 0xf7, 0x83, 0xd8, 0x8e, 0xdd, 0xc0, 0x8f, 0x38, 0x91, 0x51,
-	0x83, 0x6a, 0x7b, 0x34, 0x8e, 0xa7, 0x26, 0xfd, 0xcb, 0x09, 0x8d, 0x62, 0xe3, 0x3b, 0xa8, 0x74,
-	0x68, 0xfc, 0x3e, 0x08, 0xdf, 0xee, 0xf9, 0x87, 0x01, 0xa9, 0x41, 0xce, 0x75, 0x1a, 0xda, 0x0d,
-	0xed, 0x8e, 0x6e, 0xe6, 0x5c, 0x87, 0x5c, 0x05, 0x18, 0x53, 0x1a, 0x5a, 0x83, 0x60, 0xe2, 0xc7,
-	0x8d, 0xdc, 0x0d, 0xed, 0x4e, 0xc1, 0xd4, 0x19, 0x66, 0x87, 0x21, 0x8c, 0x5f, 0x69, 0xb0, 0x66,
-	0xb6, 0x5e, 0xb1, 0xad, 0x26, 0x8d, 0xc6, 0x81, 0x1f, 0x51, 0x72, 0x19, 0xca, 0x93, 0x88, 0x3a,
-	0x56, 0x68, 0x8f, 0x90, 0x51, 0xde, 0x2c, 0x31, 0xd8, 0xb4, 0x47, 0xe4, 0x33, 0x58, 0xb5, 0xdf,
-	0xd9, 0xae, 0x67, 0xf7, 0x3d, 0x8a, 0xeb, 0x39, 0x5c, 0xaf, 0x26, 0x48, 0x46, 0x74, 0x05, 0xf4,
-	0x38, 0x88, 0x6d, 0x0f, 0x09, 0xf2, 0x48, 0x50, 0x46, 0x04, 0x5b, 0xbc, 0x0a, 0x10, 0x51, 0xcf,
-	0xb3, 0xc6, 0xa1, 0x3b, 0xa0, 0x8d, 0x95, 0x1b, 0xda, 0x1d, 0xcd, 0xd4, 0x19, 0xe6, 0x80, 0x21,
-	0xd8, 0xde, 0xfe, 0x64, 0x2a, 0x56, 0x0b, 0xb8, 0x5a, 0xee, 0x4f, 0xa6, 0xb8, 0x68, 0xfc, 0xa7,
-	0x06, 0xf5, 0x4e, 0xe0, 0xd0, 0x19, 0x6d, 0xaf, 0x02, 0xf4, 0x27, 0xae, 0xe7, 0x58, 0xb1, 0x3b,
-	0xa2, 0xe2, 0xe0, 0x3a, 0x62, 0x7a, 0xee, 0x08, 0x0f, 0x33, 0x74, 0x63, 0xeb, 0xc8, 0x8e, 0x8e,
-	0x50, 0x59, 0xdd, 0x2c, 0x0d, 0xdd, 0xf8, 0x27, 0x76, 0x74, 0x44, 0x08, 0xac, 0x8c, 0x02, 0x87,
-	0xa2, 0x8a, 0xba, 0x89, 0xbf, 0xc9, 0x97, 0x50, 0xf2, 0xf9, 0x6d, 0xa2, 0x6e, 0x95, 0x2d, 0xb2,
-	0x81, 0x46, 0xd9, 0x50, 0xee, 0xd8, 0x94, 0x24, 0xe4, 0x26, 0x54, 0x07, 0x81, 0x43, 0xad, 0x77,
-	0x34, 0x8c, 0xdc, 0xc0, 0x47, 0x85, 0x75, 0xb3, 0xc2, 0x70, 0x3f, 0x70, 0x14, 0xb9, 0x0e, 0x95,
-	0x88, 0x86, 0xef, 0x68, 0xc8, 0xf5, 0x2b, 0xe2, 0x75, 0x00, 0x47, 0x31, 0x05, 0x8d, 0x27, 0x50,
-	0x69, 0x8d, 0x98, 0x2d, 0x5e, 0xba, 0x23, 0x37, 0x26, 0xeb, 0x50, 0x88, 0x83, 0xb7, 0xd4, 0x17,
-	0x27, 0xe1, 0x00, 0xc3, 0xbe, 0xb3, 0xbd, 0x09, 0x15, 0x47, 0xe0, 0x80, 0xf1, 0x53, 0x28, 0xb6,
-	0x06, 0xcc, 0x37, 0x48, 0x13, 0xca, 0x83, 0xc0, 0x8f, 0x43, 0x7b, 0x10, 0x8b, 0x8d, 0x09, 0xcc,
-	0x34, 0xb0, 0x91, 0xca, 0xf2, 0xed, 0x91, 0xe4, 0x00, 0x1c, 0xd5, 0xb1, 0x47, 0x94, 0xdd, 0x83,
-	0x63, 0xc7, 0xb6, 0xbc, 0x07, 0xf6, 0xdb, 0xf8, 0xcd, 0x0a, 0xe8, 0xbd, 0x63, 0x93, 0x0e, 0xa8,
-	0x3b, 0x8e, 0xc9, 0x25, 0x28, 0xc5, 0xc7, 0xfc, 0x0e, 0x39, 0xf7, 0x62, 0x7c, 0x8c, 0x57, 0x78,
-	0x05, 0xf4, 0xa1, 0x1d, 0x59, 0x93, 0xc8, 0x1e, 0x72, 0xce, 0x9a, 0x59, 0x1e, 0xda, 0xd1, 0x6b,
-	0x06, 0x93, 0x6f, 0x41, 0x0f, 0xed, 0x91, 0x58, 0xcc, 0xdf, 0xc8, 0xdf, 0xa9, 0x6c, 0x5d, 0x13,
-	0xb7, 0x99, 0xb0, 0xde, 0x30, 0xed, 0x11, 0x52, 0xb7, 0xfd, 0x38, 0x9c, 0x9a, 0xe5, 0x50, 0x80,
-	0xe4, 0x3b, 0xa8, 0x44, 0xb1, 0x1d, 0x4f, 0x22, 0x8b, 0xdd, 0x26, 0x1a, 0xa3, 0xb6, 0x75, 0x65,
-	0x6e, 0x7b, 0x17, 0x69, 0x76, 0x02, 0x87, 0x9a, 0x10, 0x25, 0xbf, 0x49, 0x03, 0x4a, 0x23, 0x1a,
-	0xa1, 0x60, 0x6e, 0x13, 0x09, 0xb2, 0x95, 0x90, 0xc6, 0x93, 0xd0, 0x8f, 0x1a, 0xc5, 0x1b, 0x79,
-	0xb6, 0x22, 0x40, 0xf2, 0x15, 0x94, 0x43, 0xce, 0x35, 0x6a, 0x94, 0x50, 0xdb, 0xc6, 0xbc, 0xb6,
-	0xfc, 0xaf, 0x99, 0x50, 0x36, 0xbf, 0x85, 0xd5, 0x99, 0x23, 0x90, 0x3a, 0xe4, 0xdf, 0xd2, 0xa9,
-	0xb8, 0x27, 0xf6, 0x73, 0xd6, 0x78, 0x79, 0x61, 0xbc, 0xa7, 0xb9, 0x6f, 0xb4, 0xe6, 0x8f, 0xa1,
-	0x24, 0xaf, 0xf8, 0x0a, 0xe8, 0x87, 0x13, 0x7f, 0xc0, 0x6d, 0x24, 0x4c, 0xc8, 0x10, 0x68, 0xa1,
-	0x06, 0x94, 0x98, 0x39, 0xa9, 0x88, 0x60, 0xdd, 0x94, 0xa0, 0xf1, 0x6f, 0x1a, 0x40, 0x7a, 0x07,
-	0xa4, 0x02, 0xa5, 0xee, 0xeb, 0x9d, 0x9d, 0x76, 0xb7, 0x5b, 0xff, 0x84, 0xac, 0x41, 0x65, 0xb7,
-	0xd5, 0xb5, 0xcc, 0xd7, 0x1d, 0x6b, 0xff, 0x75, 0xaf, 0xae, 0x91, 0x8b, 0x40, 0xb6, 0x5b, 0x2f,
-	0x5b, 0x9d, 0x9d, 0xb6, 0xd5, 0xd9, 0xef, 0x59, 0xed, 0xce, 0xfe, 0xeb, 0xdd, 0x9f, 0xd4, 0x73,
-	0xe4, 0x3c, 0xac, 0xbd, 0x31, 0xf7, 0x3b, 0xbb, 0xd6, 0x41, 0xcb, 0x6c, 0xbd, 0x6a, 0xf7, 0xda,
-	0x66, 0x3d, 0x4f, 0xce, 0xc1, 0xaa, 0xf9, 0xba, 0xd3, 0xdb, 0x7b, 0xd5, 0xb6, 0xda, 0xa6, 0xb9,
-	0x6f, 0xd6, 0x57, 0x18, 0x77, 0x06, 0x33, 0x66, 0x85, 0x74, 0x53, 0xef, 0xcf, 0xac, 0xe7, 0xfb,
-	0xe6, 0xab, 0x56, 0xaf, 0x5e, 0x64, 0x12, 0x9e, 0xbd, 0x3e, 0x78, 0xb9, 0xb7, 0xd3, 0xea, 0xb5,
-	0xad, 0x6e, 0xbb, 0x67, 0xed, 0xec, 0x3f, 0x6b, 0xd7, 0x4b, 0x8c, 0xd9, 0xeb, 0xce, 0x8b, 0xce,
-	0xfe, 0x9b, 0x8e, 0x60, 0x56, 0x36, 0x7e, 0x95, 0x87, 0x4a, 0x2f, 0xb4, 0xfd, 0x88, 0x7b, 0x22,
-	0xf3, 0x42, 0xc5, 0xc1, 0xf0, 0x37, 0xc3, 0x61, 0xd4, 0xf0, 0x8b, 0xc3, 0xdf, 0xe4, 0x1a, 0x00,
-	0x3d, 0x1e, 0xbb, 0x21, 0x26, 0x45, 0x91, 0x5e, 0x14, 0x8c, 0x74, 0x49, 0x84, 0x44, 0x7e, 0x61,
-	0x2e, 0x69, 0x32, 0x58, 0x2e, 0x7a, 0x2c, 0xd4, 0x64, 0x7a, 0x19, 0xda, 0x51, 0x12, 0x7a, 0x0e,
-	0xf5, 0xec, 0xa9, 0x08, 0x52, 0x0e, 0xb0, 0x04, 0x32, 0x38, 0xb2, 0x5d, 0xdf, 0x72, 0x9d, 0x46,
-	0xe9, 0x86, 0x76, 0x67, 0xd5, 0x2c, 0x21, 0xbc, 0xe7, 0x90, 0xdb, 0x50, 0xe2, 0xca, 0x47, 0x8d,
-	0x32, 0x3a, 0xcc, 0xaa, 0x70, 0x18, 0x1e, 0x95, 0xa6, 0x5c, 0x65, 0xf6, 0x8b, 0xdc, 0xa1, 0x4f,
-	0xc3, 0xa8, 0xa1, 0x73, 0xa7, 0x13, 0x20, 0xf9, 0x14, 0xf4, 0xf1, 0xa4, 0xef, 0xb9, 0xd1, 0x11,
-	0x0d, 0x1b, 0xc0, 0x93, 0x57, 0x82, 0x60, 0xa1, 0x1b, 0xd2, 0x43, 0x1a, 0x86, 0xd4, 0xb1, 0xe2,
-	0xe3, 0x46, 0x85, 0x87, 0xae, 0x44, 0xf5, 0x8e, 0xc9, 0x23, 0xa8, 0xda, 0x98, 0x3c, 0xc4, 0x91,
-	0xaa, 0xa8, 0x86, 0xcc, 0x59, 0x4a, 0x5e, 0x31, 0x2b, 0xb6, 0x92, 0x64, 0x36, 0x01, 0xe2, 0x63,
-	0x4b, 0xf8, 0x70, 0x63, 0x15, 0x13, 0x5d, 0x3d, 0xeb, 0xec, 0xa6, 0x1e, 0xcb, 0x9f, 0xc6, 0xff,
-	0x68, 0x70, 0x5e, 0x31, 0x56, 0x92, 0x7c, 0x9f, 0x40, 0x91, 0x47, 0x1d, 0x9a, 0xad, 0xb6, 0x75,
-	0x53, 0x32, 0x99, 0xa7, 0x15, 0xa1, 0x6a, 0x8a, 0x0d, 0xe4, 0x2b, 0xa8, 0xc4, 0x29, 0x15, 0x9a,
-	0x38, 0xd5, 0x5c, 0xdd, 0xaf, 0x92, 0xb1, 0x8c, 0xdb, 0xf7, 0x82, 0xc1, 0x5b, 0xcb, 0x9f, 0x8c,
-	0xfa, 0x34, 0x14, 0xf6, 0xaf, 0x20, 0xae, 0x83, 0x28, 0xe3, 0x21, 0x14, 0xb9, 0x28, 0xe6, 0xaf,
-	0x07, 0xed, 0xce, 0xb3, 0xbd, 0xce, 0x6e, 0xfd, 0x13, 0x02, 0x50, 0x3c, 0x68, 0xed, 0xbc, 0x68,
-	0x3f, 0xab, 0x6b, 0xa4, 0x0e, 0xd5, 0x3d, 0xd3, 0x6c, 0xff, 0xd0, 0x36, 0xbb, 0x7b, 0xdb, 0x2f,
-	0xdb, 0xf5, 0x9c, 0xf1, 0xef, 0x1a, 0xe8, 0x5d, 0x77, 0xe8, 0xdb, 0xf1, 0x24, 0xa4, 0xe4, 0x1b,
-	0xd0, 0x6d, 0x6f, 0x18, 0x84, 0x6e, 0x7c, 0x34, 0x12, 0x27, 0x6b, 0x0a, 0xcd, 0x12, 0xa2, 0x8d,
-	0x96, 0xa4, 0x30, 0x53, 0x62, 0x66, 0xcf, 0x48, 0x52, 0xe0, 0x99, 0xaa, 0x66, 0x8a, 0xc0, 0xc7,
-	0x98, 0x19, 0x77, 0x60, 0xb1, 0x14, 0x91, 0xe7, 0xcb, 0x1c, 0xf3, 0x82, 0x4e, 0x8d, 0xaf, 0x40,
-	0x4f, 0x98, 0x32, 0xe5, 0x45, 0xc8, 0xd4, 0x3f, 0x21, 0xab, 0xa0, 0x77, 0xdb, 0x3b, 0x07, 0x5b,
-	0x8f, 0xbe, 0x7e, 0xf1, 0xa0, 0xae, 0xb1, 0xb5, 0xf6, 0xb3, 0xad, 0x47, 0x8f, 0x1e, 0x3c, 0xa9,
-	0xe7, 0x8c, 0x7f, 0xcd, 0x03, 0x99, 0xb9, 0x6f, 0xac, 0x0b, 0x92, 0xd8, 0xd1, 0x96, 0xc6, 0x4e,
-	0xee, 0xe4, 0xd8, 0xc9, 0x9f, 0x14, 0x3b, 0x2b, 0xcb, 0x62, 0xa7, 0xb0, 0x2c, 0x76, 0x8a, 0x4b,
-	0x63, 0xa7, 0x74, 0x62, 0xec, 0x64, 0x5d, 0xbc, 0x7c, 0x36, 0x17, 0x5f, 0x1e, 0x72, 0xf7, 0x01,
-	0x12, 0x8b, 0x44, 0x0d, 0x40, 0x76, 0xf5, 0xac, 0x75, 0x4d, 0x85, 0x66, 0x36, 0x48, 0x2b, 0xd9,
-	0x20, 0x7d, 0x0c, 0xb5, 0x04, 0xb0, 0x22, 0x77, 0x18, 0x89, 0x28, 0x9c, 0xe7, 0xb9, 0x9a, 0xd0,
-	0x75, 0xdd, 0x61, 0x64, 0xfc, 0x6f, 0x1e, 0x0a, 0xdb, 0xcc, 0x71, 0x17, 0xe6, 0xbe, 0x06, 0x94,
-	0x64, 0x59, 0xc1, 0x0d, 0x25, 0x41, 0x96, 0x15, 0xc6, 0x76, 0x48, 0x7d, 0x51, 0xd5, 0xf0, 0x67,
-	0x1b, 0x38, 0x0a, 0x5f, 0xe5, 0xcf, 0xa1, 0x16, 0x1f, 0x5b, 0x23, 0x1a, 0xbe, 0xf5, 0x28, 0xa7,
-	0x59, 0x41, 0x9a, 0x6a, 0x7c, 0xfc, 0x0a, 0x91, 0x48, 0xf5, 0x10, 0x2e, 0xa6, 0x49, 0x60, 0x86,
-	0x9a, 0x3f, 0x99, 0xe7, 0x93, 0xf0, 0x57, 0x36, 0x5d, 0x84, 0xa2, 0x88, 0x3c, 0x9e, 0x24, 0x05,
-	0xc4, 0xb4, 0x7d, 0xef, 0xc6, 0x3e, 0x8d, 0x22, 0x4c, 0x92, 0xba, 0x29, 0xc1, 0xc4, 0x0f, 0xcb,
-	0x8a, 0x1f, 0xce, 0x94, 0x0d, 0x7a, 0xa6, 0x6c, 0xb8, 0x0c, 0xe5, 0xf8, 0x58, 0xd4, 0xab, 0xc0,
-	0x4f, 0x1e, 0x1f, 0x63, 0xb5, 0x4a, 0xbe, 0x80, 0x15, 0xd7, 0x3f, 0x0c, 0xd0, 0x06, 0x95, 0xad,
-	0x73, 0xe2, 0x82, 0xf1, 0x0e, 0x37, 0xb0, 0x32, 0xc3, 0x65, 0xf2, 0x35, 0x54, 0x95, 0x9c, 0x11,
-	0x65, 0xb2, 0xa2, 0x1a, 0x2b, 0x33, 0x74, 0xcd, 0x2e, 0xac, 0x60, 0x0d, 0x2d, 0x0b, 0x43, 0x0d,
-	0xab, 0x65, 0x5e, 0x18, 0x5e, 0x84, 0x62, 0x7c, 0x14, 0x52, 0xdb, 0x11, 0x35, 0xb4, 0x80, 0x98,
-	0x31, 0xfa, 0x76, 0x3c, 0x38, 0xb2, 0x5c, 0xdf, 0xa1, 0xc7, 0x58, 0xe6, 0x14, 0x4c, 0x40, 0xd4,
-	0x1e, 0xc3, 0x18, 0xbf, 0xd0, 0x60, 0x15, 0x35, 0x4c, 0x92, 0xe6, 0xc3, 0x4c, 0xd2, 0xbc, 0xa2,
-	0x9e, 0x63, 0x59, 0xba, 0x34, 0xa0, 0x80, 0x49, 0x4e, 0x24, 0xca, 0xea, 0xcc, 0x1e, 0xbe, 0x64,
-	0xdc, 0x5e, 0x9c, 0xf9, 0xb2, 0xd9, 0x4e, 0x33, 0xfe, 0x23, 0x0f, 0xe7, 0x76, 0x30, 0x10, 0x33,
-	0x75, 0xbf, 0x4f, 0x63, 0xb5, 0x02, 0x61, 0x85, 0x2e, 0x16, 0x20, 0x77, 0xa1, 0x8e, 0xdd, 0xc7,
-	0x20, 0xf0, 0x2c, 0xd5, 0x2b, 0x75, 0x73, 0x4d, 0xe2, 0x65, 0xc1, 0xab, 0xc6, 0x7c, 0x7e, 0x36,
-	0xe6, 0xaf, 0x02, 0x1c, 0x51, 0xdb, 0xb1, 0xf8, 0x41, 0x56, 0xd0, 0xb6, 0x3a, 0xc3, 0xf0, 0x28,
-	0xb8, 0x05, 0x6b, 0xe9, 0xb2, 0xea, 0x89, 0xab, 0x09, 0x8d, 0x2c, 0x3a, 0x3d, 0xb7, 0x2f, 0xb8,
-	0x70, 0x37, 0x2c, 0x7b, 0x6e, 0x9f, 0x33, 0xf9, 0x1c, 0x6a, 0xc9, 0x22, 0xe7, 0xc1, 0xfd, 0xb1,
-	0x2a, 0x29, 0x90, 0xc5, 0x4d, 0xa8, 0x0a, 0xff, 0xb4, 0x3c, 0x37, 0xe2, 0x49, 0x45, 0x37, 0x2b,
-	0x02, 0xf7, 0xd2, 0x8d, 0x62, 0x72, 0x07, 0xea, 0x8c, 0xd1, 0x0c, 0x19, 0xcf, 0x24, 0x4c, 0xc0,
-	0x1b, 0x85, 0xf2, 0x3e, 0xac, 0x8f, 0xa9, 0xef, 0xb8, 0xfe, 0x70, 0x96, 0x1a, 0x90, 0x9a, 0x88,
-	0x35, 0x75, 0xc7, 0xec, 0x49, 0x31, 0x3c, 0x2a, 0x78, 0x8e, 0xf4, 0xa4, 0xd8, 0xbc, 0xcc, 0x1c,
-	0x06, 0xc9, 0xaa, 0xbc, 0xdf, 0x92, 0x87, 0xc1, 0x0e, 0xe2, 0x33, 0x58, 0xed, 0x61, 0x39, 0xae,
-	0xa4, 0xfe, 0x6c, 0x3a, 0x31, 0x76, 0xe1, 0xc2, 0x2e, 0x8d, 0x71, 0xd3, 0xf6, 0xf4, 0x14, 0x62,
-	0xde, 0x4e, 0x8c, 0xc6, 0x1e, 0x8d, 0xf9, 0x23, 0x56, 0x36, 0x13, 0xd8, 0x78, 0x05, 0x97, 0x52,
-	0x46, 0xfc, 0xc9, 0x95, 0xac, 0xd2, 0xe4, 0xa0, 0xcd, 0x24, 0x87, 0x93, 0xd8, 0x7d, 0x0b, 0xab,
-	0xcf, 0xc3, 0xe0, 0xe7, 0xd4, 0xdf, 0xb6, 0x3d, 0xdb, 0x1f, 0x60, 0xa0, 0xf1, 0x3c, 0x8e, 0x4c,
-	0x34, 0x53, 0x40, 0x8b, 0x6a, 0x41, 0xe3, 0x2f, 0xa0, 0xfc, 0x43, 0x10, 0x63, 0x3f, 0xc8, 0xf6,
-	0x05, 0x63, 0x7c, 0xd7, 0x44, 0x8b, 0xc2, 0x21, 0xac, 0xbe, 0x83, 0x98, 0x46, 0xa2, 0x3d, 0xe1,
-	0x00, 0x6b, 0x64, 0x07, 0x1e, 0xb5, 0x59, 0x61, 0xc5, 0x57, 0xf9, 0x6b, 0x57, 0x15, 0x48, 0xc6,
-	0x35, 0x32, 0x7e, 0x06, 0xcd, 0x5d, 0x1a, 0x1f, 0x84, 0x81, 0x33, 0x19, 0xd0, 0x50, 0x4a, 0x92,
-	0xa7, 0x6d, 0xb0, 0x17, 0x6c, 0x90, 0x68, 0xaa, 0x9b, 0x12, 0x64, 0xae, 0xd3, 0x9f, 0x5a, 0x5e,
-	0xe0, 0x0f, 0x69, 0x14, 0x5b, 0xe8, 0xfd, 0xe2, 0xdc, 0xb5, 0xfe, 0xf4, 0x25, 0x47, 0x63, 0xf8,
-	0x19, 0xff, 0xad, 0xc1, 0x95, 0x85, 0x22, 0x44, 0x48, 0x5e, 0x84, 0xe2, 0x78, 0xd2, 0x4f, 0xfb,
-	0x09, 0x01, 0xb1, 0x26, 0xc3, 0x0b, 0x06, 0x22, 0x04, 0xd9, 0x4f, 0x86, 0x99, 0x84, 0x9e, 0x78,
-	0x0c, 0xd8, 0x4f, 0x72, 0x01, 0x8a, 0x2c, 0x9c, 0x5d, 0x47, 0x64, 0xff, 0x82, 0x4f, 0xe3, 0x3d,
-	0x4c, 0x58, 0x6e, 0x64, 0x8d, 0x85, 0x44, 0x8c, 0xb0, 0xb2, 0x09, 0x6e, 0x24, 0x75, 0x60, 0x32,
-	0x45, 0x7a, 0x2a, 0x72, 0x99, 0x22, 0x03, 0xb1, 0x0b, 0xf6, 0x3d, 0xd7, 0xa7, 0x18, 0x51, 0x65,
-	0x53, 0x40, 0xe9, 0x05, 0x97, 0x95, 0x0b, 0x36, 0x0e, 0xa1, 0xbe, 0x2b, 0x2a, 0x87, 0xe4, 0x34,
-	0x2c, 0xa4, 0x82, 0xf7, 0xec, 0x4e, 0xd2, 0x2a, 0x83, 0x1b, 0xb9, 0xc6, 0xf1, 0x72, 0x07, 0xa3,
-	0x1c, 0x51, 0xc7, 0xb5, 0x7d, 0x85, 0x92, 0xdb, 0xaf, 0xc6, 0xf1, 0x92, 0xd2, 0xf8, 0x7f, 0x1d,
-	0x4a, 0x2d, 0x71, 0xef, 0x04, 0x56, 0x94, 0xe4, 0x85, 0xbf, 0x99, 0x95, 0xfa, 0xdc, 0xb3, 0x04,
-	0x03, 0x09, 0x92, 0x07, 0xc0, 0xde, 0x1c, 0x0b, 0x1f, 0x94, 0x3c, 0x26, 0xd5, 0x8b, 0x49, 0x09,
-	0x82, 0xfc, 0x36, 0x76, 0xed, 0x88, 0xf7, 0xfb, 0x43, 0xfe, 0x83, 0x6d, 0x61, 0x1d, 0x2d, 0x6e,
-	0x59, 0x59, 0xb8, 0x45, 0xce, 0x52, 0x4a, 0xa1, 0x3d, 0xc2, 0x2d, 0x2d, 0xa8, 0x8c, 0x69, 0x38,
-	0x72, 0xa3, 0x08, 0x9f, 0xa2, 0x02, 0x3e, 0x45, 0xd7, 0x33, 0xbb, 0x0e, 0x52, 0x0a, 0xde, 0x07,
-	0xab, 0x7b, 0xc8, 0x16, 0x14, 0x87, 0x61, 0x30, 0x19, 0xf3, 0x8e, 0xb5, 0x92, 0x94, 0xa2, 0x89,
-	0x9a, 0xb8, 0xc8, 0x37, 0x0a, 0x4a, 0xf2, 0x23, 0x58, 0x3b, 0xc4, 0xb0, 0xb2, 0xc4, 0x71, 0x65,
-	0x99, 0xb5, 0x2e, 0x36, 0xcf, 0x04, 0x9d, 0x59, 0x3b, 0x54, 0xc1, 0x88, 0x6c, 0x00, 0x30, 0x33,
-	0xe2, 0x49, 0x65, 0x73, 0xb3, 0x26, 0x76, 0x26, 0x4e, 0xaa, 0xbf, 0x13, 0xbf, 0xa2, 0xe6, 0x9f,
-	0x00, 0x1c, 0x78, 0xd4, 0x19, 0xf2, 0x50, 0x6c, 0x40, 0x69, 0x8c, 0x50, 0x28, 0x23, 0x43, 0x80,
-	0x4a, 0x70, 0xe7, 0xd4, 0xe0, 0x6e, 0xfe, 0x56, 0x83, 0x92, 0xb8, 0x6d, 0x0c, 0xcd, 0x49, 0x88,
-	0xf5, 0x0d, 0x4e, 0x8d, 0x84, 0x8b, 0x54, 0x05, 0xb2, 0xc7, 0x70, 0xec, 0x41, 0xc2, 0xa7, 0xfb,
-	0x90, 0x86, 0x38, 0x8b, 0x1a, 0xda, 0x32, 0xc0, 0xd7, 0x54, 0xfc, 0xae, 0x1d, 0x61, 0xd1, 0x8d,
-	0xe2, 0x91, 0x88, 0xc7, 0xb9, 0xce, 0x31, 0x6c, 0xf9, 0x0b, 0xa8, 0xb9, 0xfe, 0x20, 0xa4, 0x76,
-	0x44, 0xad, 0x68, 0x4c, 0xa9, 0x23, 0x6a, 0xdb, 0x55, 0x89, 0xed, 0x32, 0x24, 0xf3, 0x72, 0xb5,
-	0x6b, 0xe4, 0x00, 0xf9, 0x0e, 0xaa, 0x9c, 0x93, 0xc3, 0x9d, 0x82, 0x1b, 0xe8, 0x72, 0xd6, 0xbc,
-	0xc9, 0xd5, 0x98, 0x15, 0x41, 0xce, 0x80, 0xe6, 0xf7, 0x50, 0x12, 0xfe, 0xc2, 0x4a, 0xcc, 0x64,
-	0x86, 0x26, 0xb2, 0x67, 0x8a, 0x60, 0x8e, 0x3d, 0x89, 0xa8, 0x23, 0x73, 0x1f, 0xfb, 0xcd, 0x07,
-	0x45, 0xec, 0x7a, 0x78, 0x0b, 0xc4, 0x81, 0xa6, 0x0f, 0x2b, 0x7b, 0x31, 0x1d, 0xcd, 0x8d, 0x01,
-	0xaf, 0x61, 0xd4, 0xbf, 0xa5, 0x53, 0x6b, 0x6c, 0xbb, 0xa1, 0xc8, 0x46, 0xba, 0x1b, 0xbd, 0xa0,
-	0xd3, 0x03, 0xdb, 0x45, 0xc3, 0xbc, 0xa7, 0xee, 0xf0, 0x28, 0x16, 0xec, 0x04, 0xc4, 0x3a, 0x86,
-	0xd4, 0x15, 0x45, 0x22, 0x51, 0x30, 0xcd, 0xe7, 0x50, 0x40, 0xf7, 0x5b, 0x18, 0x7b, 0x77, 0xa1,
-	0xe0, 0xc6, 0x74, 0xc4, 0x2c, 0xc3, 0xae, 0xe5, 0x7c, 0xe6, 0x5a, 0x98, 0xa2, 0x26, 0xa7, 0x68,
-	0xfe, 0x8d, 0x06, 0x90, 0x46, 0xc1, 0x42, 0x6e, 0xd7, 0xa1, 0x82, 0xce, 0x8d, 0x05, 0x0a, 0xe7,
-	0xa9, 0x9b, 0x80, 0x28, 0x56, 0xa3, 0x44, 0xa9, 0xb8, 0xfc, 0x69, 0xe2, 0xd8, 0x75, 0xb3, 0xfa,
-	0x2d, 0x3a, 0x0a, 0x3c, 0x47, 0x16, 0x22, 0x09, 0xa2, 0xf9, 0x53, 0xa8, 0x67, 0x23, 0x72, 0xc1,
-	0x58, 0x67, 0x53, 0x1d, 0xeb, 0x2c, 0x30, 0x7a, 0xc2, 0x41, 0x9d, 0xf8, 0xec, 0x43, 0x45, 0x09,
-	0xd7, 0x05, 0x5c, 0xef, 0xcd, 0x72, 0x5d, 0x5f, 0x14, 0xeb, 0x0a, 0x43, 0xe3, 0x7b, 0x38, 0xb7,
-	0x4b, 0x63, 0xb1, 0xac, 0xbc, 0xe9, 0x73, 0xd7, 0x77, 0xf6, 0x47, 0xe9, 0xb7, 0x1a, 0x94, 0x77,
-	0xe4, 0xf4, 0x30, 0xeb, 0x48, 0x04, 0x56, 0x70, 0x20, 0xc7, 0x9f, 0x1e, 0xfc, 0xcd, 0xde, 0x77,
-	0xcf, 0xf6, 0x87, 0x13, 0x3e, 0xe7, 0xc3, 0xd1, 0x95, 0x84, 0xd5, 0x36, 0x86, 0x7b, 0x4f, 0xd2,
-	0xc6, 0xdc, 0x86, 0x15, 0xbb, 0xef, 0xca, 0x94, 0x28, 0xad, 0x25, 0x05, 0x6f, 0xb4, 0xb6, 0xf7,
-	0x4c, 0x24, 0x68, 0x3a, 0x90, 0x6f, 0x6d, 0xef, 0x2d, 0x3c, 0x14, 0x81, 0x15, 0x3b, 0x1c, 0x4a,
-	0x67, 0xc0, 0xdf, 0x73, 0x0d, 0x63, 0xfe, 0x4c, 0x0d, 0xa3, 0xd1, 0x01, 0xb2, 0x4b, 0x63, 0x29,
-	0x5e, 0xde, 0x64, 0xf6, 0xf8, 0x67, 0xbf, 0xc5, 0x8f, 0x70, 0x59, 0xe1, 0xd7, 0x8d, 0x83, 0xd0,
-	0x1e, 0xd2, 0x65, 0x6c, 0x85, 0x1f, 0xe4, 0x66, 0x86, 0x86, 0x87, 0x2e, 0xf5, 0x1c, 0x71, 0xa1,
-	0x1c, 0x58, 0x28, 0x7e, 0x65, 0xa1, 0xf8, 0x10, 0x6b, 0x97, 0x39, 0xf1, 0xe2, 0x25, 0x96, 0x23,
-	0x5f, 0x2d, 0x1d, 0xf9, 0xe2, 0x20, 0x3d, 0xad, 0x9a, 0x73, 0x62, 0x90, 0xae, 0x96, 0xcc, 0xa7,
-	0x4d, 0x5e, 0x7e, 0xad, 0xc1, 0x35, 0x56, 0x1b, 0xb2, 0xe6, 0xe7, 0x8c, 0x07, 0x7f, 0x05, 0xc0,
-	0x92, 0x12, 0x9e, 0x4e, 0xe6, 0x89, 0x0d, 0x61, 0xaa, 0x93, 0x59, 0x6d, 0xbc, 0xa0, 0xd3, 0xe7,
-	0x6c, 0x9b, 0xa9, 0xbf, 0x15, 0xbf, 0xa2, 0x85, 0xf7, 0x93, 0x5f, 0x74, 0x3f, 0xcd, 0x2d, 0x28,
-	0x4b, 0x06, 0x8b, 0x47, 0xb6, 0xfc, 0xf6, 0x73, 0xca, 0xed, 0x1b, 0x53, 0xb8, 0xbe, 0x54, 0x27,
-	0x71, 0xb1, 0xeb, 0x50, 0x60, 0x97, 0xc9, 0x5a, 0x3b, 0xe6, 0x91, 0x1c, 0xf8, 0x03, 0x5c, 0xed,
-	0x08, 0x45, 0x67, 0xa4, 0xf2, 0x43, 0x9f, 0xdd, 0xa7, 0xce, 0x7c, 0x3b, 0xc6, 0x5f, 0xc1, 0x8d,
-	0xe5, 0xe2, 0xd2, 0xda, 0x54, 0x98, 0x8d, 0x9f, 0x55, 0x40, 0x7f, 0x80, 0xc3, 0x52, 0xb8, 0xd4,
-	0xa5, 0xbe, 0xb3, 0x68, 0xe0, 0xb8, 0xa8, 0x5b, 0xf9, 0x1a, 0x6a, 0xe3, 0x90, 0x5a, 0xca, 0x44,
-	0x33, 0xb7, 0x64, 0xa2, 0x59, 0x1d, 0x87, 0x34, 0x81, 0x8c, 0x10, 0x3b, 0x99, 0x5e, 0xf0, 0x36,
-	0xad, 0x83, 0xa4, 0x18, 0xa5, 0x6a, 0xd4, 0x66, 0xab, 0xc6, 0x05, 0x85, 0x55, 0xee, 0xec, 0x85,
-	0x95, 0x11, 0xc2, 0xc5, 0x39, 0x99, 0xa7, 0xb5, 0x13, 0xc9, 0x27, 0xa1, 0x9c, 0xfa, 0x49, 0xe8,
-	0xec, 0xc6, 0x34, 0x31, 0x15, 0xa0, 0xcc, 0xc7, 0x5b, 0x0f, 0x4e, 0x39, 0x6a, 0x3e, 0x3d, 0x6a,
-	0x13, 0xca, 0x28, 0x6a, 0xef, 0x99, 0x4c, 0xb0, 0x09, 0x6c, 0x44, 0xe9, 0x39, 0x1e, 0x6f, 0x3d,
-	0x50, 0xdb, 0xa2, 0xc5, 0x1f, 0xb0, 0x2e, 0x0b, 0x5e, 0xac, 0x1d, 0x11, 0x9f, 0x30, 0x38, 0x2f,
-	0xe7, 0xf7, 0x38, 0xc8, 0x13, 0x6c, 0x96, 0xa4, 0xd0, 0x57, 0x34, 0xb6, 0x59, 0x78, 0x25, 0x27,
-	0x69, 0x42, 0x79, 0x24, 0x70, 0xf2, 0x0b, 0x8a, 0x84, 0x8d, 0xfb, 0xd0, 0x50, 0xb6, 0xee, 0xbf,
-	0xf7, 0x59, 0xd7, 0x9a, 0xc6, 0x6c, 0xc0, 0x10, 0x52, 0x63, 0x04, 0x8c, 0xbf, 0xd5, 0xa0, 0xd0,
-	0x7e, 0x47, 0xb1, 0x9d, 0x2b, 0xc4, 0xc1, 0xd8, 0x1d, 0x88, 0x71, 0x8d, 0x7c, 0x49, 0x70, 0x71,
-	0xa3, 0xc7, 0x56, 0x4c, 0x4e, 0x90, 0xa4, 0xd5, 0x9c, 0x92, 0x56, 0x65, 0xdf, 0x9a, 0x57, 0xfa,
-	0xd6, 0x07, 0x50, 0xc0, 0x7d, 0x64, 0x1d, 0xea, 0x3b, 0xfb, 0x9d, 0x9e, 0xd9, 0xda, 0xe9, 0x59,
-	0x66, 0x7b, 0xa7, 0xbd, 0x77, 0xd0, 0xab, 0x7f, 0x42, 0x08, 0xd4, 0x12, 0x6c, 0xfb, 0x87, 0x76,
-	0xa7, 0x57, 0xd7, 0x8c, 0x7f, 0xd1, 0xa0, 0xde, 0x9d, 0xf4, 0xa3, 0x41, 0xe8, 0xf6, 0x13, 0x9f,
-	0xb9, 0x07, 0x45, 0x14, 0xcc, 0x43, 0x70, 0xb1, 0x6a, 0x82, 0x82, 0x7c, 0xcd, 0xc2, 0xd5, 0x8b,
-	0x69, 0x28, 0xa2, 0x43, 0x7e, 0x8a, 0xcb, 0x32, 0xdd, 0x78, 0x8e, 0x54, 0xa6, 0xa0, 0x6e, 0xde,
-	0x85, 0x22, 0xc7, 0xb0, 0x02, 0x4c, 0x7e, 0x54, 0xb4, 0x92, 0x4c, 0x03, 0x12, 0xb5, 0xe7, 0x18,
-	0x8f, 0xe1, 0x9c, 0xc2, 0x4d, 0xdc, 0xae, 0x01, 0x05, 0xca, 0xd4, 0x41, 0xfa, 0x74, 0x70, 0x85,
-	0x2a, 0x9a, 0x7c, 0xc9, 0xf8, 0x07, 0x0d, 0x80, 0xb5, 0x15, 0xe1, 0x76, 0xe0, 0x4f, 0x22, 0x66,
-	0x90, 0x3e, 0xfb, 0x21, 0x62, 0x8f, 0x03, 0xe4, 0x11, 0x14, 0x1d, 0x1a, 0xdb, 0xae, 0x27, 0x02,
-	0xee, 0xaa, 0xd2, 0x8f, 0xf0, 0x8d, 0x1b, 0xcf, 0x70, 0x5d, 0x74, 0x42, 0x9c, 0xb8, 0xf9, 0x04,
-	0x2a, 0x0a, 0xfa, 0xb4, 0xcf, 0x73, 0x9a, 0x5a, 0x5b, 0xdd, 0x82, 0xda, 0x8e, 0xed, 0x3b, 0xae,
-	0x63, 0xc7, 0xf4, 0x04, 0xcd, 0x8c, 0x37, 0x70, 0x5e, 0x3a, 0x97, 0x1a, 0x09, 0xac, 0x91, 0x9e,
-	0x8e, 0xfa, 0x81, 0x27, 0x9b, 0x77, 0x0e, 0xfd, 0x1e, 0x35, 0xc4, 0x6f, 0x34, 0xd0, 0x13, 0xb6,
-	0x4b, 0xf9, 0xe1, 0xa7, 0x43, 0xcf, 0x53, 0x3f, 0xef, 0x96, 0x19, 0x02, 0x27, 0x77, 0x17, 0xa1,
-	0xe8, 0x46, 0xd1, 0x44, 0x24, 0x5a, 0xdd, 0x14, 0x10, 0x4b, 0xc3, 0xfc, 0x23, 0x7d, 0x34, 0x19,
-	0x8f, 0xbd, 0xa9, 0x28, 0x82, 0x2b, 0x88, 0xeb, 0x22, 0x8a, 0x75, 0x46, 0xb2, 0x11, 0x13, 0x44,
-	0x7c, 0xb8, 0x2f, 0xdb, 0x33, 0x41, 0xd6, 0x80, 0x92, 0x43, 0x07, 0xee, 0xc8, 0xf6, 0x70, 0x60,
-	0x50, 0x30, 0x25, 0x88, 0x9f, 0xc7, 0x6d, 0xdf, 0x92, 0x0d, 0x99, 0x98, 0x1b, 0x54, 0x06, 0xb6,
-	0xdf, 0x13, 0xa8, 0xad, 0x5f, 0x5f, 0x00, 0x68, 0x8d, 0xdd, 0x2e, 0x0d, 0xdf, 0xb9, 0x03, 0x4a,
-	0xbe, 0x87, 0xca, 0x2e, 0x8d, 0xe5, 0x37, 0x7e, 0x22, 0x8b, 0x42, 0xf5, 0x1f, 0x1e, 0x9a, 0x97,
-	0xe4, 0x17, 0xf9, 0xcc, 0x7f, 0x02, 0x18, 0xeb, 0x7f, 0xfd, 0x5f, 0xff, 0xf7, 0xcb, 0x5c, 0x8d,
-	0x54, 0x37, 0x87, 0x0a, 0x8f, 0x1e, 0x54, 0xd9, 0x53, 0x26, 0xa7, 0x9d, 0x8b, 0x79, 0xca, 0x2f,
-	0xbd, 0x73, 0x43, 0x51, 0xe3, 0x02, 0x32, 0x5d, 0x23, 0xab, 0x8c, 0x69, 0xca, 0xa5, 0x03, 0xb0,
-	0x4b, 0x63, 0xd9, 0xbd, 0x2d, 0xe4, 0x29, 0x47, 0x03, 0x99, 0x7f, 0xaf, 0x30, 0xce, 0x23, 0xc7,
-	0x55, 0x52, 0x61, 0x1c, 0x25, 0x87, 0x3f, 0xc7, 0x83, 0xf7, 0x8e, 0xf9, 0x6c, 0x8e, 0xac, 0x27,
-	0x4f, 0x97, 0x32, 0xaa, 0x6b, 0x36, 0x97, 0x7f, 0x5d, 0x33, 0xae, 0x20, 0xd7, 0x0b, 0xe4, 0x3c,
-	0xe3, 0x2a, 0xf9, 0x6c, 0x7e, 0x60, 0x0f, 0xe4, 0x47, 0xe2, 0xc0, 0x3a, 0x72, 0x17, 0x2f, 0xdf,
-	0xf6, 0x94, 0xf3, 0x5d, 0x22, 0x66, 0xee, 0xdd, 0x34, 0x3e, 0x47, 0xe6, 0xd7, 0xc8, 0xa7, 0x9c,
-	0x79, 0x86, 0x8d, 0x94, 0x12, 0x40, 0x6d, 0x76, 0xc4, 0x48, 0x3e, 0x55, 0x2a, 0xb9, 0xb9, 0xc9,
-	0x63, 0x73, 0x7d, 0xd1, 0xdc, 0xdb, 0xb8, 0x8b, 0xb2, 0x3e, 0x23, 0x37, 0x99, 0x2c, 0x65, 0x97,
-	0x90, 0xb2, 0xf9, 0x41, 0x8e, 0x0e, 0x3f, 0x92, 0xf7, 0x50, 0xcf, 0x8e, 0x22, 0xc9, 0xb5, 0x39,
-	0x91, 0x33, 0x33, 0xca, 0x25, 0x42, 0xff, 0x18, 0x85, 0xde, 0x26, 0x5f, 0x28, 0x42, 0xf9, 0xbe,
-	0xcd, 0x0f, 0xbc, 0x4e, 0x99, 0x11, 0x4c, 0xd1, 0xfa, 0x72, 0xec, 0xd4, 0x48, 0x45, 0xce, 0xf6,
-	0x61, 0xcd, 0xda, 0x6c, 0xf7, 0x36, 0x2b, 0x46, 0x20, 0x37, 0x3f, 0xb0, 0xb8, 0xfd, 0xb8, 0xf9,
-	0x21, 0x9b, 0x13, 0x3e, 0x92, 0xbf, 0xd7, 0x60, 0x2d, 0x53, 0x2d, 0x90, 0xab, 0xa9, 0xb0, 0x05,
-	0x55, 0x44, 0xf3, 0xda, 0xb2, 0x65, 0x71, 0xd0, 0x1f, 0xa1, 0x06, 0x8f, 0xc9, 0x23, 0xb4, 0xa4,
-	0x42, 0xb1, 0xf9, 0x41, 0x94, 0x1b, 0x1f, 0x37, 0x3f, 0xe0, 0xcb, 0xbc, 0x50, 0xa3, 0x7f, 0xd2,
-	0xb0, 0x4b, 0xca, 0xd4, 0x12, 0xa7, 0x29, 0x75, 0x33, 0xb3, 0x3c, 0x5f, 0x85, 0x18, 0x3f, 0x46,
-	0xbd, 0x9e, 0x92, 0x6f, 0x12, 0xbd, 0x52, 0xa2, 0xb3, 0xa9, 0xf6, 0xcf, 0x5a, 0x9a, 0x85, 0x95,
-	0xea, 0x60, 0x4e, 0xb7, 0xd9, 0x72, 0xa5, 0x69, 0xcc, 0x2f, 0x67, 0x0b, 0x0b, 0x63, 0x1b, 0x95,
-	0xfb, 0x8e, 0x3c, 0x55, 0x95, 0x93, 0x54, 0xa9, 0x4e, 0xb2, 0xc0, 0x59, 0xa8, 0xde, 0x2f, 0x35,
-	0x74, 0xd6, 0x99, 0x0a, 0xe4, 0x34, 0xdd, 0xae, 0xcf, 0x2f, 0xcf, 0x54, 0x2e, 0xc6, 0x9f, 0xa2,
-	0x62, 0x4f, 0xc8, 0x63, 0x55, 0x31, 0x24, 0x39, 0xa3, 0x56, 0x3c, 0xdf, 0x26, 0x63, 0xd7, 0x13,
-	0xf3, 0x6d, 0x76, 0x9c, 0x3b, 0x9b, 0x6f, 0x13, 0x1e, 0xff, 0xc8, 0xed, 0x90, 0x1d, 0x69, 0x13,
-	0xc5, 0x09, 0x96, 0x4c, 0xd4, 0x55, 0x5b, 0x2c, 0x9b, 0x88, 0x1b, 0x4f, 0x50, 0xe8, 0x43, 0xf2,
-	0x80, 0x09, 0xcd, 0x52, 0xa9, 0x9e, 0x32, 0x7f, 0xd8, 0x21, 0x1e, 0x36, 0x99, 0x6c, 0x5c, 0x4e,
-	0xa5, 0x65, 0xba, 0xfe, 0xe6, 0x5a, 0x66, 0x18, 0x61, 0x7c, 0x89, 0x52, 0x6f, 0x91, 0xcf, 0xf1,
-	0x15, 0x10, 0xd8, 0xcd, 0x0f, 0x4b, 0x6e, 0x75, 0x3a, 0x33, 0x4a, 0x10, 0xdd, 0x13, 0xb9, 0x31,
-	0x2f, 0x6f, 0xb6, 0xa3, 0x55, 0xe3, 0x64, 0x49, 0x7f, 0x69, 0x5c, 0x43, 0x45, 0x1a, 0xc6, 0x79,
-	0x55, 0x11, 0x41, 0xf4, 0x54, 0xbb, 0x47, 0xfe, 0x4e, 0xe3, 0x9f, 0x67, 0x16, 0xf4, 0xa8, 0xe4,
-	0x8b, 0x33, 0xf5, 0xd5, 0xcd, 0x5b, 0xa7, 0x91, 0x09, 0x55, 0x3e, 0x43, 0x55, 0xae, 0x1a, 0x0d,
-	0xcc, 0x99, 0x0b, 0x28, 0x99, 0x3e, 0xbf, 0xd0, 0xb0, 0xf0, 0x5e, 0xd8, 0x49, 0x92, 0x5b, 0x4b,
-	0xcf, 0x3b, 0xd3, 0xd9, 0x36, 0x6f, 0x9f, 0x4a, 0x27, 0x54, 0x12, 0xef, 0x94, 0x71, 0x79, 0xc1,
-	0xed, 0x70, 0x52, 0xa6, 0xd3, 0xcf, 0x60, 0x2d, 0xd3, 0x5e, 0x26, 0xbe, 0x30, 0xff, 0x7f, 0x14,
-	0x49, 0x46, 0x5d, 0xd2, 0x91, 0x1a, 0x04, 0x65, 0x56, 0x8d, 0xd2, 0x66, 0xc4, 0x28, 0x8e, 0x99,
-	0x04, 0x13, 0xd6, 0xda, 0xc7, 0x74, 0x70, 0x46, 0x09, 0xf3, 0xef, 0x6d, 0xca, 0x93, 0x32, 0x36,
-	0xc8, 0xf3, 0x0d, 0xe8, 0x49, 0x71, 0x4d, 0x2e, 0x2d, 0x29, 0xde, 0x93, 0x42, 0x66, 0xae, 0x0e,
-	0x97, 0x85, 0x8c, 0x01, 0x9b, 0x91, 0x5c, 0x7b, 0xaa, 0xdd, 0xbb, 0xaf, 0x11, 0x1f, 0x56, 0x77,
-	0x69, 0xac, 0x94, 0xdf, 0xcb, 0xdf, 0xb3, 0x73, 0x73, 0x25, 0xb7, 0x71, 0x1f, 0xd9, 0xde, 0x23,
-	0x77, 0xd8, 0x95, 0xa7, 0xf8, 0x13, 0x5e, 0xb5, 0x9f, 0xe3, 0xc4, 0x32, 0x53, 0x58, 0x2f, 0x97,
-	0x79, 0x41, 0xc6, 0xe2, 0xcc, 0x06, 0xe3, 0x2b, 0x94, 0xbb, 0x41, 0xbe, 0x44, 0x53, 0xcf, 0xac,
-	0x9d, 0x20, 0x3b, 0xc0, 0x62, 0x30, 0x2d, 0xa9, 0x9b, 0x99, 0x0c, 0xab, 0x66, 0xa3, 0xc4, 0x2c,
-	0x72, 0xc1, 0x78, 0x80, 0x32, 0xff, 0x88, 0xdc, 0x4d, 0xd2, 0x2d, 0x4f, 0x3a, 0xbc, 0x0e, 0x5f,
-	0x24, 0xb0, 0x5f, 0xc4, 0xcf, 0xe3, 0x0f, 0x7f, 0x17, 0x00, 0x00, 0xff, 0xff, 0x18, 0xe7, 0x86,
-	0x7d, 0xed, 0x2b, 0x00, 0x00,
+	// 5784 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7b, 0xdd, 0x6f, 0x1b, 0x49,
+	0x72, 0xf8, 0x0e, 0x49, 0xf1, 0xa3, 0x48, 0x49, 0x74, 0xcb, 0x6b, 0xd3, 0x94, 0xed, 0xb5, 0x67,
+	0x77, 0x6d, 0xaf, 0x7f, 0x7b, 0xe2, 0x5a, 0xfb, 0x75, 0xde, 0xdb, 0xfb, 0xa0, 0x24, 0x5a, 0xab,
+	0x9f, 0x6d, 0x49, 0x37, 0xa2, 0x77, 0x6f, 0x7f, 0xf8, 0x05, 0x73, 0x23, 0xb2, 0x4d, 0x0d, 0x4c,
+	0xce, 0x30, 0x33, 0x43, 0x9b, 0x3a, 0x67, 0xf3, 0x90, 0x3c, 0x04, 0x48, 0x10, 0x24, 0x87, 0x0b,
+	0x70, 0x79, 0x08, 0x92, 0xf7, 0xfc, 0x03, 0x49, 0x9e, 0xf2, 0x7e, 0x79, 0x4b, 0x0e, 0xb8, 0xa7,
+	0xbb, 0x43, 0x90, 0x00, 0x79, 0xca, 0x43, 0x70, 0xcf, 0x01, 0x82, 0xaa, 0xee, 0x9e, 0x2f, 0x0e,
+	0x29, 0x6d, 0x76, 0xef, 0x89, 0xd3, 0xd5, 0xd5, 0x55, 0xdd, 0xd5, 0xd5, 0x55, 0xd5, 0xd5, 0x45,
+	0xa8, 0x7b, 0xe3, 0x5e, 0x6b, 0x7c, 0xdc, 0xf2, 0xc6, 0xbd, 0x8d, 0xb1, 0xe7, 0x06, 0x2e, 0x5b,
+	0xf2, 0xc6, 0xbd, 0xf1, 0x71, 0xf3, 0xea, 0xc0, 0x75, 0x07, 0x43, 0xde, 0xb2, 0xc6, 0x76, 0xcb,
+	0x72, 0x1c, 0x37, 0xb0, 0x02, 0xdb, 0x75, 0x7c, 0x81, 0xa4, 0xaf, 0x40, 0xad, 0x33, 0x1a, 0x07,
+	0xa7, 0x06, 0xff, 0xdd, 0x09, 0xf7, 0x03, 0xfd, 0x63, 0xa8, 0xee, 0xf3, 0xe0, 0x85, 0xeb, 0x3d,
+	0xdb, 0x73, 0x9e, 0xba, 0x6c, 0x05, 0x72, 0x76, 0xbf, 0xa1, 0xdd, 0xd0, 0xee, 0x54, 0x8c, 0x9c,
+	0xdd, 0x67, 0xd7, 0x00, 0xc6, 0x9c, 0x7b, 0x66, 0xcf, 0x9d, 0x38, 0x41, 0x23, 0x77, 0x43, 0xbb,
+	0xb3, 0x64, 0x54, 0x10, 0xb2, 0x8d, 0x00, 0xfd, 0x6f, 0x35, 0x58, 0x35, 0xda, 0x8f, 0x71, 0xa8,
+	0xc1, 0xfd, 0xb1, 0xeb, 0xf8, 0x9c, 0x5d, 0x81, 0xf2, 0xc4, 0xe7, 0x7d, 0xd3, 0xb3, 0x46, 0x44,
+	0x28, 0x6f, 0x94, 0xb0, 0x6d, 0x58, 0x23, 0xf6, 0x3a, 0x2c, 0x5b, 0xcf, 0x2d, 0x7b, 0x68, 0x1d,
+	0x0f, 0x39, 0xf5, 0xe7, 0xa8, 0xbf, 0x16, 0x02, 0x11, 0x69, 0x1d, 0x2a, 0x81, 0x1b, 0x58, 0x43,
+	0x42, 0xc8, 0x13, 0x42, 0x99, 0x00, 0xd8, 0x79, 0x0d, 0xc0, 0xe7, 0xc3, 0xa1, 0x39, 0xf6, 0xec,
+	0x1e, 0x6f, 0x14, 0x6e, 0x68, 0x77, 0x34, 0xa3, 0x82, 0x90, 0x43, 0x04, 0xe0, 0xd8, 0xe3, 0xc9,
+	0xa9, 0xec, 0x5d, 0xa2, 0xde, 0xf2, 0xf1, 0xe4, 0x94, 0x3a, 0xf5, 0x7f, 0xd6, 0xa0, 0xbe, 0xef,
+	0xf6, 0x79, 0x62, 0xb6, 0xd7, 0x00, 0x8e, 0x27, 0xf6, 0xb0, 0x6f, 0x06, 0xf6, 0x88, 0xcb, 0x85,
+	0x57, 0x08, 0xd2, 0xb5, 0x47, 0xb4, 0x98, 0x81, 0x1d, 0x98, 0x27, 0x96, 0x7f, 0x42, 0x93, 0xad,
+	0x18, 0xa5, 0x81, 0x1d, 0x7c, 0x62, 0xf9, 0x27, 0x8c, 0x41, 0x61, 0xe4, 0xf6, 0x39, 0x4d, 0xb1,
+	0x62, 0xd0, 0x37, 0x7b, 0x1b, 0x4a, 0x8e, 0x90, 0x26, 0xcd, 0xad, 0xba, 0xc9, 0x36, 0x68, 0x53,
+	0x36, 0x62, 0x32, 0x36, 0x14, 0x0a, 0xbb, 0x09, 0xb5, 0x9e, 0xdb, 0xe7, 0xe6, 0x73, 0xee, 0xf9,
+	0xb6, 0xeb, 0xd0, 0x84, 0x2b, 0x46, 0x15, 0x61, 0x9f, 0x0a, 0x10, 0x7b, 0x0d, 0xaa, 0x3e, 0xf7,
+	0x9e, 0x73, 0x4f, 0xcc, 0xaf, 0x48, 0xe2, 0x00, 0x01, 0xc2, 0x09, 0xea, 0xf7, 0xa1, 0xda, 0x1e,
+	0xe1, 0x5e, 0x3c, 0xb2, 0x47, 0x76, 0xc0, 0x2e, 0xc2, 0x52, 0xe0, 0x3e, 0xe3, 0x8e, 0x5c, 0x89,
+	0x68, 0x20, 0xf4, 0xb9, 0x35, 0x9c, 0x70, 0xb9, 0x04, 0xd1, 0xd0, 0x3f, 0x87, 0x62, 0xbb, 0x87,
+	0xba, 0xc1, 0x9a, 0x50, 0xee, 0xb9, 0x4e, 0xe0, 0x59, 0xbd, 0x40, 0x0e, 0x0c, 0xdb, 0x38, 0x03,
+	0x8b, 0xb0, 0x4c, 0xc7, 0x1a, 0x29, 0x0a, 0x20, 0x40, 0xfb, 0xd6, 0x88, 0xa3, 0x1c, 0xfa, 0x56,
+	0x60, 0x29, 0x39, 0xe0, 0xb7, 0xfe, 0xeb, 0x02, 0x54, 0xba, 0x53, 0x83, 0xf7, 0xb8, 0x3d, 0x0e,
+	0xd8, 0x65, 0x28, 0x05, 0x53, 0x21, 0x43, 0x41, 0xbd, 0x18, 0x4c, 0x49, 0x84, 0xeb, 0x50, 0x19,
+	0x58, 0xbe, 0x39, 0xf1, 0xad, 0x81, 0xa0, 0xac, 0x19, 0xe5, 0x81, 0xe5, 0x3f, 0xc1, 0x36, 0xfb,
+	0x16, 0x54, 0x3c, 0x6b, 0x24, 0x3b, 0xf3, 0x37, 0xf2, 0x77, 0xaa, 0x9b, 0xd7, 0xa5, 0x34, 0x43,
+	0xd2, 0x1b, 0x86, 0x35, 0x22, 0xec, 0x8e, 0x13, 0x78, 0xa7, 0x46, 0xd9, 0x93, 0x4d, 0xf6, 0x31,
+	0x54, 0xfd, 0xc0, 0x0a, 0x26, 0xbe, 0x89, 0xd2, 0xa4, 0xcd, 0x58, 0xd9, 0x5c, 0x9f, 0x19, 0x7e,
+	0x44, 0x38, 0xdb, 0x6e, 0x9f, 0x1b, 0xe0, 0x87, 0xdf, 0xac, 0x01, 0xa5, 0x11, 0xf7, 0x89, 0xb1,
+	0xd8, 0x13, 0xd5, 0xc4, 0x1e, 0x8f, 0x07, 0x13, 0xcf, 0xf1, 0x1b, 0xc5, 0x1b, 0x79, 0xec, 0x91,
+	0x4d, 0xf6, 0x1e, 0x94, 0x3d, 0x41, 0xd5, 0x6f, 0x94, 0x68, 0xb6, 0x8d, 0xd9, 0xd9, 0x8a, 0x5f,
+	0x23, 0xc4, 0x6c, 0x7e, 0x0b, 0x96, 0x13, 0x4b, 0x60, 0x75, 0xc8, 0x3f, 0xe3, 0xa7, 0x52, 0x4e,
+	0xf8, 0x99, 0xdc, 0xbc, 0xbc, 0xdc, 0xbc, 0x8f, 0x72, 0xdf, 0xd4, 0x9a, 0xdf, 0x83, 0x92, 0x12,
+	0xf1, 0x3a, 0x54, 0x9e, 0x4e, 0x9c, 0x9e, 0xd8, 0x23, 0xb9, 0x85, 0x08, 0xa0, 0x1d, 0x6a, 0x40,
+	0x09, 0xb7, 0x93, 0xcb, 0x13, 0x5c, 0x31, 0x54, 0x53, 0xff, 0x3b, 0x0d, 0x20, 0x92, 0x01, 0xab,
+	0x42, 0xe9, 0xe8, 0xc9, 0xf6, 0x76, 0xe7, 0xe8, 0xa8, 0xfe, 0x0a, 0x5b, 0x85, 0xea, 0x6e, 0xfb,
+	0xc8, 0x34, 0x9e, 0xec, 0x9b, 0x07, 0x4f, 0xba, 0x75, 0x8d, 0x5d, 0x02, 0xb6, 0xd5, 0x7e, 0xd4,
+	0xde, 0xdf, 0xee, 0x98, 0xfb, 0x07, 0x5d, 0xb3, 0xb3, 0x7f, 0xf0, 0x64, 0xf7, 0x93, 0x7a, 0x8e,
+	0xad, 0xc1, 0xea, 0x67, 0xc6, 0xc1, 0xfe, 0xae, 0x79, 0xd8, 0x36, 0xda, 0x8f, 0x3b, 0xdd, 0x8e,
+	0x51, 0xcf, 0xb3, 0x0b, 0xb0, 0x6c, 0x3c, 0xd9, 0xef, 0xee, 0x3d, 0xee, 0x98, 0x1d, 0xc3, 0x38,
+	0x30, 0xea, 0x05, 0xa4, 0x8e, 0x6d, 0x24, 0xb6, 0x14, 0x0d, 0xea, 0xfe, 0xc0, 0x7c, 0x70, 0x60,
+	0x3c, 0x6e, 0x77, 0xeb, 0x45, 0xe4, 0xb0, 0xf3, 0xe4, 0xf0, 0xd1, 0xde, 0x76, 0xbb, 0xdb, 0x31,
+	0x8f, 0x3a, 0x5d, 0x73, 0xfb, 0x60, 0xa7, 0x53, 0x2f, 0x21, 0xb1, 0x27, 0xfb, 0x0f, 0xf7, 0x0f,
+	0x3e, 0xdb, 0x97, 0xc4, 0xca, 0xfa, 0x3f, 0xe5, 0xa1, 0xda, 0xf5, 0x2c, 0xc7, 0x17, 0x9a, 0x88,
+	0x5a, 0x18, 0x53, 0x30, 0xfa, 0x46, 0x18, 0x9d, 0x1a, 0x21, 0x38, 0xfa, 0x66, 0xd7, 0x01, 0xf8,
+	0x74, 0x6c, 0x7b, 0x64, 0x14, 0xa5, 0x79, 0x89, 0x41, 0x94, 0x4a, 0x52, 0xab, 0x51, 0x08, 0x55,
+	0xd2, 0xc0, 0xb6, 0xea, 0x1c, 0xe2, 0x51, 0x53, 0xe6, 0x65, 0x60, 0xf9, 0xe1, 0xd1, 0xeb, 0xf3,
+	0xa1, 0x75, 0x2a, 0x0f, 0xa9, 0x68, 0xa0, 0x01, 0xe9, 0x9d, 0x58, 0xb6, 0x63, 0xda, 0xfd, 0x46,
+	0xe9, 0x86, 0x76, 0x67, 0xd9, 0x28, 0x51, 0x7b, 0xaf, 0xcf, 0x6e, 0x43, 0x49, 0x4c, 0xde, 0x6f,
+	0x94, 0x49, 0x61, 0x96, 0xa5, 0xc2, 0x88, 0x53, 0x69, 0xa8, 0x5e, 0xdc, 0x3f, 0xdf, 0x1e, 0x38,
+	0xdc, 0xf3, 0x1b, 0x15, 0xa1, 0x74, 0xb2, 0xc9, 0xae, 0x42, 0x65, 0x3c, 0x39, 0x1e, 0xda, 0xfe,
+	0x09, 0xf7, 0x1a, 0x20, 0x8c, 0x57, 0x08, 0xc0, 0xa3, 0xeb, 0xf1, 0xa7, 0xdc, 0xf3, 0x78, 0xdf,
+	0x0c, 0xa6, 0x8d, 0xaa, 0x38, 0xba, 0x0a, 0xd4, 0x9d, 0xb2, 0xf7, 0xa1, 0x66, 0x91, 0xf1, 0x90,
+	0x4b, 0xaa, 0xdd, 0xc8, 0xc7, 0x6c, 0x56, 0xcc, 0xae, 0x18, 0x55, 0x2b, 0x6a, 0xb0, 0x16, 0x40,
+	0x30, 0x35, 0xa5, 0x0e, 0x37, 0x96, 0xc9, 0xd0, 0xd5, 0xd3, 0xca, 0x6e, 0x54, 0x02, 0xf5, 0x29,
+	0x26, 0x32, 0x1e, 0x5a, 0x3d, 0x31, 0x91, 0x15, 0x35, 0x11, 0x01, 0xea, 0x4e, 0xf5, 0x5f, 0x69,
+	0xb0, 0x16, 0xdb, 0xcd, 0xd0, 0x3a, 0xdf, 0x87, 0xa2, 0x38, 0x96, 0xb4, 0xaf, 0x2b, 0x9b, 0x37,
+	0x15, 0x97, 0x59, 0x5c, 0x79, 0x96, 0x0d, 0x39, 0x80, 0xbd, 0x07, 0xd5, 0x20, 0xc2, 0x22, 0x1d,
+	0x88, 0x96, 0x16, 0x1f, 0x1f, 0x47, 0x43, 0x93, 0x7c, 0x3c, 0x74, 0x7b, 0xcf, 0x4c, 0x67, 0x32,
+	0x3a, 0xe6, 0x9e, 0x54, 0x90, 0x2a, 0xc1, 0xf6, 0x09, 0xa4, 0xbf, 0x0b, 0x45, 0xc1, 0x0a, 0x15,
+	0xfa, 0xb0, 0xb3, 0xbf, 0xb3, 0xb7, 0xbf, 0x5b, 0x7f, 0x85, 0x01, 0x14, 0x0f, 0xdb, 0xdb, 0x0f,
+	0x3b, 0x3b, 0x75, 0x8d, 0xd5, 0xa1, 0xb6, 0x67, 0x18, 0x9d, 0x4f, 0x3b, 0xc6, 0xd1, 0xde, 0xd6,
+	0xa3, 0x4e, 0x3d, 0xa7, 0xff, 0x52, 0x83, 0x52, 0x77, 0xda, 0x79, 0xce, 0x9d, 0x80, 0xdd, 0x86,
+	0x42, 0x70, 0x3a, 0xe6, 0x72, 0x49, 0x6b, 0xa1, 0xe0, 0xa8, 0x77, 0xa3, 0x7b, 0x3a, 0xe6, 0x06,
+	0x21, 0x64, 0xea, 0x6f, 0xcc, 0x34, 0xe5, 0x13, 0xa6, 0x49, 0x7f, 0x09, 0x05, 0x1c, 0x3b, 0x7f,
+	0x56, 0x00, 0xc5, 0x07, 0x07, 0x06, 0x7e, 0xe7, 0x10, 0xa9, 0xf3, 0x83, 0xc3, 0x3d, 0xa3, 0xb3,
+	0x53, 0xcf, 0xb3, 0x65, 0xa8, 0x84, 0xc7, 0xae, 0x5e, 0x20, 0xbc, 0xf6, 0xde, 0xa3, 0xce, 0x4e,
+	0x7d, 0x09, 0xf1, 0x76, 0x3a, 0x8f, 0x3a, 0xdd, 0xce, 0x4e, 0xbd, 0x48, 0x0d, 0xe3, 0xe0, 0xf0,
+	0xb0, 0xb3, 0x53, 0x2f, 0xb1, 0x1a, 0x94, 0x8d, 0xce, 0xe1, 0xa3, 0xf6, 0x76, 0x67, 0xa7, 0x5e,
+	0xd6, 0x7f, 0xa1, 0x41, 0xbd, 0x3b, 0x95, 0x5b, 0xa0, 0x76, 0xef, 0x83, 0xd4, 0xee, 0x45, 0xe6,
+	0x3b, 0x89, 0x98, 0xde, 0xba, 0xf4, 0x26, 0xe4, 0x66, 0x36, 0x81, 0xdd, 0x82, 0x22, 0x47, 0x71,
+	0xf9, 0xd2, 0x33, 0xac, 0x24, 0xa5, 0x68, 0xc8, 0x5e, 0xfd, 0x7b, 0xff, 0x8b, 0xcd, 0x42, 0x54,
+	0xa3, 0xf3, 0xf8, 0xe0, 0x53, 0x14, 0x8e, 0xfe, 0xfb, 0x70, 0x71, 0x97, 0x07, 0x87, 0xdc, 0xe9,
+	0xdb, 0xce, 0xa0, 0x3b, 0xf5, 0x65, 0xe0, 0x94, 0x3c, 0x7a, 0x5a, 0xfa, 0xe8, 0xc5, 0x3d, 0x6a,
+	0x2e, 0xe5, 0x51, 0x2f, 0x41, 0xb1, 0x37, 0xf1, 0x7c, 0xd7, 0x93, 0x3b, 0x28, 0x5b, 0x68, 0x40,
+	0xc4, 0x31, 0x2c, 0x50, 0x98, 0x25, 0x1a, 0xfa, 0x17, 0xf0, 0x6a, 0x8a, 0x7f, 0x28, 0xdd, 0x5a,
+	0x4c, 0x73, 0x51, 0xc6, 0xf9, 0x39, 0x1a, 0x9e, 0xc0, 0x8b, 0xb1, 0xcf, 0xa5, 0xd9, 0x53, 0x98,
+	0x25, 0x75, 0x5e, 0x34, 0xf4, 0xff, 0xc8, 0xc1, 0x5a, 0x77, 0x7a, 0xe8, 0xba, 0x43, 0x94, 0x63,
+	0xc4, 0x9d, 0x41, 0xc1, 0xb7, 0x7f, 0xc4, 0x65, 0x84, 0x47, 0xdf, 0xec, 0x36, 0xac, 0x86, 0x12,
+	0x88, 0x45, 0x8c, 0x79, 0x63, 0x25, 0x04, 0x53, 0xd8, 0xc8, 0x3e, 0x87, 0xb5, 0xd0, 0xc8, 0x9a,
+	0x27, 0xb6, 0x1f, 0xb8, 0x03, 0x11, 0xec, 0xe1, 0x0a, 0xde, 0x0a, 0xb7, 0x72, 0x86, 0xeb, 0xc6,
+	0xae, 0xb4, 0xc4, 0x5b, 0x93, 0xde, 0x33, 0x1e, 0x18, 0x17, 0x94, 0x65, 0xfe, 0x44, 0xd1, 0x60,
+	0x6f, 0xc0, 0x8a, 0x3b, 0xec, 0x73, 0x3f, 0x30, 0x55, 0xc8, 0x51, 0xa0, 0x55, 0xd6, 0x04, 0xb4,
+	0x2b, 0x02, 0x0f, 0x1d, 0x96, 0x23, 0x2c, 0xe5, 0xe6, 0xf3, 0x46, 0x55, 0x21, 0xb5, 0x07, 0xbc,
+	0xe9, 0xc0, 0x4a, 0x92, 0x1d, 0x8e, 0x1a, 0xd9, 0x8e, 0x19, 0xf9, 0x07, 0x8d, 0x5c, 0x40, 0x75,
+	0x64, 0x3b, 0x0a, 0x93, 0x70, 0xac, 0x69, 0x0c, 0x27, 0x27, 0x71, 0xac, 0x69, 0x88, 0x73, 0x11,
+	0x96, 0x84, 0x74, 0xa4, 0xa4, 0xa9, 0xa1, 0xff, 0x5c, 0x83, 0xcb, 0xe1, 0x36, 0xa7, 0x4e, 0x52,
+	0xca, 0x98, 0x69, 0xe7, 0x33, 0x66, 0x0c, 0x0a, 0x9e, 0xe5, 0x3c, 0x53, 0xf6, 0x03, 0xbf, 0x65,
+	0x34, 0x61, 0xf7, 0x89, 0x77, 0xd9, 0x10, 0x0d, 0x84, 0x72, 0xcf, 0x73, 0x3d, 0x29, 0x2c, 0xd1,
+	0x40, 0x77, 0x47, 0x9e, 0x91, 0x9b, 0xb6, 0x23, 0x25, 0x54, 0x16, 0x80, 0x3d, 0x27, 0x76, 0x02,
+	0x8b, 0x0b, 0x4f, 0xe0, 0xdf, 0x6b, 0x50, 0x39, 0xb2, 0x07, 0x8e, 0x15, 0x4c, 0x3c, 0xce, 0xbe,
+	0x09, 0x15, 0x6b, 0x38, 0x70, 0x3d, 0x3b, 0x38, 0x19, 0x49, 0xab, 0xd0, 0x94, 0x03, 0x43, 0xa4,
+	0x8d, 0xb6, 0xc2, 0x30, 0x22, 0x64, 0x3c, 0x6f, 0xbe, 0xc2, 0xa0, 0x15, 0xd5, 0x8c, 0x08, 0x40,
+	0xf7, 0x14, 0xd4, 0xb1, 0x9e, 0x89, 0xd1, 0x53, 0x5e, 0x74, 0x0b, 0xc8, 0x43, 0x7e, 0xaa, 0xbf,
+	0x07, 0x95, 0x90, 0x28, 0x1e, 0x6f, 0x19, 0x4d, 0xd4, 0x5f, 0x41, 0xdb, 0x77, 0xd4, 0xd9, 0x3e,
+	0xdc, 0x7c, 0xff, 0x83, 0x87, 0xf7, 0xea, 0x1a, 0xd9, 0xc5, 0x9d, 0xcd, 0xf7, 0xdf, 0xbf, 0x77,
+	0xbf, 0x9e, 0xd3, 0x7f, 0x91, 0x07, 0x96, 0xf0, 0x34, 0xe2, 0xe4, 0x2b, 0xb3, 0xac, 0xcd, 0x0d,
+	0x2b, 0x72, 0x8b, 0xc3, 0x8a, 0xfc, 0xa2, 0xb0, 0xa2, 0x30, 0x2f, 0xac, 0x58, 0x9a, 0x17, 0x56,
+	0x14, 0xe7, 0x86, 0x15, 0xa5, 0x85, 0x61, 0x45, 0xda, 0xfb, 0x97, 0xcf, 0xe7, 0xfd, 0xe7, 0x47,
+	0x23, 0xef, 0x00, 0x84, 0x3b, 0xe2, 0x37, 0xe0, 0x46, 0x3e, 0x16, 0x17, 0x84, 0xbb, 0x6b, 0xc4,
+	0x70, 0x92, 0x46, 0xb4, 0x9a, 0x36, 0xa2, 0x1f, 0x42, 0x64, 0x38, 0x4c, 0xdf, 0x1e, 0xf8, 0x8d,
+	0xda, 0x1c, 0x9a, 0xcb, 0x21, 0xde, 0x91, 0x3d, 0xf0, 0xd3, 0xf1, 0xc6, 0xf2, 0x4c, 0xbc, 0xf1,
+	0x6f, 0x79, 0x58, 0xda, 0x42, 0x77, 0x92, 0x19, 0x37, 0x36, 0xa0, 0xa4, 0xae, 0x64, 0x62, 0x27,
+	0x55, 0x13, 0x09, 0x8f, 0x2d, 0x8f, 0x3b, 0xf2, 0x46, 0x28, 0xec, 0x37, 0x08, 0x10, 0x19, 0x96,
+	0x37, 0x60, 0x25, 0x98, 0x9a, 0x23, 0xee, 0x3d, 0x1b, 0xf2, 0x84, 0xf9, 0x09, 0xa6, 0x8f, 0x09,
+	0x48, 0x58, 0xef, 0xc2, 0xa5, 0x28, 0x80, 0x4a, 0x60, 0x8b, 0xeb, 0xc6, 0x5a, 0x18, 0x3a, 0xc5,
+	0x06, 0x5d, 0x82, 0xa2, 0xf4, 0x87, 0x22, 0xc0, 0x94, 0x2d, 0x9c, 0xed, 0x0b, 0x3b, 0x70, 0xb8,
+	0xef, 0x53, 0x80, 0x59, 0x31, 0x54, 0x33, 0x54, 0xd4, 0x72, 0x4c, 0x51, 0x13, 0x57, 0xae, 0x4a,
+	0xea, 0xca, 0x75, 0x05, 0xca, 0xc1, 0x54, 0x5a, 0x6e, 0x10, 0x2b, 0x0f, 0xa6, 0xc2, 0x64, 0xbf,
+	0x09, 0x05, 0xdb, 0x79, 0xea, 0xd2, 0x26, 0x55, 0x37, 0x2f, 0xc8, 0x1d, 0x20, 0x19, 0x6e, 0xd0,
+	0xad, 0x96, 0xba, 0x67, 0x9c, 0x52, 0xed, 0x7c, 0x4e, 0xa9, 0x79, 0x04, 0x05, 0xa4, 0x12, 0x5e,
+	0xaa, 0x35, 0x72, 0x81, 0xf4, 0x8d, 0x0b, 0x0f, 0x4e, 0x3c, 0x6e, 0xf5, 0x65, 0xfe, 0x41, 0xb6,
+	0x70, 0x33, 0x8e, 0xad, 0xa0, 0x77, 0x62, 0xda, 0x4e, 0x9f, 0x4f, 0xc9, 0x7b, 0x2c, 0x19, 0x40,
+	0xa0, 0x3d, 0x84, 0xe8, 0x3f, 0xd6, 0x60, 0x99, 0x66, 0x18, 0xda, 0xd1, 0x77, 0x53, 0x11, 0xc9,
+	0x7a, 0x7c, 0x1d, 0xf3, 0xc2, 0x11, 0x1d, 0x96, 0x28, 0xf4, 0x90, 0x31, 0x64, 0x2d, 0x31, 0x46,
+	0x74, 0xe9, 0xb7, 0xb3, 0xe3, 0x8c, 0x74, 0x6c, 0xa1, 0xe9, 0xff, 0x95, 0x87, 0x0b, 0xdb, 0x74,
+	0x52, 0x53, 0x39, 0x13, 0x87, 0x07, 0xf1, 0xdb, 0x1b, 0x26, 0x09, 0xe8, 0xf2, 0xf6, 0x16, 0xd4,
+	0x29, 0x73, 0xd3, 0x73, 0x87, 0x66, 0x5c, 0x2b, 0x2b, 0xc6, 0xaa, 0x82, 0xab, 0x64, 0x41, 0xdc,
+	0x28, 0xe4, 0x93, 0x46, 0xe1, 0x1a, 0xc0, 0x09, 0xb7, 0xfa, 0xa6, 0x58, 0x48, 0x81, 0xf6, 0xb6,
+	0x82, 0x10, 0x71, 0x0a, 0x6e, 0xc1, 0x6a, 0xd4, 0x1d, 0xd7, 0xc4, 0xe5, 0x10, 0x47, 0x5d, 0xd8,
+	0x87, 0xf6, 0xb1, 0xa4, 0x22, 0xd4, 0xb0, 0x3c, 0xb4, 0x8f, 0x05, 0x91, 0x37, 0x60, 0x25, 0xec,
+	0x14, 0x34, 0x84, 0x3e, 0xd6, 0x14, 0x06, 0x91, 0xb8, 0x09, 0x35, 0xa9, 0x9f, 0xe6, 0xd0, 0xf6,
+	0x85, 0xd5, 0xa9, 0x18, 0x55, 0x09, 0x7b, 0x64, 0xfb, 0x01, 0xbb, 0x03, 0x75, 0x24, 0x94, 0x40,
+	0x13, 0xa6, 0x06, 0x19, 0x7c, 0x16, 0xc3, 0x7c, 0x07, 0x2e, 0x8e, 0x85, 0xcb, 0x4c, 0x62, 0x03,
+	0x61, 0x33, 0xd9, 0x17, 0x1f, 0x91, 0x5c, 0x29, 0x1d, 0x8f, 0x2a, 0xad, 0x23, 0x5a, 0x29, 0x25,
+	0x7e, 0x12, 0x8b, 0x21, 0xb4, 0x9a, 0xc8, 0x55, 0xa9, 0xc5, 0xc4, 0xb1, 0x50, 0x51, 0xb8, 0xe9,
+	0xb9, 0x6e, 0x20, 0x6d, 0x0d, 0x62, 0xa1, 0x3e, 0x70, 0xc3, 0x75, 0x03, 0xfd, 0x75, 0x58, 0x16,
+	0x71, 0x47, 0xcc, 0x83, 0xa4, 0x8d, 0x8e, 0xbe, 0x4b, 0x71, 0x1e, 0x91, 0xde, 0x3a, 0x3d, 0x03,
+	0x59, 0x84, 0x97, 0xa3, 0xf1, 0x90, 0x07, 0xc2, 0x17, 0x96, 0x8d, 0xb0, 0xad, 0x3f, 0x86, 0xcb,
+	0x11, 0x21, 0x11, 0x2e, 0x2b, 0x52, 0x91, 0x09, 0xd1, 0x12, 0x26, 0x64, 0x11, 0xb9, 0x17, 0x11,
+	0x39, 0x7f, 0xeb, 0xd4, 0xb0, 0x9c, 0x01, 0x57, 0xe4, 0x6e, 0x42, 0xcd, 0x0f, 0x2c, 0x2f, 0x30,
+	0x13, 0x44, 0xab, 0x04, 0x13, 0x8c, 0x51, 0xef, 0xb8, 0xd3, 0x4f, 0x06, 0xf2, 0x15, 0xee, 0xf4,
+	0xf7, 0x67, 0x19, 0xe7, 0x53, 0x8c, 0xdf, 0x82, 0x55, 0x21, 0x35, 0xee, 0xc7, 0xe6, 0x7f, 0x42,
+	0x00, 0x0a, 0x76, 0x2b, 0x86, 0x6c, 0xe9, 0x26, 0xb0, 0xf0, 0xde, 0x19, 0x05, 0x4d, 0x6f, 0xc7,
+	0x32, 0x32, 0x5a, 0xc2, 0x71, 0x74, 0xa7, 0x33, 0x99, 0x18, 0x54, 0x6d, 0xc7, 0x0d, 0xcc, 0xa7,
+	0xee, 0xc4, 0x41, 0x43, 0x83, 0xe4, 0xcb, 0x8e, 0x1b, 0x3c, 0xc0, 0xb6, 0x3e, 0x82, 0x57, 0xdb,
+	0x3d, 0xb2, 0x8b, 0x14, 0x69, 0x7a, 0xa7, 0xb1, 0xcd, 0x89, 0x1d, 0x5a, 0xfa, 0x9e, 0x11, 0x4b,
+	0xee, 0x2c, 0xb1, 0xe4, 0x53, 0x62, 0xd1, 0x7f, 0x9e, 0x83, 0x35, 0xc9, 0x4f, 0x1c, 0x1c, 0xc1,
+	0x74, 0xe6, 0x62, 0xa4, 0xcd, 0x5e, 0x8c, 0xb2, 0xee, 0x8c, 0x71, 0xb3, 0x9e, 0x27, 0x13, 0x1a,
+	0x9a, 0xf5, 0x84, 0x3b, 0x28, 0xa4, 0xdc, 0xc1, 0x7a, 0x3c, 0x03, 0x27, 0xe3, 0xbf, 0x30, 0xc3,
+	0x76, 0x0d, 0x00, 0x3b, 0x8f, 0xdd, 0xc9, 0xe0, 0x24, 0x90, 0xb6, 0x00, 0xd1, 0xb7, 0x08, 0xc0,
+	0xbe, 0x0f, 0xcb, 0x94, 0x7b, 0x34, 0x7b, 0x27, 0xa8, 0x32, 0x2a, 0x16, 0x79, 0x3b, 0x8c, 0x45,
+	0x66, 0x56, 0xb7, 0xd1, 0x45, 0xfc, 0x6d, 0x81, 0x2e, 0xf2, 0x79, 0xb5, 0x20, 0x06, 0x6a, 0x7e,
+	0x17, 0x2e, 0xcc, 0xa0, 0x9c, 0x95, 0x2f, 0xd3, 0x62, 0xf9, 0x32, 0x7d, 0x04, 0x97, 0xd2, 0xbb,
+	0x28, 0x55, 0x65, 0x13, 0x8a, 0x24, 0x44, 0xa5, 0x28, 0xcd, 0xf9, 0xd3, 0x34, 0x24, 0x26, 0xba,
+	0x1f, 0x87, 0x4f, 0x53, 0xbb, 0x0c, 0x08, 0x92, 0xbb, 0xf8, 0xb3, 0x3c, 0x5c, 0x94, 0x04, 0x48,
+	0x64, 0x21, 0xb7, 0xd4, 0x48, 0x2d, 0x3d, 0x92, 0xb2, 0xdc, 0xa4, 0x41, 0xb1, 0xad, 0xac, 0x10,
+	0x44, 0x25, 0xa5, 0x51, 0x7b, 0xa8, 0x53, 0xe8, 0x4e, 0x89, 0x3b, 0x22, 0x5f, 0x8d, 0x3e, 0x91,
+	0x34, 0x44, 0xec, 0xb6, 0xb8, 0x49, 0x02, 0x81, 0xc4, 0x86, 0xc7, 0x75, 0x61, 0x69, 0x81, 0x2e,
+	0x14, 0x53, 0xba, 0xf0, 0x0d, 0x58, 0xc3, 0x7b, 0x8d, 0x20, 0x1e, 0xa1, 0x95, 0x08, 0xad, 0x3e,
+	0xb2, 0xa6, 0x24, 0xa3, 0xdd, 0x4c, 0xd5, 0x29, 0x2f, 0x54, 0x9d, 0x4a, 0x5a, 0x75, 0x8c, 0xb4,
+	0xea, 0x88, 0x48, 0xf2, 0x1b, 0xc9, 0x3d, 0x49, 0x88, 0xf4, 0xb7, 0xaf, 0x3b, 0xdf, 0x82, 0xe5,
+	0x07, 0x9e, 0xfb, 0x23, 0xee, 0x6c, 0x59, 0x43, 0xcb, 0xe9, 0x51, 0x54, 0x22, 0xa2, 0x62, 0x79,
+	0x0b, 0x94, 0xad, 0xac, 0x03, 0xa8, 0xff, 0x0e, 0x94, 0x3f, 0x75, 0x03, 0x7a, 0x78, 0xc0, 0x71,
+	0xee, 0x38, 0xbc, 0xc5, 0x55, 0x0c, 0xd9, 0x22, 0xd6, 0x6e, 0xc0, 0xfd, 0x90, 0x35, 0x36, 0xf0,
+	0xc5, 0xa4, 0x37, 0xe4, 0x16, 0x66, 0xf0, 0x44, 0xaf, 0xb8, 0x3b, 0xd4, 0x24, 0x10, 0xa9, 0xfa,
+	0xfa, 0x0f, 0xa1, 0x89, 0x29, 0x02, 0xcf, 0xed, 0x4f, 0x7a, 0xdc, 0x53, 0x9c, 0x94, 0x89, 0x6a,
+	0xe0, 0x7d, 0xa0, 0x17, 0xce, 0xb4, 0x62, 0xa8, 0x26, 0xfa, 0xd9, 0xe3, 0x53, 0x73, 0xe8, 0xa2,
+	0x44, 0x02, 0x93, 0x42, 0x05, 0x69, 0xfe, 0x57, 0x8e, 0x4f, 0x1f, 0x09, 0x30, 0xc5, 0x2a, 0x98,
+	0xde, 0x59, 0xcf, 0x64, 0x21, 0x35, 0xfa, 0x12, 0x14, 0xc7, 0x93, 0xe3, 0x48, 0x98, 0xb2, 0x85,
+	0x12, 0x1e, 0xba, 0x3d, 0x19, 0xaf, 0xe0, 0x27, 0x42, 0x26, 0xde, 0x50, 0x46, 0xce, 0xf8, 0xc9,
+	0x5e, 0x85, 0x22, 0xc6, 0x3e, 0x76, 0x5f, 0x5d, 0x3e, 0x1d, 0x1e, 0xec, 0x51, 0x74, 0x67, 0xfb,
+	0xe6, 0x58, 0x72, 0x24, 0x5d, 0x2d, 0x1b, 0x60, 0xfb, 0x6a, 0x0e, 0xc8, 0x53, 0xc6, 0x72, 0x45,
+	0xc1, 0x53, 0xb4, 0x10, 0xee, 0x3a, 0x43, 0xdb, 0x11, 0xca, 0x59, 0x36, 0x64, 0x2b, 0x12, 0x70,
+	0x39, 0x26, 0x60, 0xfd, 0x29, 0xd4, 0xd5, 0xbd, 0x3c, 0x5c, 0x0d, 0xc6, 0x1f, 0xee, 0x0b, 0x94,
+	0x49, 0xfa, 0xaa, 0xbf, 0x22, 0xe0, 0x6a, 0x04, 0x62, 0x8e, 0x78, 0xdf, 0xb6, 0x9c, 0x99, 0x0b,
+	0xff, 0x8a, 0x80, 0x2b, 0x4c, 0xfd, 0xbf, 0x2b, 0x50, 0x92, 0x9a, 0x9b, 0xe9, 0x34, 0x1a, 0x50,
+	0x3a, 0x16, 0x9a, 0x25, 0x09, 0xa8, 0x26, 0xbb, 0x07, 0x78, 0x0a, 0x4d, 0x8a, 0xbe, 0xf3, 0x14,
+	0x81, 0x5e, 0x4a, 0x9e, 0x04, 0xcc, 0x8a, 0x88, 0x87, 0xa5, 0x81, 0xf8, 0xc0, 0x21, 0x78, 0xc0,
+	0x68, 0x48, 0x21, 0x73, 0x88, 0x7a, 0xb4, 0x2b, 0x79, 0xd6, 0x88, 0x86, 0xb4, 0xa1, 0x3a, 0xe6,
+	0xde, 0xc8, 0xf6, 0x7d, 0x8a, 0xdb, 0x97, 0xe8, 0xc8, 0xbd, 0x96, 0x1a, 0x75, 0x18, 0x61, 0x88,
+	0x43, 0x16, 0x1f, 0x83, 0x46, 0x74, 0xe0, 0xb9, 0x93, 0xb1, 0xca, 0x08, 0x34, 0xd3, 0xd3, 0xa4,
+	0x4e, 0x31, 0x50, 0x62, 0xb2, 0x6f, 0xc3, 0xea, 0x53, 0x3a, 0x56, 0xa6, 0x5c, 0xae, 0x72, 0x14,
+	0x17, 0xe5, 0xe0, 0xc4, 0xa1, 0x33, 0x56, 0x9e, 0xc6, 0x9b, 0x3e, 0xdb, 0x00, 0xc0, 0x6d, 0xa4,
+	0x95, 0xaa, 0x2c, 0xfa, 0xaa, 0x1c, 0x19, 0x2a, 0x69, 0xe5, 0xb9, 0xfc, 0xf2, 0x9b, 0xdf, 0x01,
+	0x38, 0x1c, 0xf2, 0xfe, 0x80, 0x9a, 0x28, 0xf3, 0x31, 0xb5, 0x54, 0x02, 0x4f, 0x35, 0x63, 0x87,
+	0x3b, 0x17, 0x3f, 0xdc, 0xcd, 0xdf, 0x68, 0x50, 0x92, 0xd2, 0xa6, 0xa3, 0x39, 0xf1, 0xe8, 0x32,
+	0x28, 0xf2, 0x66, 0x9a, 0x3c, 0x9a, 0x02, 0xd8, 0x45, 0x18, 0x46, 0xef, 0x74, 0xcf, 0x79, 0xca,
+	0x3d, 0x7a, 0xf4, 0x1c, 0x58, 0xea, 0x80, 0xaf, 0xc6, 0xe1, 0xbb, 0x96, 0x4f, 0x29, 0x0c, 0x62,
+	0x4f, 0x48, 0xe2, 0x9c, 0x57, 0x04, 0x04, 0xbb, 0xdf, 0x84, 0x15, 0xdb, 0xe9, 0x79, 0xdc, 0xf2,
+	0xb9, 0xe9, 0x8f, 0x39, 0xef, 0x4b, 0x77, 0xbd, 0xac, 0xa0, 0x47, 0x08, 0x8c, 0x92, 0x88, 0xe2,
+	0x79, 0x42, 0x34, 0xd8, 0xc7, 0x50, 0x13, 0x94, 0xfa, 0x42, 0x29, 0xc4, 0x06, 0x5d, 0x49, 0x6f,
+	0x6f, 0x28, 0x1a, 0xa3, 0x2a, 0xd1, 0xb1, 0xd1, 0xfc, 0x3e, 0x94, 0xa4, 0xbe, 0xe0, 0x85, 0x3d,
+	0x7c, 0xac, 0x95, 0x8e, 0x2b, 0x02, 0xa0, 0x62, 0xe3, 0x53, 0xaf, 0xb2, 0x7d, 0x13, 0x5f, 0x4c,
+	0x68, 0x36, 0xad, 0xd8, 0x74, 0xa0, 0xb0, 0x17, 0xf0, 0xd1, 0xcc, 0x7b, 0xf3, 0x75, 0x3a, 0xf5,
+	0xcf, 0xf8, 0xa9, 0x39, 0xb6, 0x6c, 0x4f, 0x5a, 0xa3, 0x8a, 0xed, 0x3f, 0xe4, 0xa7, 0x87, 0x96,
+	0x4d, 0x1b, 0xf3, 0x82, 0xdb, 0xe8, 0x36, 0x04, 0x39, 0xd9, 0xc2, 0xfc, 0x4b, 0xa4, 0x8a, 0xd2,
+	0x90, 0xc4, 0x20, 0xcd, 0x07, 0xb0, 0x44, 0xea, 0x97, 0x79, 0xf6, 0xde, 0x82, 0x25, 0x3b, 0xe0,
+	0x23, 0x9f, 0xc2, 0xbe, 0xea, 0xe6, 0x5a, 0x4a, 0x2c, 0x38, 0x51, 0x43, 0x60, 0x34, 0xff, 0x58,
+	0x03, 0x88, 0x4e, 0x41, 0x26, 0xb5, 0xd7, 0xa0, 0x4a, 0xca, 0x4d, 0xb7, 0x39, 0x5f, 0x86, 0x92,
+	0x40, 0x20, 0xbc, 0xd0, 0xf9, 0x11, 0xbb, 0xfc, 0x59, 0xec, 0x50, 0xdc, 0x78, 0xd9, 0xf5, 0x4f,
+	0xdc, 0x61, 0x5f, 0xdd, 0xda, 0x42, 0x40, 0xf3, 0x73, 0xa8, 0xa7, 0x4f, 0x64, 0x86, 0x4f, 0x6b,
+	0xc5, 0x7d, 0x5a, 0xc6, 0xa6, 0x87, 0x14, 0xe2, 0x4f, 0x8b, 0x07, 0x50, 0x8d, 0x1d, 0xd7, 0x0c,
+	0xaa, 0x77, 0x93, 0x54, 0x2f, 0x66, 0x9d, 0xf5, 0xb8, 0xff, 0xfc, 0x6b, 0x0d, 0x2e, 0xec, 0xf2,
+	0x40, 0xf6, 0x2f, 0x0a, 0x9f, 0xcf, 0xed, 0x95, 0xce, 0xf1, 0x58, 0x83, 0xc4, 0x4e, 0x2c, 0xdf,
+	0x4c, 0xa0, 0x15, 0x04, 0xb1, 0x13, 0xcb, 0xdf, 0x8a, 0x30, 0xf5, 0xdf, 0x68, 0x50, 0xde, 0x56,
+	0x29, 0xfa, 0xb4, 0x5a, 0x32, 0x28, 0xd0, 0x3b, 0xb2, 0x70, 0x64, 0xf4, 0x8d, 0x77, 0x97, 0xa1,
+	0xe5, 0x0c, 0x26, 0xd1, 0x53, 0x4c, 0xd8, 0x8e, 0x67, 0x90, 0x84, 0x2e, 0xaa, 0x26, 0x3e, 0xfe,
+	0x58, 0xc7, 0xb6, 0x32, 0xb0, 0x6a, 0xef, 0x15, 0xe3, 0x8d, 0xf6, 0xd6, 0x9e, 0x41, 0x08, 0xcd,
+	0x3e, 0xe4, 0xdb, 0x5b, 0x7b, 0x99, 0x12, 0x62, 0x50, 0xb0, 0xbc, 0x81, 0x52, 0x2d, 0xfa, 0x9e,
+	0x49, 0xe6, 0xe5, 0xcf, 0x95, 0xcc, 0xd3, 0xff, 0x5c, 0x83, 0xb5, 0x8e, 0x83, 0xeb, 0x69, 0x27,
+	0x72, 0x9c, 0x5f, 0xb5, 0x22, 0x80, 0xe6, 0x97, 0x8f, 0xcd, 0x2f, 0x6b, 0x57, 0x0b, 0x99, 0xb1,
+	0xc6, 0xff, 0x85, 0x72, 0xdb, 0x1b, 0x74, 0x28, 0x03, 0x7d, 0x11, 0x96, 0x44, 0x72, 0x47, 0xe4,
+	0x83, 0x44, 0x03, 0xe9, 0xd3, 0x03, 0x9a, 0xdc, 0x0d, 0xfc, 0x8e, 0x32, 0xd8, 0xf9, 0x58, 0x06,
+	0x5b, 0xff, 0x43, 0x0d, 0x2e, 0x26, 0x97, 0x27, 0x5d, 0xfc, 0x9b, 0x50, 0x4c, 0xe4, 0xd2, 0x53,
+	0x39, 0x52, 0xd9, 0x89, 0x61, 0xac, 0xe5, 0x0d, 0x4c, 0xe4, 0xa0, 0xc4, 0x5d, 0xb6, 0xbc, 0x01,
+	0xbe, 0xb3, 0xf9, 0xec, 0x36, 0x14, 0x89, 0x8b, 0x3a, 0xc8, 0xca, 0xf1, 0xa8, 0xd9, 0x1b, 0xb2,
+	0x5b, 0xff, 0x4f, 0x0d, 0xaa, 0x3b, 0x1c, 0x67, 0xd1, 0xdf, 0xb6, 0x86, 0xc3, 0xaf, 0x26, 0xdc,
+	0xc4, 0x94, 0xf2, 0xa9, 0x29, 0x29, 0xc9, 0x17, 0x62, 0x92, 0xdf, 0x00, 0xc0, 0x01, 0x72, 0xaa,
+	0x4b, 0xd9, 0x53, 0xad, 0x58, 0xf2, 0xcb, 0xc7, 0xb3, 0xee, 0x59, 0x2f, 0x64, 0x50, 0x85, 0x9f,
+	0x91, 0x6c, 0x4b, 0xf1, 0xd7, 0x81, 0x58, 0x29, 0x44, 0x39, 0x51, 0x0a, 0xa1, 0xff, 0xab, 0x06,
+	0x75, 0xb1, 0xde, 0xee, 0x34, 0x94, 0xf8, 0xdc, 0x22, 0x90, 0xb7, 0xa3, 0x7c, 0x75, 0x2e, 0xa1,
+	0xb4, 0x31, 0x91, 0x45, 0x49, 0xeb, 0x8d, 0xd8, 0xa5, 0x3e, 0x3f, 0x17, 0x3d, 0xc4, 0xf9, 0x6d,
+	0x15, 0x82, 0xe8, 0xfb, 0xc0, 0x76, 0x79, 0xa0, 0xce, 0xad, 0x3a, 0x36, 0x69, 0xbb, 0x71, 0xfe,
+	0x08, 0xfb, 0x67, 0x1a, 0x5c, 0x89, 0x11, 0x3c, 0x0a, 0x5c, 0xcf, 0x1a, 0xf0, 0x79, 0x74, 0xa5,
+	0x3d, 0xce, 0x25, 0x6e, 0x2e, 0x4f, 0x6d, 0x3e, 0xec, 0x2b, 0xfd, 0xa7, 0xc6, 0xf9, 0x4f, 0xdd,
+	0x8c, 0x2d, 0x5d, 0x3a, 0x9f, 0x2d, 0x2d, 0x66, 0xda, 0x52, 0x0f, 0x9a, 0x59, 0x6b, 0x89, 0x9e,
+	0x0e, 0xa9, 0x60, 0x48, 0x8b, 0x0a, 0x86, 0xa8, 0x0c, 0x2b, 0xca, 0x1b, 0xe6, 0x64, 0x19, 0x56,
+	0x3c, 0x69, 0x78, 0xd6, 0xb3, 0xfc, 0x2f, 0x35, 0xb8, 0x8e, 0x89, 0x2a, 0x4c, 0xff, 0x9e, 0x53,
+	0x8a, 0x8f, 0x01, 0x30, 0xd2, 0x20, 0x51, 0x29, 0xe5, 0xdb, 0x90, 0xaa, 0xb1, 0x98, 0xd4, 0xc6,
+	0x43, 0x7e, 0xfa, 0x00, 0x87, 0x19, 0x95, 0x67, 0xf2, 0x2b, 0xdb, 0xc4, 0xe5, 0xb3, 0x84, 0xdd,
+	0xdc, 0x84, 0xb2, 0x22, 0x90, 0x7d, 0x09, 0x15, 0x5b, 0x99, 0x8b, 0x6d, 0xa5, 0x7e, 0x0a, 0xaf,
+	0xcd, 0x9d, 0x93, 0x14, 0x2c, 0x3e, 0x15, 0x59, 0x81, 0xa5, 0xb2, 0x63, 0xa2, 0xf1, 0x35, 0x88,
+	0x76, 0x44, 0xac, 0x53, 0x5c, 0xc5, 0xa2, 0xcf, 0xaf, 0xa0, 0xe7, 0x96, 0x8e, 0xfe, 0x7b, 0x70,
+	0x63, 0x3e, 0xbb, 0xe8, 0xc2, 0x29, 0xb7, 0x4d, 0x66, 0x02, 0x45, 0xeb, 0x6b, 0x58, 0xec, 0x0f,
+	0xe0, 0xfa, 0x2c, 0xf7, 0x43, 0xcf, 0x75, 0x9f, 0x7e, 0xc5, 0xc3, 0x88, 0x11, 0xc6, 0x6b, 0x73,
+	0x49, 0x2f, 0x38, 0x1b, 0x99, 0xd5, 0x7b, 0x08, 0xe5, 0x53, 0xcc, 0x75, 0xcb, 0x87, 0x5c, 0x6a,
+	0x50, 0x7a, 0xc7, 0xb3, 0x39, 0xbd, 0x82, 0xca, 0xc8, 0x03, 0xdb, 0x0f, 0xc5, 0xa4, 0xc6, 0xc8,
+	0x8b, 0x5c, 0x40, 0xc5, 0x10, 0x0d, 0x99, 0x6a, 0x52, 0xd9, 0x6b, 0x61, 0xf4, 0x2b, 0xbe, 0x4a,
+	0x5d, 0xa7, 0xe4, 0x59, 0x3a, 0x4b, 0x9e, 0xe5, 0x59, 0x79, 0xfe, 0x4a, 0x83, 0x4b, 0xbb, 0x3c,
+	0xe8, 0x4e, 0xfd, 0xad, 0xd3, 0x54, 0xf4, 0x37, 0x3f, 0x33, 0xf1, 0x01, 0x14, 0x3c, 0x77, 0x28,
+	0x56, 0xbc, 0xb2, 0xa9, 0x47, 0x67, 0x32, 0x83, 0xcc, 0x86, 0xe1, 0x0e, 0xb9, 0x41, 0xf8, 0x5f,
+	0xae, 0xb4, 0x42, 0x78, 0x30, 0x0c, 0xcc, 0xb8, 0x4c, 0x2f, 0xa8, 0xa6, 0x7e, 0x17, 0x0a, 0x48,
+	0x95, 0x95, 0x20, 0xdf, 0xde, 0xff, 0x5c, 0x3c, 0x13, 0x1f, 0x3e, 0xd9, 0x7a, 0xb4, 0x77, 0xf4,
+	0x49, 0xc7, 0x10, 0xa5, 0x34, 0x47, 0x7b, 0xbb, 0xfb, 0x1d, 0xa3, 0x9e, 0xc3, 0xc8, 0xf6, 0xb2,
+	0x9a, 0x59, 0xda, 0x1f, 0x7c, 0x25, 0x4f, 0xff, 0x75, 0x2d, 0xe6, 0x8f, 0x34, 0x58, 0x11, 0x13,
+	0x0c, 0xd5, 0xec, 0x3b, 0x99, 0xb5, 0x23, 0xcd, 0xf9, 0xd5, 0x55, 0xe7, 0xac, 0x21, 0xb9, 0x06,
+	0x40, 0x21, 0x9a, 0xf9, 0xd4, 0x73, 0x55, 0xf1, 0x6e, 0x85, 0x20, 0x0f, 0x3c, 0x77, 0xa4, 0x73,
+	0xb8, 0x7c, 0x84, 0x99, 0xca, 0x59, 0xfa, 0x99, 0xaf, 0x1c, 0x1f, 0xc0, 0xca, 0xd8, 0xe3, 0x66,
+	0xac, 0xd6, 0x2c, 0x37, 0xa7, 0xd6, 0xac, 0x36, 0xf6, 0x78, 0xd8, 0xd2, 0xff, 0x21, 0x0f, 0xeb,
+	0x1d, 0x3f, 0xb0, 0x47, 0x56, 0xc0, 0xb3, 0x78, 0x25, 0xeb, 0xd7, 0xb4, 0xb3, 0xeb, 0xd7, 0x16,
+	0xd6, 0xa9, 0x26, 0x5e, 0xef, 0xf3, 0xa9, 0xd7, 0xfb, 0x85, 0xe5, 0x84, 0x8f, 0x93, 0xf9, 0x75,
+	0xdc, 0x82, 0x77, 0xe4, 0x34, 0x16, 0x4c, 0x7f, 0x6e, 0xcd, 0xeb, 0xeb, 0x10, 0x3d, 0x83, 0x53,
+	0x0e, 0x41, 0xe4, 0x70, 0x6b, 0x21, 0x10, 0xd3, 0x08, 0x09, 0x24, 0x2c, 0xba, 0x29, 0x89, 0x67,
+	0xad, 0x10, 0x28, 0xab, 0xac, 0x71, 0xd6, 0xdc, 0xc1, 0x84, 0x2c, 0x1d, 0xea, 0xb2, 0x81, 0xeb,
+	0xe8, 0x10, 0x40, 0xe5, 0x6f, 0x65, 0x77, 0x45, 0x74, 0x7b, 0xd6, 0x48, 0x74, 0x7f, 0xa5, 0x9a,
+	0x56, 0xdd, 0x13, 0x87, 0xc9, 0x7d, 0x16, 0x66, 0x79, 0xc2, 0x6d, 0x8b, 0xa5, 0xc8, 0xb4, 0x64,
+	0x8a, 0x2c, 0x23, 0x8b, 0x94, 0x3b, 0x7f, 0x16, 0x49, 0xff, 0x47, 0x69, 0xa2, 0x12, 0x4c, 0xcf,
+	0x32, 0x51, 0x61, 0xa5, 0x75, 0x2e, 0x5e, 0x69, 0x7d, 0x6e, 0x2f, 0x37, 0x63, 0x3a, 0x0b, 0xe7,
+	0x0b, 0xb8, 0x96, 0x32, 0x03, 0x2e, 0x03, 0x9a, 0x6a, 0x01, 0x1f, 0x6e, 0xde, 0x3b, 0x43, 0x70,
+	0xf9, 0x48, 0x70, 0x4d, 0x28, 0xd3, 0xbc, 0xf7, 0x76, 0xc2, 0xeb, 0x8d, 0x6a, 0xeb, 0x7e, 0x24,
+	0x94, 0x0f, 0x37, 0xef, 0xc5, 0x33, 0xca, 0xd9, 0x45, 0xe6, 0x57, 0x24, 0x2d, 0xcc, 0xe4, 0xca,
+	0x32, 0x63, 0x41, 0xab, 0xff, 0x25, 0x7c, 0xff, 0x7d, 0x58, 0x8f, 0x31, 0x7d, 0xcc, 0x03, 0x0b,
+	0x5d, 0x60, 0xb8, 0x92, 0x26, 0x94, 0x47, 0x12, 0xa6, 0xec, 0xa9, 0x6a, 0xeb, 0xef, 0x40, 0x23,
+	0x36, 0xf4, 0xe0, 0x85, 0xc3, 0xbd, 0x78, 0x64, 0xe4, 0x22, 0x40, 0xcd, 0x98, 0x1a, 0xfa, 0xdf,
+	0xe4, 0x60, 0x49, 0x94, 0x64, 0xde, 0xc1, 0x15, 0x8d, 0xed, 0x9e, 0x2c, 0x0b, 0x50, 0x57, 0x0a,
+	0x59, 0x91, 0x89, 0x3d, 0x86, 0x40, 0x08, 0x1d, 0x74, 0x2e, 0xe6, 0xa0, 0x55, 0xca, 0x3f, 0x1f,
+	0x7b, 0x73, 0xbb, 0x1b, 0x5a, 0xc8, 0xe4, 0x1f, 0x01, 0x88, 0xe4, 0x36, 0xf5, 0x28, 0xab, 0xa9,
+	0xff, 0x54, 0x83, 0x25, 0x62, 0xc2, 0x2e, 0x42, 0x7d, 0xfb, 0x60, 0xbf, 0x6b, 0xb4, 0xb7, 0xbb,
+	0xa6, 0xd1, 0xd9, 0xee, 0xec, 0x1d, 0x76, 0xeb, 0xaf, 0x30, 0x06, 0x2b, 0x21, 0xb4, 0xf3, 0x69,
+	0x67, 0x1f, 0x8b, 0xb1, 0x19, 0xac, 0xec, 0x77, 0x3e, 0x33, 0x3f, 0xe9, 0xb4, 0x77, 0xcc, 0xad,
+	0x47, 0x07, 0xdb, 0x0f, 0xeb, 0x39, 0x2c, 0x93, 0x46, 0xd8, 0xa3, 0xbd, 0x2d, 0x09, 0xca, 0x23,
+	0x41, 0x59, 0x8d, 0x80, 0x85, 0xd6, 0xed, 0x9d, 0x9d, 0xce, 0x4e, 0xbd, 0x80, 0x75, 0xd6, 0x31,
+	0xa8, 0xaa, 0x75, 0x5c, 0xc2, 0x92, 0xef, 0xed, 0x4f, 0xda, 0x7b, 0xfb, 0xa6, 0xd1, 0x39, 0x30,
+	0x76, 0xeb, 0x45, 0x7d, 0x0c, 0xd5, 0xd8, 0x84, 0xcf, 0xf3, 0xfe, 0x28, 0xde, 0x97, 0xc4, 0xa5,
+	0x3d, 0xa7, 0xde, 0x97, 0xa8, 0x1c, 0x03, 0x4d, 0x8f, 0x2a, 0x79, 0x51, 0x15, 0x1b, 0xd8, 0x5f,
+	0x93, 0x40, 0x59, 0xb3, 0x91, 0x83, 0xfa, 0xd1, 0xe4, 0xd8, 0xef, 0x79, 0xf6, 0x71, 0x78, 0x0a,
+	0xef, 0x42, 0x91, 0xa4, 0x2f, 0x1c, 0x55, 0xf6, 0xfe, 0x48, 0x0c, 0x2c, 0x3a, 0x7d, 0x6a, 0x0f,
+	0x03, 0xf9, 0x22, 0x17, 0xfd, 0x67, 0x20, 0x4d, 0x74, 0xe3, 0x01, 0x61, 0x19, 0x12, 0x9b, 0xbd,
+	0x0b, 0x55, 0x74, 0x5a, 0x66, 0xcc, 0xe5, 0x66, 0xef, 0x1a, 0x20, 0x9a, 0xf8, 0x6e, 0xf6, 0xa1,
+	0x28, 0xc8, 0xa0, 0x37, 0x57, 0x9e, 0xdd, 0x0c, 0xa3, 0x43, 0x50, 0xa0, 0xbd, 0x7e, 0xdc, 0x92,
+	0xe4, 0x92, 0x96, 0x24, 0x15, 0x08, 0xe4, 0xd3, 0x81, 0x80, 0xfe, 0x21, 0x5c, 0x88, 0xcd, 0x5e,
+	0xaa, 0xb4, 0x0e, 0x4b, 0x54, 0x61, 0xd7, 0xd0, 0x12, 0x55, 0x29, 0x34, 0x53, 0x43, 0x74, 0xe9,
+	0x7f, 0xa1, 0x01, 0x60, 0x1a, 0xdc, 0xdb, 0x72, 0x9d, 0x89, 0x8f, 0xa7, 0xe0, 0x18, 0x3f, 0xa4,
+	0xf9, 0x14, 0x0d, 0xf6, 0x3e, 0x14, 0xfb, 0x3c, 0xb0, 0xec, 0xa1, 0xb4, 0x99, 0xd7, 0x62, 0xf9,
+	0x73, 0x31, 0x70, 0x63, 0x87, 0xfa, 0x65, 0xe6, 0x5e, 0x20, 0x37, 0xef, 0x63, 0x4e, 0x23, 0x04,
+	0x7f, 0xa9, 0xb7, 0xb4, 0x5b, 0xb0, 0xb2, 0x6d, 0x39, 0x7d, 0xbb, 0x6f, 0x05, 0x7c, 0xc1, 0xcc,
+	0xf4, 0xcf, 0x60, 0x4d, 0x9d, 0xe8, 0xb8, 0xf9, 0xc1, 0x87, 0x9f, 0xd3, 0xd1, 0xb1, 0x3b, 0x54,
+	0x89, 0x04, 0xd1, 0xfa, 0x12, 0x97, 0xed, 0x5f, 0x6b, 0x50, 0x09, 0xc9, 0xce, 0xa5, 0x47, 0xff,
+	0xa9, 0x18, 0x0e, 0xe3, 0xe1, 0x59, 0x19, 0x01, 0x2a, 0x38, 0xb3, 0x7d, 0x7f, 0xc2, 0xc3, 0xe0,
+	0x4c, 0xb4, 0xf0, 0x88, 0x88, 0x7f, 0x2f, 0xf9, 0x93, 0xf1, 0x78, 0x78, 0xaa, 0xcc, 0x3a, 0xc1,
+	0x8e, 0x08, 0x84, 0x99, 0x7c, 0xf5, 0x70, 0x20, 0x91, 0xc4, 0x65, 0x5b, 0x3d, 0x27, 0x48, 0xb4,
+	0x06, 0x94, 0xfa, 0xbc, 0x67, 0x8f, 0xac, 0x21, 0x39, 0xf2, 0x25, 0x43, 0x35, 0x91, 0x47, 0xcf,
+	0x72, 0x4c, 0xf5, 0x80, 0x20, 0xdf, 0xb9, 0xaa, 0x3d, 0xcb, 0xe9, 0x4a, 0xd0, 0xe6, 0x9f, 0xe8,
+	0x00, 0xed, 0xb1, 0x7d, 0xc4, 0xbd, 0xe7, 0x76, 0x8f, 0xb3, 0xef, 0x43, 0x75, 0x97, 0x07, 0xea,
+	0xcf, 0x4f, 0x4c, 0xa5, 0x1d, 0xe3, 0xff, 0x04, 0x6b, 0x5e, 0x96, 0xc0, 0xf4, 0x5f, 0xa4, 0xf4,
+	0x8b, 0x7f, 0xf0, 0x2f, 0xff, 0xfe, 0x93, 0xdc, 0x0a, 0xab, 0xb5, 0x06, 0x31, 0x1a, 0x5d, 0xa8,
+	0xed, 0x72, 0x21, 0xcf, 0xf9, 0x34, 0xd5, 0x5f, 0x60, 0x66, 0x2a, 0x9e, 0xf4, 0x57, 0x89, 0xe8,
+	0x2a, 0x5b, 0x46, 0xa2, 0x11, 0x95, 0x7d, 0x80, 0x5d, 0x1e, 0xa8, 0xd7, 0x86, 0x4c, 0x9a, 0xea,
+	0x29, 0x2b, 0xf5, 0xbf, 0x33, 0x7d, 0x8d, 0x28, 0x2e, 0xb3, 0x2a, 0x52, 0x54, 0x14, 0xfe, 0x3f,
+	0x2d, 0xbc, 0x3b, 0x15, 0x25, 0x35, 0xec, 0x62, 0x18, 0xe5, 0xc5, 0x2a, 0x6c, 0x9a, 0x0b, 0xe2,
+	0x5e, 0x7d, 0x9d, 0xa8, 0xbe, 0xca, 0xd6, 0x5a, 0x83, 0x88, 0x4e, 0xeb, 0x25, 0xc6, 0xa7, 0x5f,
+	0xb0, 0x3e, 0xd5, 0x86, 0x87, 0x21, 0xe3, 0xd6, 0x69, 0x77, 0xba, 0x80, 0xcd, 0x4c, 0x88, 0xa9,
+	0xbf, 0x41, 0xc4, 0xaf, 0xb3, 0xab, 0x82, 0x78, 0x8a, 0x8c, 0xe2, 0xf2, 0xb9, 0x5c, 0x83, 0x2c,
+	0x30, 0xcb, 0x26, 0x7e, 0x79, 0x4e, 0x6d, 0x7d, 0x7a, 0x01, 0xa2, 0x57, 0x91, 0xe6, 0xb0, 0x9c,
+	0x28, 0x2e, 0x67, 0xeb, 0xd1, 0x55, 0x6b, 0xa6, 0xe4, 0xbd, 0x79, 0x35, 0xbb, 0x53, 0x32, 0xba,
+	0x42, 0x8c, 0xd6, 0xf4, 0x95, 0xd6, 0x20, 0xde, 0xff, 0x91, 0x76, 0x97, 0xfd, 0x3f, 0x79, 0x01,
+	0x09, 0x0b, 0xba, 0xb3, 0x77, 0xb6, 0x39, 0xbf, 0xf2, 0x5b, 0xbf, 0x4c, 0xd4, 0x2f, 0xb0, 0x55,
+	0xb1, 0x8c, 0x88, 0x92, 0x43, 0x89, 0xb8, 0x54, 0xe1, 0xf4, 0x1c, 0x21, 0x29, 0x5f, 0x30, 0xa7,
+	0xcc, 0x5a, 0xd7, 0x89, 0xc9, 0x55, 0xd6, 0x4c, 0x2c, 0x21, 0x29, 0x32, 0x97, 0xd6, 0x12, 0xab,
+	0xd3, 0x62, 0x31, 0xb1, 0xcc, 0x96, 0x6f, 0x35, 0x2f, 0x66, 0x95, 0x18, 0xea, 0x6f, 0x11, 0xa7,
+	0xd7, 0xd9, 0x4d, 0xe4, 0x14, 0x1b, 0x25, 0xb9, 0xb4, 0x5e, 0xaa, 0x32, 0xa8, 0x2f, 0xd8, 0x0b,
+	0xa8, 0xa7, 0xeb, 0xb9, 0xd8, 0xf5, 0x19, 0x96, 0x89, 0x42, 0xaf, 0x39, 0x4c, 0xbf, 0x41, 0x4c,
+	0x6f, 0xb3, 0x37, 0x5b, 0x83, 0xd4, 0xb8, 0xd6, 0x4b, 0xe1, 0xd1, 0x13, 0x8c, 0x4f, 0xa0, 0x9e,
+	0xae, 0xfc, 0x9a, 0x61, 0x9c, 0x2a, 0x09, 0x9b, 0xc3, 0xf8, 0x2a, 0x31, 0xbe, 0xa4, 0x5f, 0x68,
+	0x0d, 0x52, 0xe3, 0x3e, 0xd2, 0xee, 0xbe, 0xa3, 0xb1, 0x11, 0xd5, 0xbe, 0x45, 0x25, 0x5c, 0x42,
+	0x16, 0xdc, 0x67, 0x97, 0x12, 0xdb, 0x18, 0x16, 0x82, 0x35, 0xaf, 0xa4, 0x8f, 0x52, 0xb4, 0x87,
+	0x37, 0x89, 0xd7, 0xba, 0x7e, 0xa9, 0x35, 0xc8, 0x22, 0x89, 0xea, 0xc8, 0xc9, 0xc8, 0xa8, 0xd7,
+	0xf8, 0x46, 0xb4, 0xa4, 0x64, 0x62, 0xa1, 0xb9, 0x92, 0x7c, 0xd4, 0x4a, 0xca, 0x4f, 0x02, 0x5b,
+	0x2f, 0xd1, 0x3d, 0x7c, 0xd1, 0x7a, 0x99, 0x76, 0x3d, 0xa8, 0x29, 0xb1, 0x17, 0x2f, 0x55, 0xc2,
+	0x75, 0x35, 0x49, 0x33, 0x59, 0x4e, 0xd6, 0xbc, 0x36, 0xa7, 0x57, 0xae, 0xed, 0x1a, 0x4d, 0xe0,
+	0xb2, 0xce, 0x62, 0x13, 0x90, 0x38, 0xb8, 0x2e, 0x1b, 0x56, 0x23, 0x86, 0xe2, 0x4e, 0xb8, 0x98,
+	0xdd, 0xfa, 0x82, 0x92, 0x1a, 0x65, 0x38, 0xf4, 0x7a, 0x8c, 0x19, 0x61, 0x20, 0xab, 0x3f, 0xd3,
+	0x88, 0x57, 0xfc, 0xca, 0xc4, 0xae, 0xc5, 0xd2, 0x34, 0xb3, 0x57, 0xa9, 0xe6, 0xf5, 0x79, 0xdd,
+	0x92, 0xdf, 0xb7, 0x89, 0xdf, 0x87, 0xec, 0xfd, 0xd6, 0x20, 0x89, 0xd1, 0x7a, 0x29, 0x23, 0xa5,
+	0x2f, 0x5a, 0x2f, 0xe9, 0x46, 0x91, 0x29, 0xed, 0xbf, 0xd4, 0xc8, 0x10, 0xa4, 0xee, 0x40, 0x67,
+	0x4d, 0xea, 0x66, 0xaa, 0x7b, 0xf6, 0xf6, 0xa4, 0x7f, 0x8f, 0xe6, 0xf5, 0x11, 0xfb, 0x66, 0x6b,
+	0x30, 0x83, 0x74, 0xbe, 0xa9, 0xfd, 0x95, 0x06, 0x6b, 0x19, 0xb7, 0x9a, 0x99, 0xb9, 0x25, 0xaf,
+	0x59, 0x4d, 0x7d, 0xb6, 0x3b, 0x7d, 0x21, 0xd2, 0xb7, 0x68, 0x72, 0x1f, 0xb3, 0x8f, 0x5a, 0x83,
+	0x59, 0xac, 0x68, 0x4e, 0xea, 0x62, 0x96, 0x39, 0xbd, 0x9f, 0x68, 0x74, 0xd0, 0x13, 0x37, 0xa7,
+	0xb3, 0xe6, 0xf6, 0xda, 0x6c, 0x77, 0xe2, 0xc6, 0xa5, 0x7f, 0x97, 0x26, 0x76, 0x9f, 0x7d, 0xd8,
+	0x1a, 0xa4, 0x50, 0xce, 0x39, 0x2b, 0x11, 0xb2, 0x84, 0x95, 0x36, 0x0b, 0x43, 0x96, 0x74, 0x05,
+	0x4f, 0x32, 0x64, 0x09, 0x69, 0xfc, 0x54, 0xec, 0x43, 0xba, 0x8a, 0x89, 0xc5, 0x94, 0x60, 0x4e,
+	0x11, 0x55, 0x53, 0x5f, 0x84, 0x22, 0x99, 0xde, 0x27, 0xa6, 0xef, 0xb2, 0x7b, 0xad, 0xc1, 0x2c,
+	0x56, 0x5c, 0x53, 0x66, 0x17, 0x3b, 0xa0, 0xc5, 0x86, 0xcf, 0xcf, 0x57, 0x22, 0x6e, 0xa9, 0x8c,
+	0x62, 0x73, 0x35, 0xf5, 0x62, 0xac, 0xbf, 0x4d, 0x5c, 0x6f, 0xb1, 0x37, 0x28, 0x90, 0x92, 0xd0,
+	0xd6, 0xcb, 0x39, 0x52, 0xb5, 0xa0, 0x16, 0x7f, 0x0f, 0x65, 0xca, 0xe5, 0x66, 0xbc, 0x01, 0x37,
+	0xd7, 0x33, 0xfb, 0xe4, 0x62, 0x1b, 0xc4, 0x96, 0xe9, 0xcb, 0x2d, 0x1e, 0xeb, 0x46, 0xd3, 0x70,
+	0x04, 0x65, 0xf5, 0xf8, 0x77, 0x46, 0xac, 0x92, 0x7e, 0x23, 0x54, 0x44, 0x59, 0xbd, 0xd5, 0x97,
+	0x5d, 0xca, 0xeb, 0x9e, 0x02, 0x9b, 0x4d, 0x9d, 0xb3, 0x1b, 0xb3, 0x72, 0x4a, 0xbe, 0xd3, 0x34,
+	0x6f, 0x2e, 0xc0, 0x90, 0x4c, 0xaf, 0x13, 0xd3, 0x86, 0xbe, 0xd6, 0x1a, 0xcc, 0x20, 0xe1, 0x7a,
+	0xfe, 0x54, 0xe4, 0x77, 0xb3, 0x5e, 0x5e, 0xd8, 0x9b, 0xe7, 0x7a, 0x2d, 0x6a, 0xde, 0x3a, 0x0b,
+	0x4d, 0x4e, 0xe5, 0x75, 0x9a, 0xca, 0x35, 0xbd, 0xd1, 0x1a, 0x64, 0x63, 0xe2, 0x7c, 0x7e, 0xac,
+	0x51, 0xa2, 0x23, 0xf3, 0x7d, 0x84, 0xdd, 0x9a, 0xbb, 0xde, 0xc4, 0x7b, 0x4d, 0xf3, 0xf6, 0x99,
+	0x78, 0x72, 0x4a, 0x32, 0x44, 0xd5, 0xaf, 0xb4, 0x06, 0x73, 0x50, 0x63, 0x32, 0xca, 0x7a, 0xda,
+	0x88, 0xcb, 0x68, 0xc1, 0xab, 0x4a, 0xf3, 0xd6, 0x59, 0x68, 0x59, 0x32, 0xca, 0xc2, 0xc4, 0xf9,
+	0xf4, 0x61, 0x55, 0xa5, 0xe4, 0x95, 0x9b, 0xbf, 0xb6, 0xf0, 0x11, 0xa1, 0xf9, 0x6a, 0xa2, 0x3b,
+	0xdb, 0x09, 0xc6, 0xc7, 0x21, 0x97, 0x81, 0xb0, 0x9b, 0xf1, 0xc4, 0x7f, 0x3c, 0x40, 0xca, 0x7a,
+	0x11, 0x98, 0xc7, 0x27, 0x11, 0x21, 0x25, 0x06, 0x22, 0xa3, 0x1f, 0xc2, 0x6a, 0x2a, 0x6f, 0x1e,
+	0x9a, 0x88, 0xd9, 0xff, 0xa7, 0x85, 0x8e, 0x76, 0x4e, 0xaa, 0x5d, 0x67, 0xc4, 0xab, 0xa6, 0x97,
+	0x5a, 0x3e, 0x62, 0x4c, 0x85, 0xc0, 0x2e, 0x6c, 0xa3, 0x43, 0x1b, 0x7e, 0x4d, 0x3c, 0xa4, 0x01,
+	0xd6, 0x2b, 0xad, 0x9e, 0x20, 0x4b, 0x5c, 0x0c, 0x58, 0xed, 0x4c, 0x79, 0xef, 0x9c, 0x3c, 0x66,
+	0xef, 0x4b, 0xd1, 0xcc, 0x39, 0x92, 0x21, 0x9a, 0x43, 0x58, 0xcb, 0x48, 0x96, 0x2f, 0xa2, 0xab,
+	0x9f, 0x9d, 0x63, 0xd7, 0x2f, 0x11, 0xa7, 0xba, 0x5e, 0x6d, 0x71, 0x85, 0x45, 0xdc, 0x3e, 0x83,
+	0x4a, 0x98, 0x8a, 0x61, 0x97, 0xe7, 0xa4, 0x96, 0x9a, 0x8d, 0xd9, 0x8e, 0xe4, 0xb5, 0x57, 0x87,
+	0x96, 0xaf, 0xfa, 0x44, 0x08, 0xec, 0xd0, 0x4d, 0x2c, 0x96, 0xac, 0x99, 0x1f, 0x96, 0x5e, 0x98,
+	0x49, 0xd0, 0xe8, 0xef, 0x10, 0xd9, 0xbb, 0xec, 0x0e, 0xaa, 0x4f, 0x04, 0x5f, 0x10, 0x9c, 0xfe,
+	0x88, 0x82, 0xd3, 0x54, 0x1a, 0x66, 0x3e, 0x4f, 0xa5, 0xb6, 0xc9, 0x01, 0xfa, 0x7b, 0xc4, 0x77,
+	0x83, 0xbd, 0x4d, 0x87, 0x31, 0xd1, 0xb7, 0x30, 0x30, 0xae, 0xc5, 0xf3, 0x3a, 0xa1, 0x13, 0xca,
+	0x48, 0xf6, 0x44, 0x4a, 0xa0, 0x3a, 0xf4, 0x7b, 0xc4, 0xf3, 0xff, 0xb0, 0xb7, 0xc2, 0xc8, 0x42,
+	0xf8, 0x57, 0x91, 0xb5, 0xc9, 0x62, 0x78, 0x5c, 0xa4, 0x7f, 0x4a, 0xbd, 0xfb, 0x3f, 0x03, 0x00,
+	0xe1, 0x48, 0x0e, 0x05, 0x34, 0x47, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4193,12 +6461,28 @@ type ApiServiceClient interface {
 	GetTxByHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
 	// get transaction receipt by transaction hash
 	GetTxReceiptByTxHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxReceipt, error)
+	// get transaction status and its lifecycle in transaction pool
+	GetTxStatus(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxStatusResponse, error)
+	// get pending transactions in the packing order
+	GetPendingTxs(ctx context.Context, in *GetPendingTxsRequest, opts ...grpc.CallOption) (*GetPendingTxsResponse, error)
+	// get the stats of transaction pool
+	GetTxPoolStats(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*TxPoolStatsResponse, error)
+	// get the verification status of a pending transaction
+	GetPendingTxStatus(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*PendingTxStatusResponse, error)
 	// get block by hash
 	GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error)
 	// get block by number
 	GetBlockByNumber(ctx context.Context, in *GetBlockByNumberRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// get blocks by range of number
+	GetBlocksByRange(ctx context.Context, in *GetBlocksByRangeRequest, opts ...grpc.CallOption) (ApiService_GetBlocksByRangeClient, error)
+	// get tx receipts by tx hashes
+	GetTxReceiptsByHashes(ctx context.Context, in *TxHashesRequest, opts ...grpc.CallOption) (*TxReceiptsResponse, error)
 	// get account
 	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	// get the per-block history of gas, ram and token balance changes of the account
+	GetAccountHistory(ctx context.Context, in *AccountHistoryRequest, opts ...grpc.CallOption) (*AccountHistoryResponse, error)
+	// get the total gas, ram and token balance changes of the account
+	GetAccountUsage(ctx context.Context, in *AccountHistoryRequest, opts ...grpc.CallOption) (*AccountUsageResponse, error)
 	// get token balance
 	GetTokenBalance(ctx context.Context, in *GetTokenBalanceRequest, opts ...grpc.CallOption) (*GetTokenBalanceResponse, error)
 	// get token721 balance
@@ -4213,16 +6497,30 @@ type ApiServiceClient interface {
 	GetProducerVoteInfo(ctx context.Context, in *GetProducerVoteInfoRequest, opts ...grpc.CallOption) (*GetProducerVoteInfoResponse, error)
 	// get contract
 	GetContract(ctx context.Context, in *GetContractRequest, opts ...grpc.CallOption) (*Contract, error)
+	// validate and encode the typed arguments of a contract action against its abi
+	EncodeAction(ctx context.Context, in *EncodeActionRequest, opts ...grpc.CallOption) (*EncodeActionResponse, error)
+	// decode the actions, receipts and returns of a transaction against the abis of contracts
+	DecodeTx(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*DecodeTxResponse, error)
 	// get contract storage
 	GetContractStorage(ctx context.Context, in *GetContractStorageRequest, opts ...grpc.CallOption) (*GetContractStorageResponse, error)
 	// get batch contract storage
 	GetBatchContractStorage(ctx context.Context, in *GetBatchContractStorageRequest, opts ...grpc.CallOption) (*GetBatchContractStorageResponse, error)
 	// get contract fields storage
 	GetContractStorageFields(ctx context.Context, in *GetContractStorageFieldsRequest, opts ...grpc.CallOption) (*GetContractStorageFieldsResponse, error)
+	// get the merkle proof of contract storage against the state root of last irreversible block
+	GetContractStorageProof(ctx context.Context, in *GetContractStorageProofRequest, opts ...grpc.CallOption) (*GetContractStorageProofResponse, error)
+	// get the transactions published or signed by the account, need txindex enabled
+	GetTxsByAccount(ctx context.Context, in *GetTxsByAccountRequest, opts ...grpc.CallOption) (*GetTxsResponse, error)
+	// get the transactions calling the contract, need txindex enabled
+	GetTxsByContract(ctx context.Context, in *GetTxsByContractRequest, opts ...grpc.CallOption) (*GetTxsResponse, error)
 	// send transaction
 	SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
+	// cancel a pending transaction by a replacement without actions
+	CancelTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
 	// execute transaction
 	ExecTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TxReceipt, error)
+	// estimate the gas and ram usage of a transaction by executing it on the head block
+	EstimateTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateTransactionResponse, error)
 	// subscribe an event
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error)
 	GetVoterBonus(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*VoterBonus, error)
@@ -4283,6 +6581,42 @@ func (c *apiServiceClient) GetTxReceiptByTxHash(ctx context.Context, in *TxHashR
 	return out, nil
 }
 
+func (c *apiServiceClient) GetTxStatus(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxStatusResponse, error) {
+	out := new(TxStatusResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetPendingTxs(ctx context.Context, in *GetPendingTxsRequest, opts ...grpc.CallOption) (*GetPendingTxsResponse, error) {
+	out := new(GetPendingTxsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetPendingTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTxPoolStats(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*TxPoolStatsResponse, error) {
+	out := new(TxPoolStatsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxPoolStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetPendingTxStatus(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*PendingTxStatusResponse, error) {
+	out := new(PendingTxStatusResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetPendingTxStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
 	out := new(BlockResponse)
 	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHash", in, out, opts...)
@@ -4301,6 +6635,47 @@ func (c *apiServiceClient) GetBlockByNumber(ctx context.Context, in *GetBlockByN
 	return out, nil
 }
 
+func (c *apiServiceClient) GetBlocksByRange(ctx context.Context, in *GetBlocksByRangeRequest, opts ...grpc.CallOption) (ApiService_GetBlocksByRangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[0], "/rpcpb.ApiService/GetBlocksByRange", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceGetBlocksByRangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_GetBlocksByRangeClient interface {
+	Recv() (*BlockResponse, error)
+	grpc.ClientStream
+}
+
+type apiServiceGetBlocksByRangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceGetBlocksByRangeClient) Recv() (*BlockResponse, error) {
+	m := new(BlockResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) GetTxReceiptsByHashes(ctx context.Context, in *TxHashesRequest, opts ...grpc.CallOption) (*TxReceiptsResponse, error) {
+	out := new(TxReceiptsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxReceiptsByHashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error) {
 	out := new(Account)
 	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetAccount", in, out, opts...)
@@ -4310,6 +6685,24 @@ func (c *apiServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest
 	return out, nil
 }
 
+func (c *apiServiceClient) GetAccountHistory(ctx context.Context, in *AccountHistoryRequest, opts ...grpc.CallOption) (*AccountHistoryResponse, error) {
+	out := new(AccountHistoryResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetAccountHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetAccountUsage(ctx context.Context, in *AccountHistoryRequest, opts ...grpc.CallOption) (*AccountUsageResponse, error) {
+	out := new(AccountUsageResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetAccountUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetTokenBalance(ctx context.Context, in *GetTokenBalanceRequest, opts ...grpc.CallOption) (*GetTokenBalanceResponse, error) {
 	out := new(GetTokenBalanceResponse)
 	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTokenBalance", in, out, opts...)
@@ -4364,45 +6757,99 @@ func (c *apiServiceClient) GetProducerVoteInfo(ctx context.Context, in *GetProdu
 	return out, nil
 }
 
-func (c *apiServiceClient) GetContract(ctx context.Context, in *GetContractRequest, opts ...grpc.CallOption) (*Contract, error) {
-	out := new(Contract)
-	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetContract", in, out, opts...)
+func (c *apiServiceClient) GetContract(ctx context.Context, in *GetContractRequest, opts ...grpc.CallOption) (*Contract, error) {
+	out := new(Contract)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) EncodeAction(ctx context.Context, in *EncodeActionRequest, opts ...grpc.CallOption) (*EncodeActionResponse, error) {
+	out := new(EncodeActionResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/EncodeAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) DecodeTx(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*DecodeTxResponse, error) {
+	out := new(DecodeTxResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/DecodeTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetContractStorage(ctx context.Context, in *GetContractStorageRequest, opts ...grpc.CallOption) (*GetContractStorageResponse, error) {
+	out := new(GetContractStorageResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetContractStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBatchContractStorage(ctx context.Context, in *GetBatchContractStorageRequest, opts ...grpc.CallOption) (*GetBatchContractStorageResponse, error) {
+	out := new(GetBatchContractStorageResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetBatchContractStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetContractStorageFields(ctx context.Context, in *GetContractStorageFieldsRequest, opts ...grpc.CallOption) (*GetContractStorageFieldsResponse, error) {
+	out := new(GetContractStorageFieldsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetContractStorageFields", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetContractStorageProof(ctx context.Context, in *GetContractStorageProofRequest, opts ...grpc.CallOption) (*GetContractStorageProofResponse, error) {
+	out := new(GetContractStorageProofResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetContractStorageProof", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetContractStorage(ctx context.Context, in *GetContractStorageRequest, opts ...grpc.CallOption) (*GetContractStorageResponse, error) {
-	out := new(GetContractStorageResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetContractStorage", in, out, opts...)
+func (c *apiServiceClient) GetTxsByAccount(ctx context.Context, in *GetTxsByAccountRequest, opts ...grpc.CallOption) (*GetTxsResponse, error) {
+	out := new(GetTxsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxsByAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetBatchContractStorage(ctx context.Context, in *GetBatchContractStorageRequest, opts ...grpc.CallOption) (*GetBatchContractStorageResponse, error) {
-	out := new(GetBatchContractStorageResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetBatchContractStorage", in, out, opts...)
+func (c *apiServiceClient) GetTxsByContract(ctx context.Context, in *GetTxsByContractRequest, opts ...grpc.CallOption) (*GetTxsResponse, error) {
+	out := new(GetTxsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxsByContract", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetContractStorageFields(ctx context.Context, in *GetContractStorageFieldsRequest, opts ...grpc.CallOption) (*GetContractStorageFieldsResponse, error) {
-	out := new(GetContractStorageFieldsResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetContractStorageFields", in, out, opts...)
+func (c *apiServiceClient) SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
+	out := new(SendTransactionResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/SendTransaction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
+func (c *apiServiceClient) CancelTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
 	out := new(SendTransactionResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/SendTransaction", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/CancelTransaction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4418,8 +6865,17 @@ func (c *apiServiceClient) ExecTransaction(ctx context.Context, in *TransactionR
 	return out, nil
 }
 
+func (c *apiServiceClient) EstimateTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateTransactionResponse, error) {
+	out := new(EstimateTransactionResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/EstimateTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[0], "/rpcpb.ApiService/Subscribe", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[1], "/rpcpb.ApiService/Subscribe", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4489,12 +6945,28 @@ type ApiServiceServer interface {
 	GetTxByHash(context.Context, *TxHashRequest) (*TransactionResponse, error)
 	// get transaction receipt by transaction hash
 	GetTxReceiptByTxHash(context.Context, *TxHashRequest) (*TxReceipt, error)
+	// get transaction status and its lifecycle in transaction pool
+	GetTxStatus(context.Context, *TxHashRequest) (*TxStatusResponse, error)
+	// get pending transactions in the packing order
+	GetPendingTxs(context.Context, *GetPendingTxsRequest) (*GetPendingTxsResponse, error)
+	// get the stats of transaction pool
+	GetTxPoolStats(context.Context, *EmptyRequest) (*TxPoolStatsResponse, error)
+	// get the verification status of a pending transaction
+	GetPendingTxStatus(context.Context, *TxHashRequest) (*PendingTxStatusResponse, error)
 	// get block by hash
 	GetBlockByHash(context.Context, *GetBlockByHashRequest) (*BlockResponse, error)
 	// get block by number
 	GetBlockByNumber(context.Context, *GetBlockByNumberRequest) (*BlockResponse, error)
+	// get blocks by range of number
+	GetBlocksByRange(*GetBlocksByRangeRequest, ApiService_GetBlocksByRangeServer) error
+	// get tx receipts by tx hashes
+	GetTxReceiptsByHashes(context.Context, *TxHashesRequest) (*TxReceiptsResponse, error)
 	// get account
 	GetAccount(context.Context, *GetAccountRequest) (*Account, error)
+	// get the per-block history of gas, ram and token balance changes of the account
+	GetAccountHistory(context.Context, *AccountHistoryRequest) (*AccountHistoryResponse, error)
+	// get the total gas, ram and token balance changes of the account
+	GetAccountUsage(context.Context, *AccountHistoryRequest) (*AccountUsageResponse, error)
 	// get token balance
 	GetTokenBalance(context.Context, *GetTokenBalanceRequest) (*GetTokenBalanceResponse, error)
 	// get token721 balance
@@ -4509,16 +6981,30 @@ type ApiServiceServer interface {
 	GetProducerVoteInfo(context.Context, *GetProducerVoteInfoRequest) (*GetProducerVoteInfoResponse, error)
 	// get contract
 	GetContract(context.Context, *GetContractRequest) (*Contract, error)
+	// validate and encode the typed arguments of a contract action against its abi
+	EncodeAction(context.Context, *EncodeActionRequest) (*EncodeActionResponse, error)
+	// decode the actions, receipts and returns of a transaction against the abis of contracts
+	DecodeTx(context.Context, *TxHashRequest) (*DecodeTxResponse, error)
 	// get contract storage
 	GetContractStorage(context.Context, *GetContractStorageRequest) (*GetContractStorageResponse, error)
 	// get batch contract storage
 	GetBatchContractStorage(context.Context, *GetBatchContractStorageRequest) (*GetBatchContractStorageResponse, error)
 	// get contract fields storage
 	GetContractStorageFields(context.Context, *GetContractStorageFieldsRequest) (*GetContractStorageFieldsResponse, error)
+	// get the merkle proof of contract storage against the state root of last irreversible block
+	GetContractStorageProof(context.Context, *GetContractStorageProofRequest) (*GetContractStorageProofResponse, error)
+	// get the transactions published or signed by the account, need txindex enabled
+	GetTxsByAccount(context.Context, *GetTxsByAccountRequest) (*GetTxsResponse, error)
+	// get the transactions calling the contract, need txindex enabled
+	GetTxsByContract(context.Context, *GetTxsByContractRequest) (*GetTxsResponse, error)
 	// send transaction
 	SendTransaction(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
+	// cancel a pending transaction by a replacement without actions
+	CancelTransaction(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
 	// execute transaction
 	ExecTransaction(context.Context, *TransactionRequest) (*TxReceipt, error)
+	// estimate the gas and ram usage of a transaction by executing it on the head block
+	EstimateTransaction(context.Context, *TransactionRequest) (*EstimateTransactionResponse, error)
 	// subscribe an event
 	Subscribe(*SubscribeRequest, ApiService_SubscribeServer) error
 	GetVoterBonus(context.Context, *GetAccountRequest) (*VoterBonus, error)
@@ -4620,6 +7106,78 @@ func _ApiService_GetTxReceiptByTxHash_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetTxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTxStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxStatus(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetPendingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetPendingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetPendingTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetPendingTxs(ctx, req.(*GetPendingTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTxPoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxPoolStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTxPoolStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxPoolStats(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetPendingTxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetPendingTxStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetPendingTxStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetPendingTxStatus(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockByHashRequest)
 	if err := dec(in); err != nil {
@@ -4656,6 +7214,45 @@ func _ApiService_GetBlockByNumber_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetBlocksByRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetBlocksByRangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).GetBlocksByRange(m, &apiServiceGetBlocksByRangeServer{stream})
+}
+
+type ApiService_GetBlocksByRangeServer interface {
+	Send(*BlockResponse) error
+	grpc.ServerStream
+}
+
+type apiServiceGetBlocksByRangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceGetBlocksByRangeServer) Send(m *BlockResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_GetTxReceiptsByHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxReceiptsByHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTxReceiptsByHashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxReceiptsByHashes(ctx, req.(*TxHashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAccountRequest)
 	if err := dec(in); err != nil {
@@ -4674,6 +7271,42 @@ func _ApiService_GetAccount_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetAccountHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAccountHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetAccountHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAccountHistory(ctx, req.(*AccountHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetAccountUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAccountUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetAccountUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAccountUsage(ctx, req.(*AccountHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetTokenBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTokenBalanceRequest)
 	if err := dec(in); err != nil {
@@ -4800,6 +7433,42 @@ func _ApiService_GetContract_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_EncodeAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncodeActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).EncodeAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/EncodeAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).EncodeAction(ctx, req.(*EncodeActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_DecodeTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).DecodeTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/DecodeTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).DecodeTx(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetContractStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetContractStorageRequest)
 	if err := dec(in); err != nil {
@@ -4854,6 +7523,60 @@ func _ApiService_GetContractStorageFields_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetContractStorageProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractStorageProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetContractStorageProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetContractStorageProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetContractStorageProof(ctx, req.(*GetContractStorageProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTxsByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxsByAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxsByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTxsByAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxsByAccount(ctx, req.(*GetTxsByAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTxsByContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxsByContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxsByContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTxsByContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxsByContract(ctx, req.(*GetTxsByContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_SendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TransactionRequest)
 	if err := dec(in); err != nil {
@@ -4872,6 +7595,24 @@ func _ApiService_SendTransaction_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_CancelTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).CancelTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/CancelTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).CancelTransaction(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_ExecTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TransactionRequest)
 	if err := dec(in); err != nil {
@@ -4890,6 +7631,24 @@ func _ApiService_ExecTransaction_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_EstimateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).EstimateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/EstimateTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).EstimateTransaction(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -4989,6 +7748,22 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTxReceiptByTxHash",
 			Handler:    _ApiService_GetTxReceiptByTxHash_Handler,
 		},
+		{
+			MethodName: "GetTxStatus",
+			Handler:    _ApiService_GetTxStatus_Handler,
+		},
+		{
+			MethodName: "GetPendingTxs",
+			Handler:    _ApiService_GetPendingTxs_Handler,
+		},
+		{
+			MethodName: "GetTxPoolStats",
+			Handler:    _ApiService_GetTxPoolStats_Handler,
+		},
+		{
+			MethodName: "GetPendingTxStatus",
+			Handler:    _ApiService_GetPendingTxStatus_Handler,
+		},
 		{
 			MethodName: "GetBlockByHash",
 			Handler:    _ApiService_GetBlockByHash_Handler,
@@ -4997,10 +7772,22 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockByNumber",
 			Handler:    _ApiService_GetBlockByNumber_Handler,
 		},
+		{
+			MethodName: "GetTxReceiptsByHashes",
+			Handler:    _ApiService_GetTxReceiptsByHashes_Handler,
+		},
 		{
 			MethodName: "GetAccount",
 			Handler:    _ApiService_GetAccount_Handler,
 		},
+		{
+			MethodName: "GetAccountHistory",
+			Handler:    _ApiService_GetAccountHistory_Handler,
+		},
+		{
+			MethodName: "GetAccountUsage",
+			Handler:    _ApiService_GetAccountUsage_Handler,
+		},
 		{
 			MethodName: "GetTokenBalance",
 			Handler:    _ApiService_GetTokenBalance_Handler,
@@ -5029,6 +7816,14 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetContract",
 			Handler:    _ApiService_GetContract_Handler,
 		},
+		{
+			MethodName: "EncodeAction",
+			Handler:    _ApiService_EncodeAction_Handler,
+		},
+		{
+			MethodName: "DecodeTx",
+			Handler:    _ApiService_DecodeTx_Handler,
+		},
 		{
 			MethodName: "GetContractStorage",
 			Handler:    _ApiService_GetContractStorage_Handler,
@@ -5041,14 +7836,34 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetContractStorageFields",
 			Handler:    _ApiService_GetContractStorageFields_Handler,
 		},
+		{
+			MethodName: "GetContractStorageProof",
+			Handler:    _ApiService_GetContractStorageProof_Handler,
+		},
+		{
+			MethodName: "GetTxsByAccount",
+			Handler:    _ApiService_GetTxsByAccount_Handler,
+		},
+		{
+			MethodName: "GetTxsByContract",
+			Handler:    _ApiService_GetTxsByContract_Handler,
+		},
 		{
 			MethodName: "SendTransaction",
 			Handler:    _ApiService_SendTransaction_Handler,
 		},
+		{
+			MethodName: "CancelTransaction",
+			Handler:    _ApiService_CancelTransaction_Handler,
+		},
 		{
 			MethodName: "ExecTransaction",
 			Handler:    _ApiService_ExecTransaction_Handler,
 		},
+		{
+			MethodName: "EstimateTransaction",
+			Handler:    _ApiService_EstimateTransaction_Handler,
+		},
 		{
 			MethodName: "GetVoterBonus",
 			Handler:    _ApiService_GetVoterBonus_Handler,
@@ -5063,6 +7878,11 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetBlocksByRange",
+			Handler:       _ApiService_GetBlocksByRange_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Subscribe",
 			Handler:       _ApiService_Subscribe_Handler,
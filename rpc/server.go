@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/consensus"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/global"
@@ -18,10 +19,13 @@ import (
 	"github.com/rs/cors"
 	"golang.org/x/net/netutil"
 
+	"github.com/graph-gophers/graphql-go/relay"
 	"github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -38,6 +42,18 @@ type Server struct {
 	gatewayServer *http.Server
 	allowOrigins  []string
 
+	jsonrpcAddr   string
+	jsonrpcServer *http.Server
+	jsonrpcConn   *grpc.ClientConn
+
+	graphqlConf   *common.GraphQLConfig
+	graphqlServer *http.Server
+
+	apiService *APIService
+
+	limiter        *limiter
+	maxRequestSize int
+
 	quitCh chan struct{}
 
 	enable bool
@@ -53,25 +69,33 @@ func New(tp txpool.TxPool, bc blockcache.BlockCache, bv global.BaseVariable, p2p
 		grpcAddr:     bv.Config().RPC.GRPCAddr,
 		gatewayAddr:  bv.Config().RPC.GatewayAddr,
 		allowOrigins: bv.Config().RPC.AllowOrigins,
+		jsonrpcAddr:  bv.Config().RPC.JSONRPCAddr,
+		graphqlConf:  bv.Config().RPC.GraphQL,
 		quitCh:       make(chan struct{}),
 		enable:       bv.Config().RPC.Enable,
+
+		maxRequestSize: requestSize(bv.Config().RPC.Limit),
 	}
+	s.limiter = newLimiter(bv.Config().RPC.Limit)
 	s.grpcServer = grpc.NewServer(
 		grpc.UnaryInterceptor(
 			grpc_middleware.ChainUnaryServer(
 				metricsUnaryMiddleware,
+				s.limiter.unaryInterceptor,
 				grpc_recovery.UnaryServerInterceptor(grpc_recovery.WithRecoveryHandler(p)),
 			),
 		),
 		grpc.StreamInterceptor(
 			grpc_middleware.ChainStreamServer(
 				metricsStreamMiddleware,
+				s.limiter.streamInterceptor,
 				grpc_recovery.StreamServerInterceptor(grpc_recovery.WithRecoveryHandler(p)),
 			),
 		),
-		grpc.MaxConcurrentStreams(maxConcurrentStreams))
-	apiService := NewAPIService(tp, bc, bv, p2pService, consensus, s.quitCh)
-	rpcpb.RegisterApiServiceServer(s.grpcServer, apiService)
+		grpc.MaxConcurrentStreams(maxConcurrentStreams),
+		grpc.MaxRecvMsgSize(s.maxRequestSize))
+	s.apiService = NewAPIService(tp, bc, bv, p2pService, consensus, s.quitCh)
+	rpcpb.RegisterApiServiceServer(s.grpcServer, s.apiService)
 	return s
 }
 
@@ -83,7 +107,13 @@ func (s *Server) Start() error {
 	if err := s.startGrpc(); err != nil {
 		return err
 	}
-	return s.startGateway()
+	if err := s.startGateway(); err != nil {
+		return err
+	}
+	if err := s.startJSONRPC(); err != nil {
+		return err
+	}
+	return s.startGraphQL()
 }
 
 func (s *Server) startGrpc() error {
@@ -103,6 +133,7 @@ func (s *Server) startGrpc() error {
 func (s *Server) startGateway() error {
 	mux := runtime.NewServeMux(
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}),
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher),
 		runtime.WithProtoErrorHandler(errorHandler))
 	opts := []grpc.DialOption{grpc.WithInsecure()}
 	err := rpcpb.RegisterApiServiceHandlerFromEndpoint(context.Background(), mux, s.grpcAddr, opts)
@@ -110,13 +141,13 @@ func (s *Server) startGateway() error {
 		return err
 	}
 	c := cors.New(cors.Options{
-		AllowedHeaders: []string{"Content-Type", "Accept"},
+		AllowedHeaders: []string{"Content-Type", "Accept", apiKeyHeader},
 		AllowedMethods: []string{"GET", "HEAD", "POST", "PUT", "DELETE"},
 		AllowedOrigins: s.allowOrigins,
 	})
 	s.gatewayServer = &http.Server{
 		Addr:    s.gatewayAddr,
-		Handler: c.Handler(mux),
+		Handler: limitRequestSize(c.Handler(mux), s.maxRequestSize),
 	}
 	go func() {
 		if err := s.gatewayServer.ListenAndServe(); err != http.ErrServerClosed {
@@ -126,8 +157,73 @@ func (s *Server) startGateway() error {
 	return nil
 }
 
+func (s *Server) startJSONRPC() error {
+	if s.jsonrpcAddr == "" {
+		return nil
+	}
+	conn, err := grpc.Dial(s.grpcAddr, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	s.jsonrpcConn = conn
+	c := cors.New(cors.Options{
+		AllowedHeaders: []string{"Content-Type", "Accept", apiKeyHeader},
+		AllowedMethods: []string{"POST"},
+		AllowedOrigins: s.allowOrigins,
+	})
+	handler := newJSONRPCHandler(rpcpb.NewApiServiceClient(conn), s.allowOrigins, s.maxRequestSize, s.quitCh)
+	s.jsonrpcServer = &http.Server{
+		Addr:    s.jsonrpcAddr,
+		Handler: c.Handler(handler),
+	}
+	go func() {
+		if err := s.jsonrpcServer.ListenAndServe(); err != http.ErrServerClosed {
+			ilog.Fatalf("start jsonrpc failed. err=%v", err)
+		}
+	}()
+	return nil
+}
+
+func (s *Server) startGraphQL() error {
+	if s.graphqlConf == nil || !s.graphqlConf.Enable {
+		return nil
+	}
+	schema, err := newGraphQLSchema(s.apiService, s.graphqlConf.MaxDepth, s.graphqlConf.MaxComplexity)
+	if err != nil {
+		return err
+	}
+	c := cors.New(cors.Options{
+		AllowedHeaders: []string{"Content-Type", "Accept", apiKeyHeader},
+		AllowedMethods: []string{"POST"},
+		AllowedOrigins: s.allowOrigins,
+	})
+	relayHandler := &relay.Handler{Schema: schema}
+	handler := s.limiter.limitHTTP(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		relayHandler.ServeHTTP(w, r.WithContext(withGraphQLState(r.Context())))
+	}), graphqlMethod)
+	s.graphqlServer = &http.Server{
+		Addr:    s.graphqlConf.Addr,
+		Handler: limitRequestSize(c.Handler(handler), s.maxRequestSize),
+	}
+	go func() {
+		if err := s.graphqlServer.ListenAndServe(); err != http.ErrServerClosed {
+			ilog.Fatalf("start graphql failed. err=%v", err)
+		}
+	}()
+	return nil
+}
+
 func errorHandler(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
-	w.WriteHeader(400)
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		w.WriteHeader(http.StatusUnauthorized)
+	case codes.PermissionDenied:
+		w.WriteHeader(http.StatusForbidden)
+	case codes.ResourceExhausted:
+		w.WriteHeader(http.StatusTooManyRequests)
+	default:
+		w.WriteHeader(400)
+	}
 	bytes, e := json.Marshal(err)
 	if e != nil {
 		bytes = []byte(fmt.Sprint(err))
@@ -143,5 +239,12 @@ func (s *Server) Stop() {
 	close(s.quitCh)
 	ctx, _ := context.WithTimeout(context.Background(), time.Second) // nolint
 	s.gatewayServer.Shutdown(ctx)
+	if s.jsonrpcServer != nil {
+		s.jsonrpcServer.Shutdown(ctx)
+		s.jsonrpcConn.Close()
+	}
+	if s.graphqlServer != nil {
+		s.graphqlServer.Shutdown(ctx)
+	}
 	s.grpcServer.GracefulStop()
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"time"
 
@@ -262,6 +263,175 @@ func (s *IOSTDevSDK) GetTxReceiptByTxHash(txHashStr string) (*rpcpb.TxReceipt, e
 	return client.GetTxReceiptByTxHash(context.Background(), &rpcpb.TxHashRequest{Hash: txHashStr})
 }
 
+// GetTxStatus returns the status of tx and its lifecycle in txpool
+func (s *IOSTDevSDK) GetTxStatus(txHashStr string) (*rpcpb.TxStatusResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.GetTxStatus(context.Background(), &rpcpb.TxHashRequest{Hash: txHashStr})
+}
+
+// GetPendingTxs returns a page of pending txs in the packing order, filtered by publisher or contract if they are not empty
+func (s *IOSTDevSDK) GetPendingTxs(publisher, contract, cursor string, limit int32) (*rpcpb.GetPendingTxsResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.GetPendingTxs(context.Background(), &rpcpb.GetPendingTxsRequest{
+		Publisher: publisher,
+		Contract:  contract,
+		Cursor:    cursor,
+		Limit:     limit,
+	})
+}
+
+// GetTxPoolStats returns the stats of txpool
+func (s *IOSTDevSDK) GetTxPoolStats() (*rpcpb.TxPoolStatsResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.GetTxPoolStats(context.Background(), &rpcpb.EmptyRequest{})
+}
+
+// GetPendingTxStatus returns whether the pending tx can still be packed and its position in the packing order
+func (s *IOSTDevSDK) GetPendingTxStatus(txHashStr string) (*rpcpb.PendingTxStatusResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.GetPendingTxStatus(context.Background(), &rpcpb.TxHashRequest{Hash: txHashStr})
+}
+
+// EncodeAction validates the args against the abi of the contract action and returns the encoded action.
+// The errors of invalid args are returned in the response rather than as an error.
+func (s *IOSTDevSDK) EncodeAction(contract, actionName string, args []string) (*rpcpb.EncodeActionResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.EncodeAction(context.Background(), &rpcpb.EncodeActionRequest{
+		Contract:       contract,
+		ActionName:     actionName,
+		Args:           args,
+		ByLongestChain: s.useLongestChain,
+	})
+}
+
+// EncodeActionData is like EncodeAction, but takes the action data in json array as iwallet call does.
+func (s *IOSTDevSDK) EncodeActionData(contract, actionName, data string) (*rpcpb.EncodeActionResponse, error) {
+	args, err := ArgsFromJSON(data, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.EncodeAction(contract, actionName, args)
+	if err != nil {
+		return nil, err
+	}
+	// the string elements of json args should be kept quoted, which is known after the abi is got
+	for _, t := range resp.ArgTypes {
+		if t == "json" {
+			args, err = ArgsFromJSON(data, resp.ArgTypes)
+			if err != nil {
+				return nil, err
+			}
+			return s.EncodeAction(contract, actionName, args)
+		}
+	}
+	return resp, nil
+}
+
+// DecodeTx returns the actions and receipts of the transaction decoded against the abis
+func (s *IOSTDevSDK) DecodeTx(txHashStr string) (*rpcpb.DecodeTxResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.DecodeTx(context.Background(), &rpcpb.TxHashRequest{Hash: txHashStr})
+}
+
+// GetBlocksByRange returns the blocks whose number is in [start, end), the server returns fewer blocks
+// than requested if the range exceeds the head of chain, and an error if it exceeds its max batch size
+func (s *IOSTDevSDK) GetBlocksByRange(start, end int64, complete bool) ([]*rpcpb.BlockResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	stream, err := client.GetBlocksByRange(context.Background(), &rpcpb.GetBlocksByRangeRequest{StartNumber: start, EndNumber: end, Complete: complete})
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]*rpcpb.BlockResponse, 0)
+	for {
+		blk, err := stream.Recv()
+		if err == io.EOF {
+			return blocks, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, blk)
+	}
+}
+
+// GetAccountHistory returns the resource usage and balance changes of the account in each block of [start, end)
+func (s *IOSTDevSDK) GetAccountHistory(name string, start, end int64) (*rpcpb.AccountHistoryResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.GetAccountHistory(context.Background(), &rpcpb.AccountHistoryRequest{Name: name, StartNumber: start, EndNumber: end})
+}
+
+// GetAccountUsage returns the total resource usage and balance changes of the account in [start, end)
+func (s *IOSTDevSDK) GetAccountUsage(name string, start, end int64) (*rpcpb.AccountUsageResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.GetAccountUsage(context.Background(), &rpcpb.AccountHistoryRequest{Name: name, StartNumber: start, EndNumber: end})
+}
+
+// GetTxReceiptsByHashes returns the receipts of txs, and the hashes of txs not found
+func (s *IOSTDevSDK) GetTxReceiptsByHashes(hashes []string) (*rpcpb.TxReceiptsResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.GetTxReceiptsByHashes(context.Background(), &rpcpb.TxHashesRequest{Hashes: hashes})
+}
+
 // SendTransaction send raw transaction to server
 func (s *IOSTDevSDK) SendTransaction(signedTx *rpcpb.TransactionRequest) (string, error) {
 	if s.rpcConn == nil {
@@ -278,6 +448,18 @@ func (s *IOSTDevSDK) SendTransaction(signedTx *rpcpb.TransactionRequest) (string
 	return resp.Hash, nil
 }
 
+// EstimateTransaction estimates the gas and ram usage of the signed transaction by the server
+func (s *IOSTDevSDK) EstimateTransaction(signedTx *rpcpb.TransactionRequest) (*rpcpb.EstimateTransactionResponse, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return nil, err
+		}
+		defer s.CloseConn()
+	}
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	return client.EstimateTransaction(context.Background(), signedTx)
+}
+
 ////////////////////////////////////// transaction related /////////////////////////////////
 
 // CreateTxFromActions ...
@@ -354,6 +536,52 @@ func (s *IOSTDevSDK) checkTransaction(txHash string) error {
 	return fmt.Errorf("exceeded max retry times")
 }
 
+// CancelTx cancels the pending transaction by sending a replacement without actions and checks result if
+// sdk.checkResult is set. The gas ratio of the replacement is higher by 1 than the canceled one if gasRatio is 0.
+func (s *IOSTDevSDK) CancelTx(txHash string, gasRatio float64) (string, error) {
+	if s.rpcConn == nil {
+		if err := s.Connect(); err != nil {
+			return "", err
+		}
+		defer s.CloseConn()
+	}
+	r, err := s.GetTxByHash(txHash)
+	if err != nil {
+		return "", err
+	}
+	if r.Status != rpcpb.TransactionResponse_PENDING {
+		return "", fmt.Errorf("transaction %v is not pending", txHash)
+	}
+	if gasRatio == 0 {
+		gasRatio = r.Transaction.GasRatio + 1
+	}
+	trx, err := s.CreateTxFromActions(nil)
+	if err != nil {
+		return "", err
+	}
+	trx.GasRatio = gasRatio
+	trx.ReplacedTx = txHash
+	signedTx, err := s.SignTx(trx, s.signAlgo)
+	if err != nil {
+		return "", fmt.Errorf("sign tx error %v", err)
+	}
+	s.log("Sending cancellation...")
+	s.log(MarshalTextString(signedTx))
+	client := rpcpb.NewApiServiceClient(s.rpcConn)
+	resp, err := client.CancelTransaction(context.Background(), signedTx)
+	if err != nil {
+		return "", fmt.Errorf("cancel tx error %v", err)
+	}
+	s.log("Cancellation has been sent.")
+	s.log("The transaction hash is:", resp.Hash)
+	if s.checkResult {
+		if err = s.checkTransaction(resp.Hash); err != nil {
+			return resp.Hash, err
+		}
+	}
+	return resp.Hash, nil
+}
+
 // SendTx send transaction and check result if sdk.checkResult is set
 func (s *IOSTDevSDK) SendTx(tx *rpcpb.TransactionRequest) (string, error) {
 	signedTx, err := s.SignTx(tx, s.signAlgo)
@@ -1,6 +1,7 @@
 package sdk
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -151,6 +152,26 @@ func NewAction(contract string, name string, data string) *rpcpb.Action {
 	}
 }
 
+// ArgsFromJSON splits the json array of action data into the args accepted by EncodeAction.
+// A string element is unquoted unless its type is "json", the others are kept in json.
+// All string elements are unquoted if types is nil.
+func ArgsFromJSON(data string, types []string) ([]string, error) {
+	var values []json.RawMessage
+	if err := json.Unmarshal([]byte(data), &values); err != nil {
+		return nil, fmt.Errorf("invalid args, should be json array: %v, %v", data, err)
+	}
+	args := make([]string, 0, len(values))
+	for i, v := range values {
+		var s string
+		if (i >= len(types) || types[i] != "json") && json.Unmarshal(v, &s) == nil {
+			args = append(args, s)
+			continue
+		}
+		args = append(args, string(v))
+	}
+	return args, nil
+}
+
 /////////////////////////////////// serialize deserialize ///////////////////////////////////////////
 
 func actionToBytes(a *rpcpb.Action) []byte {
@@ -199,6 +220,10 @@ func txToBytes(t *rpcpb.TransactionRequest, withSign bool) []byte {
 	}
 	se.WriteBytesSlice(amountBytes)
 
+	if t.ReplacedTx != "" {
+		se.WriteBytes(common.Base58Decode(t.ReplacedTx))
+	}
+
 	if withSign {
 		signBytes := make([][]byte, 0, len(t.Signatures))
 		for _, sig := range t.Signatures {
@@ -9,6 +9,7 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 
 	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/contract"
 	"github.com/iost-official/go-iost/core/event"
 	"github.com/iost-official/go-iost/core/tx"
@@ -118,7 +119,6 @@ func Test_VMMethod_Event(t *testing.T) {
 		eve := event.GetCollector()
 		// contract event
 		ch1 := eve.Subscribe(1, []event.Topic{event.ContractEvent}, nil)
-		ch2 := eve.Subscribe(2, []event.Topic{event.ContractReceipt}, nil)
 
 		r, err = s.Call(cname, "event", fmt.Sprintf(`["%v"]`, "eventdata"), acc0.ID, acc0.KeyPair)
 		s.Visitor.Commit()
@@ -130,16 +130,19 @@ func Test_VMMethod_Event(t *testing.T) {
 		So(e.Data, ShouldEqual, "eventdata")
 		So(e.Topic, ShouldEqual, event.ContractEvent)
 
-		// receipt event
+		// receipt event, which is posted when the block becomes irreversible
 		r, err = s.Call(cname, "receiptf", fmt.Sprintf(`["%v"]`, "receipteventdata"), acc0.ID, acc0.KeyPair)
 		s.Visitor.Commit()
 
 		So(err, ShouldBeNil)
 		So(r.Status.Message, ShouldEqual, "")
 
-		e = <-ch2
+		events, _ := event.ReceiptEvents(&block.Block{Head: &block.BlockHead{Number: 1}, Receipts: []*tx.TxReceipt{r}})
+		So(len(events), ShouldBeGreaterThan, 0)
+		e = events[len(events)-1]
 		So(e.Data, ShouldEqual, "receipteventdata")
 		So(e.Topic, ShouldEqual, event.ContractReceipt)
+		So(e.Cursor, ShouldResemble, &event.Cursor{BlockNumber: 1, TxIndex: 0, ReceiptIndex: int32(len(events) - 1)})
 	})
 }
 
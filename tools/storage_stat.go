@@ -19,10 +19,6 @@ func padTo(s string, ptn string, l int) string {
 func printTokenBalance(db *leveldb.DB, tokenType string) {
 	fmt.Println("############# ", tokenType, " balance ##############")
 	prefix := "state/m-token.iost-TB"
-	keys, err := db.Keys([]byte(prefix))
-	if err != nil {
-		panic(err)
-	}
 	suffix := "-" + tokenType
 	decimalKey := "state/m-token.iost-TI" + tokenType + "-decimal"
 	decimalRaw, err := db.Get([]byte(decimalKey))
@@ -30,27 +26,29 @@ func printTokenBalance(db *leveldb.DB, tokenType string) {
 		panic(err)
 	}
 	decimal := database.MustUnmarshal(string(decimalRaw))
-	for _, k := range keys {
+	iter := db.NewIteratorByPrefix([]byte(prefix))
+	for iter.Next() {
+		k := iter.Key()
 		if !strings.HasSuffix(string(k), suffix) {
 			continue
 		}
-		rawValue, err := db.Get(k)
-		if err != nil {
-			panic(err)
-		}
-		v := database.MustUnmarshal(string(rawValue))
+		v := database.MustUnmarshal(string(iter.Value()))
 		f := common.Fixed{Value: v.(int64), Decimal: int(decimal.(int64))}
 		tmp := string(k)[len(prefix):]
 		user := tmp[:len(tmp)-len(suffix)]
 		user = padTo(user, " ", 20)
 		fmt.Printf("%v\t%v\n", user, f.ToString())
 	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		panic(err)
+	}
 	fmt.Println()
 }
 
 func printAll(db *leveldb.DB) { // nolint
 	fmt.Println("######## all kvs #############")
-	iter := db.NewIteratorByPrefix([]byte("state/")).(*leveldb.Iter)
+	iter := db.NewIteratorByPrefix([]byte("state/"))
 	for iter.Next() {
 		k := string(iter.Key())
 		v := string(iter.Value())
@@ -64,7 +62,7 @@ func printAll(db *leveldb.DB) { // nolint
 func printRAMUsage(db *leveldb.DB) {
 	fmt.Println("######## system ram usage #############")
 	m := make(map[string]int)
-	iter := db.NewIteratorByPrefix([]byte("state/")).(*leveldb.Iter)
+	iter := db.NewIteratorByPrefix([]byte("state/"))
 	for iter.Next() {
 		k := string(iter.Key())
 		v := string(iter.Value())
@@ -1,25 +1,124 @@
 package verifier
 
 import (
+	"sort"
+	"time"
+
 	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/core/txpool"
 )
 
+// Names of the tx providers, which decide the order of txs packed into a block.
+const (
+	GasRatioProvider   = "gas_ratio"
+	RoundRobinProvider = "round_robin"
+	DeadlineProvider   = "deadline"
+)
+
+// the txs expiring in it are packed first by the deadline provider
+var deadlineWindow = int64(10 * time.Second)
+
 // ProviderImpl impl of provider
 type ProviderImpl struct {
 	cache    []*tx.Tx
 	pool     *txpool.SortedTxMap
-	iter     *txpool.Iterator
+	next     func() (*tx.Tx, bool)
 	droplist map[*tx.Tx]error
 }
 
-// NewProvider ...
-func NewProvider(pool *txpool.SortedTxMap) *ProviderImpl {
+func newProviderImpl(pool *txpool.SortedTxMap, next func() (*tx.Tx, bool)) *ProviderImpl {
 	return &ProviderImpl{
 		cache:    make([]*tx.Tx, 0),
 		droplist: make(map[*tx.Tx]error),
 		pool:     pool,
-		iter:     pool.Iter(),
+		next:     next,
+	}
+}
+
+// NewProvider returns the provider of txs in the order of gas ratio, which maximizes the gas ratio of a block.
+func NewProvider(pool *txpool.SortedTxMap) *ProviderImpl {
+	return newProviderImpl(pool, pool.Iter().Next)
+}
+
+// NewRoundRobinProvider returns the provider taking a tx from each publisher in turn, so the publishers with
+// lots of txs can not fill a block alone. The txs of a publisher are in the order of gas ratio, and the
+// publishers are in the order of their first tx.
+func NewRoundRobinProvider(pool *txpool.SortedTxMap) *ProviderImpl {
+	queues := make(map[string][]*tx.Tx)
+	publishers := make([]string, 0)
+	iter := pool.Iter()
+	for t, ok := iter.Next(); ok; t, ok = iter.Next() {
+		if _, exist := queues[t.Publisher]; !exist {
+			publishers = append(publishers, t.Publisher)
+		}
+		queues[t.Publisher] = append(queues[t.Publisher], t)
+	}
+	txs := make([]*tx.Tx, 0, pool.Size())
+	for len(publishers) > 0 {
+		rest := publishers[:0]
+		for _, p := range publishers {
+			q := queues[p]
+			txs = append(txs, q[0])
+			if len(q) > 1 {
+				queues[p] = q[1:]
+				rest = append(rest, p)
+			}
+		}
+		publishers = rest
+	}
+	return newProviderImpl(pool, sliceNext(txs))
+}
+
+// NewDeadlineProvider returns the provider taking the txs expiring in deadlineWindow after blockTime first in
+// the order of expiration, then the others in the order of gas ratio, so fewer txs expire in txpool.
+func NewDeadlineProvider(pool *txpool.SortedTxMap, blockTime int64) *ProviderImpl {
+	urgent := make([]*tx.Tx, 0)
+	rest := make([]*tx.Tx, 0, pool.Size())
+	iter := pool.Iter()
+	for t, ok := iter.Next(); ok; t, ok = iter.Next() {
+		if !t.IsDefer() && t.Expiration-blockTime < deadlineWindow {
+			urgent = append(urgent, t)
+		} else {
+			rest = append(rest, t)
+		}
+	}
+	sort.SliceStable(urgent, func(i, j int) bool {
+		return urgent[i].Expiration < urgent[j].Expiration
+	})
+	return newProviderImpl(pool, sliceNext(append(urgent, rest...)))
+}
+
+// NewProviderByName returns the provider of the name for the block of blockTime, the name can be empty for
+// the gas ratio provider.
+func NewProviderByName(name string, pool *txpool.SortedTxMap, blockTime int64) (*ProviderImpl, error) {
+	switch name {
+	case "", GasRatioProvider:
+		return NewProvider(pool), nil
+	case RoundRobinProvider:
+		return NewRoundRobinProvider(pool), nil
+	case DeadlineProvider:
+		return NewDeadlineProvider(pool, blockTime), nil
+	}
+	return nil, ErrInvalidProvider
+}
+
+// CheckProvider checks whether the name of provider is valid.
+func CheckProvider(name string) error {
+	switch name {
+	case "", GasRatioProvider, RoundRobinProvider, DeadlineProvider:
+		return nil
+	}
+	return ErrInvalidProvider
+}
+
+func sliceNext(txs []*tx.Tx) func() (*tx.Tx, bool) {
+	return func() (*tx.Tx, bool) {
+		if len(txs) == 0 {
+			return nil, false
+		}
+		t := txs[0]
+		txs = txs[1:]
+		return t, true
 	}
 }
 
@@ -30,7 +129,7 @@ func (p *ProviderImpl) Tx() *tx.Tx {
 		p.cache = p.cache[:len(p.cache)-1]
 		return t
 	}
-	t, ok := p.iter.Next()
+	t, ok := p.next()
 	if !ok {
 		return nil
 	}
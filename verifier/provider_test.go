@@ -0,0 +1,156 @@
+package verifier
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/core/txpool"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var providerTxSeq int64
+
+func genProviderTx(publisher string, gasRatio int64, now int64, expiration int64) *tx.Tx {
+	t := tx.NewTx(nil, nil, 100000, gasRatio, now+expiration, 0, 0)
+	t.Publisher = publisher
+	// the txs of the same gas ratio are in the order of generation
+	providerTxSeq++
+	t.Time = now - int64(time.Minute) + providerTxSeq
+	return t
+}
+
+func providerTxs(p *ProviderImpl) []*tx.Tx {
+	txs := make([]*tx.Tx, 0)
+	for t := p.Tx(); t != nil; t = p.Tx() {
+		txs = append(txs, t)
+	}
+	return txs
+}
+
+func TestProviders(t *testing.T) {
+	Convey("test providers", t, func() {
+		now := time.Now().UnixNano()
+		pool := txpool.NewSortedTxMap()
+		a300 := genProviderTx("a", 300, now, int64(time.Minute))
+		a200 := genProviderTx("a", 200, now, int64(time.Minute))
+		a100 := genProviderTx("a", 100, now, int64(time.Minute))
+		b150 := genProviderTx("b", 150, now, int64(time.Minute))
+		c50 := genProviderTx("c", 50, now, int64(time.Second))
+		for _, t := range []*tx.Tx{a100, b150, c50, a300, a200} {
+			pool.Add(t)
+		}
+
+		Convey("gas ratio", func() {
+			p, err := NewProviderByName("", pool, now)
+			So(err, ShouldBeNil)
+			So(providerTxs(p), ShouldResemble, []*tx.Tx{a300, a200, b150, a100, c50})
+		})
+
+		Convey("round robin", func() {
+			p, err := NewProviderByName(RoundRobinProvider, pool, now)
+			So(err, ShouldBeNil)
+			So(providerTxs(p), ShouldResemble, []*tx.Tx{a300, b150, c50, a200, a100})
+		})
+
+		Convey("deadline", func() {
+			p, err := NewProviderByName(DeadlineProvider, pool, now)
+			So(err, ShouldBeNil)
+			So(providerTxs(p), ShouldResemble, []*tx.Tx{c50, a300, a200, b150, a100})
+		})
+
+		Convey("return and drop", func() {
+			p := NewProvider(pool)
+			t := p.Tx()
+			p.Return(t)
+			So(p.Tx(), ShouldEqual, t)
+			p.Drop(t, ErrExpiredTx)
+			p.Close()
+			So(pool.Size(), ShouldEqual, 4)
+		})
+
+		Convey("invalid", func() {
+			_, err := NewProviderByName("unknown", pool, now)
+			So(err, ShouldEqual, ErrInvalidProvider)
+			So(CheckProvider("unknown"), ShouldEqual, ErrInvalidProvider)
+			So(CheckProvider(DeadlineProvider), ShouldBeNil)
+		})
+	})
+}
+
+// genBenchPool returns a pool where a publisher sends lots of txs with high gas ratio, and many publishers send
+// a few txs with low gas ratio, some of which are going to expire.
+func genBenchPool(now int64) *txpool.SortedTxMap {
+	pool := txpool.NewSortedTxMap()
+	for i := 0; i < 1000; i++ {
+		pool.Add(genProviderTx("whale", 200+int64(i%100), now, int64(tx.MaxExpiration)))
+	}
+	for i := 0; i < 100; i++ {
+		for j := 0; j < 10; j++ {
+			expiration := int64(tx.MaxExpiration)
+			if j == 0 {
+				expiration = int64(5 * time.Second)
+			}
+			pool.Add(genProviderTx(fmt.Sprintf("user%v", i), 100+int64(j), now, expiration))
+		}
+	}
+	return pool
+}
+
+// the count of txs fit in a block of the benchmarks
+const benchBlockTxs = 500
+
+// packBench takes txs from the provider as a block does, and returns the gas paid, the Jain's fairness index
+// of the count of txs of each publisher and the count of packed txs expiring soon.
+func packBench(p *ProviderImpl, now int64) (gas int64, fairness float64, urgent int) {
+	counts := make(map[string]float64)
+	for i := 0; i < benchBlockTxs; i++ {
+		t := p.Tx()
+		if t == nil {
+			break
+		}
+		gas += t.GasLimit * t.GasRatio / 100
+		counts[t.Publisher]++
+		if t.Expiration-now < deadlineWindow {
+			urgent++
+		}
+	}
+	var sum, sumSquare float64
+	for _, c := range counts {
+		sum += c
+		sumSquare += c * c
+	}
+	// all the 101 publishers have pending txs
+	fairness = sum * sum / (101 * sumSquare)
+	return
+}
+
+func benchmarkProvider(b *testing.B, name string) {
+	now := time.Now().UnixNano()
+	pool := genBenchPool(now)
+	p, err := NewProviderByName(name, pool, now)
+	if err != nil {
+		b.Fatal(err)
+	}
+	gas, fairness, urgent := packBench(p, now)
+	b.Logf("provider %v: packed gas %v, fairness %.3f, packed urgent txs %v/100", name, gas, fairness, urgent)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p, _ := NewProviderByName(name, pool, now)
+		packBench(p, now)
+	}
+}
+
+func BenchmarkGasRatioProvider(b *testing.B) {
+	benchmarkProvider(b, GasRatioProvider)
+}
+
+func BenchmarkRoundRobinProvider(b *testing.B) {
+	benchmarkProvider(b, RoundRobinProvider)
+}
+
+func BenchmarkDeadlineProvider(b *testing.B) {
+	benchmarkProvider(b, DeadlineProvider)
+}
@@ -23,6 +23,8 @@ var (
 	ErrExpiredTx    = errors.New("expired tx")
 	ErrNotArrivedTx = errors.New("not arrived tx")
 	ErrInvalidMode  = errors.New("invalid mode")
+
+	ErrInvalidProvider = errors.New("invalid tx provider")
 )
 
 // Verifier ..
@@ -35,6 +37,7 @@ type Config struct {
 	Timeout     time.Duration
 	TxTimeLimit time.Duration
 	Thread      int
+	TxProvider  string // the name of the provider deciding the order of txs to pack, see NewProviderByName
 }
 
 // Info info in block
@@ -92,6 +95,10 @@ func (v *Verifier) Try(bh *block.BlockHead, db database.IMultiValue, t *tx.Tx, l
 
 // Gen gen block
 func (v *Verifier) Gen(blk, parent *block.Block, witnessList *blockcache.WitnessList, db database.IMultiValue, iter *txpool.SortedTxMap, c *Config) (droplist []*tx.Tx, errs []error, err error) {
+	pi, err := NewProviderByName(c.TxProvider, iter, blk.Head.Time)
+	if err != nil {
+		return nil, nil, err
+	}
 	isolator := &vm.Isolator{}
 	baseTx, err := NewBaseTx(blk, parent, witnessList)
 	if err != nil {
@@ -103,7 +110,6 @@ func (v *Verifier) Gen(blk, parent *block.Block, witnessList *blockcache.Witness
 	}
 	blk.Txs = append(blk.Txs, baseTx)
 	blk.Receipts = append(blk.Receipts, r)
-	var pi = NewProvider(iter)
 	switch c.Mode {
 	case 0:
 		err = baseGen(blk, db, pi, isolator, c)
@@ -18,7 +18,16 @@ func NewEventPoster(h *Host) EventPoster {
 // PostEvent post the event
 func (p *EventPoster) PostEvent(data string) contract.Cost {
 	e := event.NewEvent(event.ContractEvent, data)
-	event.GetCollector().Post(e,
-		&event.Meta{ContractID: p.h.Context().Value("contract_name").(string)})
+	event.GetCollector().Post(e, eventMeta(p.h))
 	return EventCost(len(data))
 }
+
+// eventMeta returns the meta of the events posted by contract
+func eventMeta(h *Host) *event.Meta {
+	meta := &event.Meta{ContractID: h.Context().Value("contract_name").(string)}
+	meta.ActionName, _ = h.Context().Value("abi_name").(string)
+	if publisher, ok := h.Context().Value("publisher").(string); ok {
+		meta.Accounts = []string{publisher}
+	}
+	return meta
+}
@@ -2,7 +2,6 @@ package host
 
 import (
 	"github.com/iost-official/go-iost/core/contract"
-	"github.com/iost-official/go-iost/core/event"
 	"github.com/iost-official/go-iost/core/tx"
 )
 
@@ -23,12 +22,9 @@ func (h *APIDelegate) receipt(s string) {
 		Content:  s,
 	}
 
+	// the event of receipt is posted with its cursor when the block becomes irreversible
 	rs := h.h.ctx.GValue("receipts").([]*tx.Receipt)
 	h.h.ctx.GSet("receipts", append(rs, rec))
-
-	// post event for receipt
-	event.GetCollector().Post(event.NewEvent(event.ContractReceipt, rec.Content),
-		&event.Meta{ContractID: h.h.Context().Value("contract_name").(string)})
 }
 
 // Receipt ...